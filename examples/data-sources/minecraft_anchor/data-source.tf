@@ -0,0 +1,18 @@
+data "minecraft_anchor" "landmarks" {
+  anchors = {
+    spawn   = { kind = "spawn" }
+    village = { kind = "structure", target = "#minecraft:village" }
+    plains  = { kind = "biome", target = "minecraft:plains" }
+  }
+}
+
+# A welcome sign next to the nearest village, at spawn height.
+resource "minecraft_block" "village_sign" {
+  material = "minecraft:oak_sign"
+
+  position = {
+    x = data.minecraft_anchor.landmarks.points["village"].x
+    y = data.minecraft_anchor.landmarks.points["spawn"].y
+    z = data.minecraft_anchor.landmarks.points["village"].z
+  }
+}
@@ -0,0 +1,5 @@
+data "minecraft_bans" "current" {}
+
+output "banned_players" {
+  value = data.minecraft_bans.current.players
+}
@@ -0,0 +1,10 @@
+data "minecraft_connection" "current" {}
+
+output "minecraft_connection" {
+  value = {
+    host           = data.minecraft_connection.current.host
+    port           = data.minecraft_connection.current.port
+    latency_ms     = data.minecraft_connection.current.latency_ms
+    server_version = data.minecraft_connection.current.server_version
+  }
+}
@@ -0,0 +1,18 @@
+data "minecraft_healthcheck" "server" {
+  fail_on_unreachable = true
+}
+
+resource "minecraft_block" "beacon" {
+  material = "minecraft:beacon"
+  position = {
+    x = 0
+    y = 64
+    z = 0
+  }
+
+  depends_on = [data.minecraft_healthcheck.server]
+}
+
+output "rcon_latency_ms" {
+  value = data.minecraft_healthcheck.server.latency_ms
+}
@@ -0,0 +1,17 @@
+data "minecraft_region" "tower_walls" {
+  material = "minecraft:stone_bricks"
+  from = {
+    x = 0
+    y = 64
+    z = 0
+  }
+  to = {
+    x = 7
+    y = 80
+    z = 7
+  }
+}
+
+output "tower_wall_blocks" {
+  value = data.minecraft_region.tower_walls.count
+}
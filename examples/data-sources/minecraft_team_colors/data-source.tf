@@ -0,0 +1,9 @@
+data "minecraft_team_colors" "all" {}
+
+# One team per color.
+resource "minecraft_team" "per_color" {
+  for_each = toset([for c in data.minecraft_team_colors.all.colors : c if c != "reset"])
+
+  name  = each.value
+  color = each.value
+}
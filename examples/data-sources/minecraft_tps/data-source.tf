@@ -0,0 +1,18 @@
+data "minecraft_tps" "server" {}
+
+# Only build the arena while the server keeps up.
+resource "minecraft_fill" "arena" {
+  count = coalesce(data.minecraft_tps.server.tps_1m, 20) >= 18 ? 1 : 0
+
+  material = "minecraft:stone"
+  start = {
+    x = 0
+    y = 64
+    z = 0
+  }
+  end = {
+    x = 31
+    y = 64
+    z = 31
+  }
+}
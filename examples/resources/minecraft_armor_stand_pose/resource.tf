@@ -0,0 +1,26 @@
+resource "minecraft_entity" "statue" {
+  type = "minecraft:armor_stand"
+
+  position = {
+    x = 10
+    y = 64
+    z = 10
+  }
+}
+
+# Salute.
+resource "minecraft_armor_stand_pose" "statue" {
+  armor_stand_id = minecraft_entity.statue.id
+
+  head = {
+    x = -10
+    y = 0
+    z = 0
+  }
+
+  right_arm = {
+    x = -140
+    y = -30
+    z = 0
+  }
+}
@@ -0,0 +1,8 @@
+# Make Steve 20% faster while this exists.
+resource "minecraft_attribute_modifier" "speed" {
+  target    = "Steve"
+  attribute = "minecraft:generic.movement_speed"
+  name      = "Speed boost"
+  value     = 0.2
+  operation = "multiply"
+}
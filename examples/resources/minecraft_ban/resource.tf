@@ -0,0 +1,5 @@
+resource "minecraft_ban" "griefer" {
+  player   = "griefer123"
+  reason   = "Griefing spawn"
+  duration = "3d"
+}
@@ -0,0 +1,15 @@
+resource "minecraft_biome" "garden" {
+  biome         = "minecraft:cherry_grove"
+  restore_biome = "minecraft:plains"
+
+  start = {
+    x = 0
+    y = 60
+    z = 0
+  }
+  end = {
+    x = 31
+    y = 100
+    z = 31
+  }
+}
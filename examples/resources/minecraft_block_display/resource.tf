@@ -0,0 +1,23 @@
+resource "minecraft_block_display" "statue" {
+  block_state = "minecraft:oak_stairs[facing=east,half=bottom]"
+
+  position = {
+    x = 10
+    y = 64
+    z = 10
+  }
+
+  scale = {
+    x = 2
+    y = 2
+    z = 2
+  }
+
+  translation = {
+    x = -0.5
+    y = 0
+    z = -0.5
+  }
+
+  billboard = "fixed"
+}
@@ -0,0 +1,19 @@
+resource "minecraft_chest" "loot" {
+  size = "single"
+
+  position = {
+    x = 10
+    y = 64
+    z = -4
+  }
+}
+
+# Put a stack of bread in the chest's first slot
+resource "minecraft_block_item_slot" "bread" {
+  container = "minecraft:chest"
+  slot      = 0
+  item      = "minecraft:bread"
+  count     = 16
+
+  position = minecraft_chest.loot.position
+}
@@ -0,0 +1,11 @@
+# Turn a furnace that was placed by hand to face the other way.
+resource "minecraft_block_rotation" "furnace" {
+  material = "minecraft:furnace"
+  turns    = 2
+
+  position = {
+    x = 12
+    y = 64
+    z = -3
+  }
+}
@@ -0,0 +1,18 @@
+resource "minecraft_scoreboard_objective" "counts" {
+  name      = "counts"
+  criterion = "dummy"
+}
+
+# Count the cows on every apply
+resource "minecraft_capture" "cows" {
+  objective = minecraft_scoreboard_objective.counts.name
+  command   = "execute if entity @e[type=minecraft:cow]"
+
+  triggers = {
+    at = timestamp()
+  }
+}
+
+output "cow_count" {
+  value = minecraft_capture.cows.value
+}
@@ -0,0 +1,16 @@
+# Reset the arena to fresh terrain after every event, and when it's destroyed.
+# Needs a datapack defining terraform:regenerate_chunks.
+resource "minecraft_chunk" "arena" {
+  from = {
+    x = 0
+    z = 0
+  }
+  to = {
+    x = 63
+    z = 63
+  }
+
+  regenerate = {
+    event = "2026-10-17"
+  }
+}
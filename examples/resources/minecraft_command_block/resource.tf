@@ -0,0 +1,11 @@
+resource "minecraft_command_block" "greeter" {
+  kind     = "repeating"
+  facing   = "north"
+  command  = "title @a[distance=..5] actionbar {\"text\":\"Welcome!\"}"
+  auto     = true
+  position = {
+    x = 0
+    y = 60
+    z = 0
+  }
+}
@@ -0,0 +1,10 @@
+resource "minecraft_custom_item" "wand" {
+  player      = "Steve"
+  item        = "minecraft:blaze_rod"
+  custom_name = "Wand of Sparks"
+  lore        = ["Handle with care", "Terraform managed"]
+  unbreakable = true
+
+  custom_model_data          = 1001
+  enchantment_glint_override = true
+}
@@ -0,0 +1,10 @@
+# Sting the red team with magic damage at the start of each round.
+resource "minecraft_damage" "round_start" {
+  target      = "@a[team=red]"
+  amount      = 4
+  damage_type = "minecraft:magic"
+
+  triggers = {
+    round = "1"
+  }
+}
@@ -0,0 +1,3 @@
+resource "minecraft_datapack" "my_pack" {
+  name = "file/my_pack.zip"
+}
@@ -0,0 +1,6 @@
+resource "minecraft_difficulty" "this" {
+  difficulty = "hard"
+
+  # Re-apply on every refresh if a restart resets it.
+  lock = true
+}
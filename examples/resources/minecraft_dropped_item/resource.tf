@@ -0,0 +1,10 @@
+resource "minecraft_dropped_item" "trophy" {
+  item          = "minecraft:nether_star"
+  no_despawn    = true
+  pickup_locked = true
+  position = {
+    x = 0.5
+    y = 65
+    z = 0.5
+  }
+}
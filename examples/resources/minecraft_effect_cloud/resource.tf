@@ -0,0 +1,15 @@
+resource "minecraft_effect_cloud" "trap" {
+  position = {
+    x = 10.5
+    y = 64
+    z = 10.5
+  }
+  radius   = 3
+  duration = 1200
+  effects = [
+    { effect = "minecraft:poison", amplifier = 1, duration = 200 },
+    { effect = "minecraft:slowness", duration = 100 },
+  ]
+  particle = "minecraft:witch"
+  color    = 5149489
+}
@@ -2,12 +2,24 @@
 resource "minecraft_entity" "farmer" {
   type     = "minecraft:villager"
   position = { x = 0, y = 64, z = 5 }
+
+  name         = "Farmer Joe"
+  name_visible = true
 }
 
 # Zombie guard with sword + helmet
 resource "minecraft_entity" "zombie_guard" {
   type     = "minecraft:zombie"
   position = { x = -2, y = 64, z = 5 }
+
+  equipment = {
+    head     = "minecraft:iron_helmet"
+    mainhand = "minecraft:iron_sword"
+
+    # Never drop the gear, so farms don't fill up with it.
+    armor_drop_chances = [0, 0, 0, 0]
+    hand_drop_chances  = [0, 0]
+  }
 }
 
 # Blue sheep
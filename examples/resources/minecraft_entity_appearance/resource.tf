@@ -0,0 +1,15 @@
+resource "minecraft_entity" "guard" {
+  type = "minecraft:zombie"
+
+  position = {
+    x = 0.5
+    y = 64
+    z = 0.5
+  }
+}
+
+# Highlight the guard while the event runs
+resource "minecraft_entity_appearance" "guard" {
+  entity_id = minecraft_entity.guard.id
+  glowing   = var.event_running
+}
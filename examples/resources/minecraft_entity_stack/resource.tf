@@ -0,0 +1,11 @@
+resource "minecraft_entity_stack" "jockey" {
+  entities = [
+    { type = "minecraft:spider" },
+    { type = "minecraft:skeleton", name = "Rider" },
+  ]
+  position = {
+    x = 10.5
+    y = 64
+    z = 10.5
+  }
+}
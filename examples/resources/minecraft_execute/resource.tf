@@ -0,0 +1,7 @@
+# Put a glowing marker above every cow; remove it again on destroy.
+resource "minecraft_execute" "cow_markers" {
+  as          = "@e[type=minecraft:cow]"
+  positioned  = "~ ~2 ~"
+  run         = "summon minecraft:marker ~ ~ ~ {Tags:[\"cow_marker\"]}"
+  destroy_run = "kill @e[type=minecraft:marker,tag=cow_marker,distance=..3]"
+}
@@ -0,0 +1,14 @@
+# Drop an anvil from the sky; bump the trigger to drop another.
+resource "minecraft_falling_block" "anvil" {
+  block_state = "minecraft:anvil[facing=north]"
+
+  position = {
+    x = 0
+    y = 120
+    z = 0
+  }
+
+  triggers = {
+    round = "1"
+  }
+}
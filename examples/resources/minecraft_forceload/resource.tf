@@ -0,0 +1,11 @@
+# Keep the iron farm running while nobody is around.
+resource "minecraft_forceload" "iron_farm" {
+  from = {
+    x = -64
+    z = -64
+  }
+  to = {
+    x = 63
+    z = 63
+  }
+}
@@ -0,0 +1,15 @@
+# Mark the plot a build will go on with gold edges.
+resource "minecraft_frame" "plot" {
+  material = "minecraft:gold_block"
+
+  from = {
+    x = 0
+    y = 64
+    z = 0
+  }
+  to = {
+    x = 31
+    y = 96
+    z = 31
+  }
+}
@@ -0,0 +1,9 @@
+resource "minecraft_give_enchanted" "sword" {
+  player = "Steve"
+  item   = "minecraft:diamond_sword"
+
+  enchantments = {
+    "minecraft:sharpness"  = 5
+    "minecraft:unbreaking" = 3
+  }
+}
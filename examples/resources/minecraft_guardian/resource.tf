@@ -0,0 +1,11 @@
+# An elder guardian that stays with the monument build.
+resource "minecraft_guardian" "monument_keeper" {
+  position = {
+    x = 100.5
+    y = 45
+    z = -200.5
+  }
+
+  elder      = true
+  persistent = true
+}
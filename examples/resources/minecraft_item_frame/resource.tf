@@ -0,0 +1,11 @@
+resource "minecraft_item_frame" "trophy" {
+  facing   = "south"
+  item     = "minecraft:diamond_sword"
+  glow     = true
+  rotation = 1
+  position = {
+    x = 4
+    y = 65
+    z = -2
+  }
+}
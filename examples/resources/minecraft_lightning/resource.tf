@@ -0,0 +1,14 @@
+resource "minecraft_lightning" "opening_ceremony" {
+  position = {
+    x = 0
+    y = 64
+    z = 0
+  }
+
+  cosmetic = true
+
+  # Bump to strike again.
+  triggers = {
+    round = "1"
+  }
+}
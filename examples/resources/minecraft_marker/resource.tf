@@ -0,0 +1,8 @@
+resource "minecraft_marker" "arena_spawn" {
+  data = "{role:\"spawn\",team:1}"
+  position = {
+    x = 12
+    y = 64
+    z = -30
+  }
+}
@@ -0,0 +1,5 @@
+# Requires a Bukkit/Paper server; vanilla has no setmaxplayers command.
+resource "minecraft_max_players" "this" {
+  max_players         = 50
+  default_max_players = 20
+}
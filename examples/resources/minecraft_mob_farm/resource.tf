@@ -0,0 +1,13 @@
+resource "minecraft_mob_farm" "blaze" {
+  mob = "minecraft:blaze"
+  position = {
+    x = 40
+    y = 70
+    z = -12
+  }
+
+  # Optional tuning; these override the farm defaults.
+  min_delay    = 80
+  max_delay    = 160
+  player_range = 24
+}
@@ -0,0 +1,10 @@
+resource "minecraft_mob_group" "herd" {
+  type  = "minecraft:cow"
+  count = 12
+
+  position = {
+    x = 0
+    y = 64
+    z = 0
+  }
+}
@@ -0,0 +1,4 @@
+# Requires a Bukkit/Paper plugin that provides setmotd; vanilla can't change the MOTD over RCON.
+resource "minecraft_motd" "this" {
+  motd = "§aWelcome to the Terraform-managed server"
+}
@@ -0,0 +1,17 @@
+resource "minecraft_move" "house" {
+  from = {
+    x = 0
+    y = 64
+    z = 0
+  }
+  to = {
+    x = 9
+    y = 72
+    z = 9
+  }
+  destination = {
+    x = 20
+    y = 64
+    z = 0
+  }
+}
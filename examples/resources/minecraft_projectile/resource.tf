@@ -0,0 +1,17 @@
+resource "minecraft_projectile" "cannon" {
+  entity = "minecraft:snowball"
+  position = {
+    x = 10
+    y = 80
+    z = 10
+  }
+  motion = {
+    x = 1.5
+    y = 0.8
+    z = 0
+  }
+
+  triggers = {
+    shot = "1"
+  }
+}
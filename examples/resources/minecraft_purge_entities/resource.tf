@@ -0,0 +1,13 @@
+resource "minecraft_purge_entities" "clear_spawn" {
+  entity_type = "minecraft:zombie"
+  center = {
+    x = 0
+    y = 64
+    z = 0
+  }
+  radius = 32
+
+  triggers = {
+    run = "1"
+  }
+}
@@ -0,0 +1,19 @@
+resource "minecraft_marker" "spawn" {
+  position = {
+    x = 10
+    y = 64
+    z = -20
+  }
+}
+
+# A glowstone block under the marker
+resource "minecraft_relative_block" "light" {
+  material = "minecraft:glowstone"
+  entity   = minecraft_marker.spawn.id
+
+  offset = {
+    x = 0
+    y = -1
+    z = 0
+  }
+}
@@ -0,0 +1,5 @@
+resource "minecraft_reload" "datapacks" {
+  triggers = {
+    pack = filesha256("${path.module}/datapacks/my_pack.zip")
+  }
+}
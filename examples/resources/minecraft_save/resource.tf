@@ -0,0 +1,9 @@
+resource "minecraft_save" "after_build" {
+  pause_autosave = true
+
+  triggers = {
+    build = minecraft_fill.floor.id
+  }
+
+  depends_on = [minecraft_fill.floor]
+}
@@ -0,0 +1,8 @@
+# Add Steve's kills to the red team's running total.
+resource "minecraft_score_operation" "red_total" {
+  target           = "#red"
+  target_objective = "team_kills"
+  operation        = "+="
+  source           = "Steve"
+  source_objective = "kills"
+}
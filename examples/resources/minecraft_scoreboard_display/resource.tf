@@ -0,0 +1,10 @@
+resource "minecraft_scoreboard_display" "sidebar" {
+  slot      = "sidebar"
+  objective = "kills"
+}
+
+# Only players on the red team see this one.
+resource "minecraft_scoreboard_display" "red_sidebar" {
+  slot      = "sidebar.team.red"
+  objective = "red_kills"
+}
@@ -0,0 +1,6 @@
+resource "minecraft_scoreboard_objective" "health" {
+  name         = "hp"
+  criterion    = "health"
+  display_name = "Health"
+  render_type  = "hearts"
+}
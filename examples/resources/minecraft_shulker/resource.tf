@@ -0,0 +1,12 @@
+# A red shulker half open on the wall of an End city tower.
+resource "minecraft_shulker" "turret" {
+  position = {
+    x = 300
+    y = 80
+    z = -150
+  }
+
+  color       = "red"
+  attach_face = "east"
+  peek        = 50
+}
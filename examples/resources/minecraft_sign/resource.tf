@@ -0,0 +1,11 @@
+resource "minecraft_sign" "welcome" {
+  material = "minecraft:oak_sign[rotation=8]"
+  position = {
+    x = 10
+    y = 64
+    z = -5
+  }
+
+  front_lines = ["Welcome to", "Spawn", "", "Managed by Terraform"]
+  back_lines  = ["Shops this way ->"]
+}
@@ -0,0 +1,18 @@
+resource "minecraft_structure_save" "house" {
+  name = "terraform:house"
+  from = {
+    x = 100
+    y = 64
+    z = 100
+  }
+  to = {
+    x = 111
+    y = 75
+    z = 109
+  }
+
+  # Bump to re-save after editing the house in game.
+  triggers = {
+    revision = "1"
+  }
+}
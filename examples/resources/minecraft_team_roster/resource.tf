@@ -0,0 +1,10 @@
+resource "minecraft_team" "red" {
+  display_name = "Red Team"
+  name         = "red"
+  color        = "red"
+}
+
+resource "minecraft_team_roster" "red" {
+  team    = minecraft_team.red.name
+  players = ["Steve", "Alex"]
+}
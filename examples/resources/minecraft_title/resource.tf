@@ -0,0 +1,19 @@
+resource "minecraft_title" "round" {
+  target = "@a"
+  text   = "Round ${var.round}"
+  stay   = 100
+
+  triggers = {
+    round = var.round
+  }
+}
+
+resource "minecraft_title" "hint" {
+  target = "@a"
+  type   = "actionbar"
+  text   = "Capture the flag!"
+
+  triggers = {
+    round = var.round
+  }
+}
@@ -0,0 +1,6 @@
+# Players run `/trigger menu set 1` (usually via a clickable chat link).
+resource "minecraft_trigger_objective" "menu" {
+  name         = "menu"
+  display_name = "Menu"
+  targets      = ["@a"]
+}
@@ -0,0 +1,9 @@
+resource "minecraft_world_normalize" "every_apply" {
+  set_time       = "day"
+  set_weather    = "clear"
+  set_difficulty = "normal"
+
+  triggers = {
+    run = timestamp()
+  }
+}
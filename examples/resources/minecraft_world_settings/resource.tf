@@ -0,0 +1,6 @@
+resource "minecraft_world_settings" "lobby" {
+  difficulty       = "peaceful"
+  default_gamemode = "adventure"
+  time             = "noon"
+  weather          = "clear"
+}
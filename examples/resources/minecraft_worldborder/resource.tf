@@ -0,0 +1,10 @@
+# Changing var.arena_size moves the border over two minutes
+resource "minecraft_worldborder" "arena" {
+  size        = var.arena_size
+  resize_time = 120
+
+  center = {
+    x = 0.5
+    z = 0.5
+  }
+}
@@ -0,0 +1,14 @@
+# Drop 50 XP onto the farm's collection point
+resource "minecraft_xp_orb" "test" {
+  value = 50
+
+  position = {
+    x = 100
+    y = 64
+    z = -40
+  }
+
+  triggers = {
+    run = "1"
+  }
+}
@@ -2,26 +2,127 @@ package minecraft
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/seeruk/minecraft-rcon/rcon"
 )
 
+// Client wraps a single authenticated RCON connection. It is safe for
+// concurrent use: RCON has no request IDs we can rely on to pair replies, so
+// commands are serialized over the connection. Parallel resources therefore
+// queue behind each other; that costs throughput on large applies but keeps
+// every response matched to the command that produced it.
 type Client struct {
-	client *rcon.Client
+	client commandSender
+
+	// mu serializes commands on the connection. It's a pointer so the
+	// value-receiver methods all share the same lock.
+	mu *sync.Mutex
+
+	// broken is set to 1 once a send fails on the connection itself. Like mu,
+	// it is shared by copies of the client.
+	broken *int32
+
+	// commandTimeout bounds each command when the caller's context has no deadline.
+	commandTimeout time.Duration
+
+	// retries is how many more times a command is sent after a transient failure.
+	retries         int
+	transientErrors []string
+
+	// limiter, if set, paces commands. It may be shared by several clients.
+	limiter *RateLimiter
+
+	// version is the server release, e.g. {1, 20, 4}. Zero means unknown,
+	// which is treated as a current release.
+	version [3]int
+}
+
+// commandSender is the RCON connection a Client sends over. *rcon.Client is
+// the real one; tests use a fake.
+type commandSender interface {
+	SendCommand(command string) (string, error)
+}
+
+// DefaultTransientErrors are reply substrings that mean the server didn't run
+// the command and asks to try again shortly, typically seen while a freshly
+// started server loads its world.
+var DefaultTransientErrors = []string{
+	"server is still starting",
+	"still loading",
+}
+
+// Base delay between retries; doubled after each attempt up to retryMaxDelay.
+const (
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 8 * time.Second
+)
+
+// RateLimiter spaces commands evenly at a fixed rate: a token bucket holding a
+// single token. One limiter can be shared by every client for a server so that
+// parallel resources together stay under the rate.
+type RateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// NewRateLimiter allows perSecond commands per second. It returns nil, which
+// never waits, when perSecond is zero or negative.
+func NewRateLimiter(perSecond float64) *RateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	return &RateLimiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// Wait blocks until the caller may send a command or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 type Player struct {
 }
 
 func New(address string, password string) (*Client, error) {
-	addressParts := strings.Split(address, ":")
-	host := addressParts[0]
-	port, err := strconv.Atoi(addressParts[1])
+	host, port, err := SplitAddress(address)
 	if err != nil {
-		return nil, fmt.Errorf("invalid port %s", addressParts[1])
+		return nil, err
 	}
 
 	client, err := rcon.NewClient(host, port, password)
@@ -29,167 +130,306 @@ func New(address string, password string) (*Client, error) {
 		return nil, err
 	}
 
-	return &Client{client}, nil
-}
-
-// Get a player.
-func (c Client) GetPlayer(ctx context.Context, name string) error {
-	return nil
+	return newClient(client), nil
 }
 
-// Creates a block.
-func (c Client) CreateBlock(ctx context.Context, material string, x, y, z int) error {
-	command := fmt.Sprintf("setblock %d %d %d %s replace", x, y, z, material)
-	_, err := c.client.SendCommand(command)
+// SplitAddress splits an RCON address such as "localhost:25575" into the host
+// and port New connects to.
+func SplitAddress(address string) (string, int, error) {
+	host, portText, err := net.SplitHostPort(address)
 	if err != nil {
-		return err
+		return "", 0, fmt.Errorf("address must look like host:port (got %q)", address)
+	}
+	port, err := strconv.Atoi(portText)
+	if err != nil || port < 1 || port > 65535 {
+		return "", 0, fmt.Errorf("invalid port %s", portText)
 	}
+	return host, port, nil
+}
 
-	return nil
+// newClient wraps an authenticated connection.
+func newClient(conn commandSender) *Client {
+	return &Client{client: conn, mu: &sync.Mutex{}, broken: new(int32), transientErrors: DefaultTransientErrors}
 }
 
-// Deletes a block.
-func (c Client) DeleteBlock(ctx context.Context, x, y, z int) error {
-	command := fmt.Sprintf("setblock %d %d %d minecraft:air replace", x, y, z)
-	_, err := c.client.SendCommand(command)
-	if err != nil {
-		return err
-	}
+// Broken reports whether a command failed on the connection itself, e.g.
+// because the server restarted. A broken client should be replaced by a new
+// connection; a command the server merely rejected doesn't break it.
+func (c Client) Broken() bool {
+	return atomic.LoadInt32(c.broken) != 0
+}
 
-	return nil
+// SetCommandTimeout sets the default deadline applied to each command whose
+// context doesn't already carry one. Zero disables the default.
+func (c *Client) SetCommandTimeout(d time.Duration) {
+	c.commandTimeout = d
 }
 
-// CreateStairs places a stairs block (e.g., "minecraft:oak_stairs") with orientation.
-func (c Client) CreateStairs(ctx context.Context, material string, x, y, z int, facing, half, shape string, waterlogged bool) error {
-	cmd := fmt.Sprintf(
-		`setblock %d %d %d %s[facing=%s,half=%s,shape=%s,waterlogged=%t] replace`,
-		x, y, z, material, facing, half, shape, waterlogged,
-	)
-	_, err := c.client.SendCommand(cmd)
-	return err
+// SetCommandRetries sets how many times a command is retried, with
+// exponential backoff, when the server replies that it isn't ready or the
+// command couldn't be sent. Zero disables retries.
+func (c *Client) SetCommandRetries(n int) {
+	c.retries = n
 }
 
-// Creates an entity.
-func (c Client) CreateEntity(ctx context.Context, entity string, position string, id string) error {
-	command := fmt.Sprintf("summon %s %s {CustomName:'{\"text\":\"%s\"}'}", entity, position, id)
-	_, err := c.client.SendCommand(command)
-	if err != nil {
-		return err
-	}
+// SetRateLimiter paces every command sent by this client through l. A nil
+// limiter disables throttling.
+func (c *Client) SetRateLimiter(l *RateLimiter) {
+	c.limiter = l
+}
 
-	return nil
+// SetVersion tells the client which release the server runs, so commands
+// whose syntax changed between versions are built to match.
+func (c *Client) SetVersion(v [3]int) {
+	c.version = v
 }
 
-// CreateZombie summons a zombie with common zombie-specific NBT attributes.
-func (c Client) CreateZombie(
-	ctx context.Context,
-	position string,
-	id string,
-	isBaby bool,
-	canBreakDoors bool,
-	canPickUpLoot bool,
-	persistenceRequired bool,
-	health float32,
-) error {
-	// Helper to convert Go bool → NBT byte (0b / 1b)
-	boolToByte := func(b bool) int {
-		if b {
+// versionAtLeast reports whether the server is major.minor.patch or newer.
+// An unknown version counts as a current release.
+func (c Client) versionAtLeast(major, minor, patch int) bool {
+	if c.version == [3]int{} {
+		return true
+	}
+	return CompareVersions(c.version, [3]int{major, minor, patch}) >= 0
+}
+
+// CompareVersions returns -1, 0 or 1 as a is older than, the same as or
+// newer than b.
+func CompareVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
 			return 1
 		}
-		return 0
 	}
+	return 0
+}
 
-	isBabyVal := boolToByte(isBaby)
-	canBreakDoorsVal := boolToByte(canBreakDoors)
-	canPickUpLootVal := boolToByte(canPickUpLoot)
-	persistenceRequiredVal := boolToByte(persistenceRequired)
-
-	// Build summon command for zombie
-	// Common zombie NBT tags:
-	// - IsBaby (byte): 1b if baby, 0b if adult
-	// - CanBreakDoors (byte): 1b to allow breaking doors
-	// - CanPickUpLoot (byte): 1b to allow picking up items
-	// - PersistenceRequired (byte): 1b to prevent despawn
-	// - Health (float): current health (default full health is 20.0f)
-	command := fmt.Sprintf(
-		`summon zombie %s {CustomName:'{"text":"%s"}',IsBaby:%db,CanBreakDoors:%db,CanPickUpLoot:%db,PersistenceRequired:%db,Health:%ff}`,
-		position,
-		id,
-		isBabyVal,
-		canBreakDoorsVal,
-		canPickUpLootVal,
-		persistenceRequiredVal,
-		health,
-	)
+// ParseVersion splits a release such as "1.20.4" or "1.21" into numeric
+// parts; a missing patch number is 0.
+func ParseVersion(v string) ([3]int, error) {
+	var parts [3]int
+	fields := strings.Split(strings.TrimSpace(v), ".")
+	if len(fields) < 2 || len(fields) > 3 {
+		return parts, fmt.Errorf("version must look like 1.20 or 1.20.4 (got %q)", v)
+	}
+	for i, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return parts, fmt.Errorf("version must look like 1.20 or 1.20.4 (got %q)", v)
+		}
+		parts[i] = n
+	}
+	return parts, nil
+}
 
-	_, err := c.client.SendCommand(command)
+// GetVersion asks the server which release it runs using `version`, which
+// Paper and Spigot provide and vanilla only has from 1.21.6. It returns the
+// release, e.g. "1.20.4", or an error when the reply names none.
+func (c Client) GetVersion(ctx context.Context) (string, error) {
+	out, err := c.send(ctx, "version")
 	if err != nil {
-		return err
+		return "", err
 	}
-
-	return nil
+	return parseVersionReply(out)
 }
 
-// Create Sheep
-func (c Client) CreateSheep(ctx context.Context, position string, id string, color string, sheared bool) error {
-	// Map sheep colors to their NBT integer values
-	colorMap := map[string]int{
-		"white":      0,
-		"orange":     1,
-		"magenta":    2,
-		"light_blue": 3,
-		"yellow":     4,
-		"lime":       5,
-		"pink":       6,
-		"gray":       7,
-		"light_gray": 8,
-		"cyan":       9,
-		"purple":     10,
-		"blue":       11,
-		"brown":      12,
-		"green":      13,
-		"red":        14,
-		"black":      15,
+// Releases in `version` replies, e.g.
+//
+//	This server is running Paper version git-Paper-496 (MC: 1.20.4) (Implementing API version 1.20.4-R0.1-SNAPSHOT)
+var (
+	mcVersionPattern = regexp.MustCompile(`\(MC: (1\.\d+(?:\.\d+)?)\)`)
+	versionPattern   = regexp.MustCompile(`\b(1\.\d+(?:\.\d+)?)\b`)
+)
+
+// parseVersionReply picks the release out of a `version` reply, preferring
+// Bukkit's "(MC: x)" over the first version-looking number.
+func parseVersionReply(out string) (string, error) {
+	for _, re := range []*regexp.Regexp{mcVersionPattern, versionPattern} {
+		if m := re.FindStringSubmatch(out); m != nil {
+			return m[1], nil
+		}
 	}
+	return "", fmt.Errorf("no release version in reply: %s", strings.TrimSpace(out))
+}
 
-	// Default to white if invalid color
-	colorVal, ok := colorMap[color]
-	if !ok {
-		colorVal = 0
+// SetTransientErrors replaces the substrings (matched case-insensitively
+// against the reply) that make a command eligible for retry.
+func (c *Client) SetTransientErrors(substrings []string) {
+	c.transientErrors = substrings
+}
+
+// send runs a command, retrying transient failures up to c.retries times.
+// The command timeout applies to each attempt; ctx bounds the whole call.
+//
+// Only failures where the server can't have run the command are retried:
+// a reply saying it isn't ready yet, or an attempt that timed out before the
+// command was sent. A timeout or broken connection after sending may still
+// have run it, and commands such as summon must not run twice.
+func (c Client) send(ctx context.Context, command string) (string, error) {
+	if c.retries == 0 {
+		return c.sendOnce(ctx, command)
 	}
+	delay := retryBaseDelay
+	for attempt := 0; ; attempt++ {
+		out, err := c.sendOnce(ctx, command)
+		if attempt >= c.retries || ctx.Err() != nil || !c.isTransient(out, err) {
+			if err == nil && c.isTransient(out, nil) {
+				err = fmt.Errorf("server not ready after %d attempts: %s", attempt+1, out)
+			}
+			return out, err
+		}
 
-	// Convert sheared bool → NBT-friendly value
-	shearedVal := 0
-	if sheared {
-		shearedVal = 1
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+		if delay *= 2; delay > retryMaxDelay {
+			delay = retryMaxDelay
+		}
 	}
+}
 
-	// Build summon command
-	command := fmt.Sprintf(
-		`summon sheep %s {CustomName:'{"text":"%s"}',Color:%d,Sheared:%db}`,
-		position, id, colorVal, shearedVal,
-	)
+// Ping runs `list` once, without retries, and returns how long the round
+// trip took. It checks the connection and password are still good.
+func (c Client) Ping(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	if _, err := c.sendOnce(ctx, "list"); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
 
-	_, err := c.client.SendCommand(command)
+// isTransient reports whether an attempt can safely be retried: the reply
+// matches a transient substring, or the command was never sent.
+func (c Client) isTransient(out string, err error) bool {
 	if err != nil {
-		return err
+		var notSent notSentError
+		return errors.As(err, &notSent)
+	}
+	text := strings.ToLower(out)
+	for _, sub := range c.transientErrors {
+		if sub != "" && strings.Contains(text, strings.ToLower(sub)) {
+			return true
+		}
+	}
+	return false
+}
+
+// notSentError wraps a failure that happened before the command reached the
+// server, such as the attempt timing out while queued behind another command.
+type notSentError struct{ err error }
+
+func (e notSentError) Error() string { return e.err.Error() }
+func (e notSentError) Unwrap() error { return e.err }
+
+// sendOnce runs a command over RCON, returning early with ctx.Err() if the context
+// is cancelled or its deadline passes before the server answers.
+func (c Client) sendOnce(ctx context.Context, command string) (string, error) {
+	if _, ok := ctx.Deadline(); !ok && c.commandTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.commandTimeout)
+		defer cancel()
+	}
+	if err := ctx.Err(); err != nil {
+		return "", notSentError{err}
 	}
+	if err := c.limiter.Wait(ctx); err != nil {
+		return "", notSentError{err}
+	}
+
+	type result struct {
+		out string
+		err error
+	}
+	// state moves from queued to either sent, once the goroutine holds the
+	// lock, or abandoned, once the caller stops waiting. Whichever side moves
+	// it first decides whether the command runs.
+	const (
+		queued int32 = iota
+		sent
+		abandoned
+	)
+	var state int32
+	done := make(chan result, 1)
+	go func() {
+		// The lock is held until the server answers, even if the caller gave
+		// up waiting, so an abandoned reply can't be read by the next command.
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if err := ctx.Err(); err != nil || !atomic.CompareAndSwapInt32(&state, queued, sent) {
+			// Cancelled while queued; don't run it late.
+			done <- result{"", notSentError{ctx.Err()}}
+			return
+		}
+		out, err := c.client.SendCommand(command)
+		if err != nil {
+			atomic.StoreInt32(c.broken, 1)
+		}
+		done <- result{out, err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.out, res.err
+	case <-ctx.Done():
+		if atomic.CompareAndSwapInt32(&state, queued, abandoned) {
+			return "", notSentError{ctx.Err()}
+		}
+		return "", ctx.Err()
+	}
+}
 
+// Get a player.
+func (c Client) GetPlayer(ctx context.Context, name string) error {
 	return nil
 }
 
-// Deletes an entity.
-func (c Client) DeleteEntity(ctx context.Context, entity string, position string, id string) error {
-	// Remove the entity.
-	command := fmt.Sprintf("kill @e[type=%s,nbt={CustomName:'{\"text\":\"%s\"}'}]", entity, id)
-	_, err := c.client.SendCommand(command)
+// Creates a block. Mode is one of replace, keep or destroy; empty means replace.
+func (c Client) CreateBlock(ctx context.Context, material string, x, y, z int, mode string) error {
+	command := fmt.Sprintf("setblock %d %d %d %s %s", x, y, z, material, setblockMode(mode))
+	return c.setblock(ctx, command, mode, x, y, z)
+}
+
+// ErrBlockNotPlaced is returned, wrapped, when a `keep` setblock left the
+// position alone because something other than air was already there.
+var ErrBlockNotPlaced = errors.New("block not placed: the position is not air")
+
+// setblock sends a setblock command. In keep mode the server answers "Could
+// not set the block" instead of placing anything when the position isn't air;
+// that is an error, so callers never record a block they didn't place. Other
+// modes give the same answer when the block is already what was asked for,
+// which is fine.
+func (c Client) setblock(ctx context.Context, command string, mode string, x, y, z int) error {
+	out, err := c.send(ctx, command)
 	if err != nil {
 		return err
 	}
+	if setblockMode(mode) == "keep" && strings.Contains(out, "Could not set the block") {
+		return fmt.Errorf("setblock at %d %d %d: %w", x, y, z, ErrBlockNotPlaced)
+	}
+	return nil
+}
+
+// setblockMode returns the /setblock placement mode, defaulting to replace.
+//   - replace: overwrite whatever is there
+//   - keep:    only place if the target is air
+//   - destroy: break the existing block (with drops) first
+func setblockMode(mode string) string {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode == "" {
+		return "replace"
+	}
+	return mode
+}
 
-	// Remove the entity from inventories.
-	command = fmt.Sprintf("clear @a %s{display:{Name:'{\"text\":\"%s\"}'}}", entity, id)
-	_, err = c.client.SendCommand(command)
+// Deletes a block.
+func (c Client) DeleteBlock(ctx context.Context, x, y, z int) error {
+	command := fmt.Sprintf("setblock %d %d %d minecraft:air replace", x, y, z)
+	_, err := c.send(ctx, command)
 	if err != nil {
 		return err
 	}
@@ -197,142 +437,194 @@ func (c Client) DeleteEntity(ctx context.Context, entity string, position string
 	return nil
 }
 
+// EditSignText rewrites the text on both sides of an existing sign (1.20+)
+// without replacing the block. Empty strings leave a line blank.
+func (c Client) EditSignText(ctx context.Context, x, y, z int, front [4]string, back [4]string) error {
+	_, err := c.send(ctx, editSignCommand(x, y, z, front, back))
+	return err
+}
 
-// GameMode names keyed by the numeric values returned by Minecraft.
-var gameModeNames = map[int]string{
-	0: "survival",
-	1: "creative",
-	2: "adventure",
-	3: "spectator",
+func editSignCommand(x, y, z int, front, back [4]string) string {
+	return fmt.Sprintf("data merge block %d %d %d %s", x, y, z, SignTextNBT(front, back))
 }
-///data get storage minecraft:server worldDefaultGameMode
-// GetDefaultGameMode queries the server for the world’s default game mode
-// and returns it as a lowercase string (e.g. "creative").
-func (c Client) GetDefaultGameMode(ctx context.Context) (string, error) {
-	out, err := c.client.SendCommand(`/data get storage minecraft:server worldDefaultGameMode`)
-	if err != nil {
-		return "", fmt.Errorf("send command: %w", err)
+
+// SignTextNBT returns the block entity NBT holding both sides of a sign's text.
+func SignTextNBT(front, back [4]string) string {
+	return fmt.Sprintf("{front_text:{messages:%s},back_text:{messages:%s}}", signMessagesNBT(front), signMessagesNBT(back))
+}
+
+// signMessagesNBT renders sign lines as a list of single-quoted JSON text components.
+func signMessagesNBT(lines [4]string) string {
+	escape := strings.NewReplacer(`\`, `\\\\`, `"`, `\\"`, `'`, `\'`)
+	parts := make([]string, len(lines))
+	for i, line := range lines {
+		parts[i] = fmt.Sprintf(`'{"text":"%s"}'`, escape.Replace(line))
 	}
-	// Typical output:
-	// Storage minecraft:server has the following data: {worldDefaultGameMode:1}
+	return "[" + strings.Join(parts, ",") + "]"
+}
 
-	// Find the last colon and take everything after it.
-	parts := strings.Split(out, ":")
-	if len(parts) < 2 {
-		return "", fmt.Errorf("unexpected response: %q", out)
+// BlockPlacement is one block for PlaceBlocks; Block may carry states and NBT.
+type BlockPlacement struct {
+	X, Y, Z int
+	Block   string
+}
+
+// PlaceBlocks places blocks in order. If one fails, the blocks already placed
+// are cleared to air in reverse order, so a half-built group isn't left behind.
+func (c Client) PlaceBlocks(ctx context.Context, blocks []BlockPlacement) error {
+	for i, b := range blocks {
+		if _, err := c.send(ctx, fmt.Sprintf("setblock %d %d %d %s replace", b.X, b.Y, b.Z, b.Block)); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				_ = c.DeleteBlock(ctx, blocks[j].X, blocks[j].Y, blocks[j].Z)
+			}
+			return fmt.Errorf("placing %s at %d %d %d: %w", b.Block, b.X, b.Y, b.Z, err)
+		}
 	}
-	numStr := strings.TrimRight(strings.TrimSpace(parts[len(parts)-1]), "}")
-	id, err := strconv.Atoi(numStr)
+	return nil
+}
+
+// SetBlockWithNBT places material with the given block states, then merges
+// nbt into its block entity. Material may carry its own [states] only when
+// states is empty. If the merge fails the block is cleared to air again, so a
+// block entity without its data isn't left behind. An empty nbt skips the merge.
+func (c Client) SetBlockWithNBT(ctx context.Context, material string, x, y, z int, states map[string]string, nbt string) error {
+	block, err := blockWithStates(material, states)
 	if err != nil {
-		return "", fmt.Errorf("parse int: %w", err)
+		return err
 	}
-	name, ok := gameModeNames[id]
-	if !ok {
-		return "", fmt.Errorf("unknown game mode id %d", id)
+	if _, err := c.send(ctx, fmt.Sprintf("setblock %d %d %d %s replace", x, y, z, block)); err != nil {
+		return fmt.Errorf("placing %s at %d %d %d: %w", block, x, y, z, err)
 	}
-	return name, nil
+	if nbt == "" {
+		return nil
+	}
+	if _, err := c.send(ctx, fmt.Sprintf("data merge block %d %d %d %s", x, y, z, nbt)); err != nil {
+		_ = c.DeleteBlock(ctx, x, y, z)
+		return fmt.Errorf("merging data into %s at %d %d %d: %w", block, x, y, z, err)
+	}
+	return nil
 }
 
-// GetUserGameMode runs `/data get entity <name> playerGameType`
-// and returns the player's current game mode as a lowercase string
-// ("survival", "creative", "adventure", or "spectator").
-func (c Client) GetUserGameMode(ctx context.Context, name string) (string, error) {
-	out, err := c.client.SendCommand(fmt.Sprintf(`/data get entity %s playerGameType`, name))
-	if err != nil {
-		return "", fmt.Errorf("send command: %w", err)
+// blockWithStates appends states to material as [k=v,...], sorted by key so
+// the command is stable.
+func blockWithStates(material string, states map[string]string) (string, error) {
+	if len(states) == 0 {
+		return material, nil
 	}
-	// Look for the final colon and grab everything after it.
-	parts := strings.Split(out, ":")
-	if len(parts) < 2 {
-		return "", fmt.Errorf("unexpected response: %q", out)
+	if strings.Contains(material, "[") {
+		return "", fmt.Errorf("material %q already has block states; pass them in one place", material)
 	}
-	numStr := strings.TrimSpace(parts[len(parts)-1])
-	id, err := strconv.Atoi(numStr)
-	if err != nil {
-		return "", fmt.Errorf("parse int: %w", err)
+	keys := make([]string, 0, len(states))
+	for k := range states {
+		keys = append(keys, k)
 	}
-	nameStr, ok := gameModeNames[id]
-	if !ok {
-		return "", fmt.Errorf("unknown game mode id %d", id)
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + states[k]
 	}
-	return nameStr, nil
+	return material + "[" + strings.Join(parts, ",") + "]", nil
 }
 
-// Sets the default game mode
-func (c Client) SetDefaultGameMode(ctx context.Context, gamemode string) error {
-	var cmd string
-	cmd = fmt.Sprintf(`defaultgamemode %s`, gamemode)
-
-	_, err := c.client.SendCommand(cmd)
-	return err
+// SpawnerConfig tunes a mob spawner. Delays are in ticks; ranges in blocks.
+type SpawnerConfig struct {
+	MinDelay, MaxDelay int
+	SpawnRange         int
+	PlayerRange        int
 }
 
+// SpawnerBlock returns a spawner block argument for setblock that spawns mob (1.18+ SpawnData format).
+func SpawnerBlock(mob string, cfg SpawnerConfig) string {
+	return "minecraft:spawner" + spawnerNBT(mob, cfg)
+}
 
-// Sets the user game mode
-func (c Client) SetUserGameMode(ctx context.Context, gamemode string, name string) error {
-	var cmd string
-	cmd = fmt.Sprintf(`gamemode %s %s`, gamemode, name)
-
-	_, err := c.client.SendCommand(cmd)
+// UpdateSpawner merges a new mob and tuning into the spawner at x, y, z.
+func (c Client) UpdateSpawner(ctx context.Context, x, y, z int, mob string, cfg SpawnerConfig) error {
+	_, err := c.send(ctx, fmt.Sprintf("data merge block %d %d %d %s", x, y, z, spawnerNBT(mob, cfg)))
 	return err
 }
 
-func (c Client) EnableDayLock(ctx context.Context) error {
-    // 1) Lock the time to day
-    if _, err := c.client.SendCommand("daylock true"); err != nil {
-        return fmt.Errorf("daylock true failed: %w", err)
-    }
+func spawnerNBT(mob string, cfg SpawnerConfig) string {
+	return fmt.Sprintf(
+		`{SpawnData:{entity:{id:"%s"}},SpawnPotentials:[],Delay:%ds,MinSpawnDelay:%ds,MaxSpawnDelay:%ds,SpawnRange:%ds,RequiredPlayerRange:%ds}`,
+		mob, cfg.MinDelay, cfg.MinDelay, cfg.MaxDelay, cfg.SpawnRange, cfg.PlayerRange,
+	)
+}
 
-    // 2) Immediately set the world time to day
-    if _, err := c.client.SendCommand("time set day"); err != nil {
-        return fmt.Errorf("time set day failed: %w", err)
-    }
-	return nil
+// commandBlockKinds maps command block kinds to their block IDs.
+var commandBlockKinds = map[string]string{
+	"impulse":   "minecraft:command_block",
+	"chain":     "minecraft:chain_command_block",
+	"repeating": "minecraft:repeating_command_block",
 }
 
+// IsCommandBlockKind reports whether kind is impulse, chain or repeating.
+func IsCommandBlockKind(kind string) bool {
+	_, ok := commandBlockKinds[kind]
+	return ok
+}
 
-func (c Client) DisableDayLock(ctx context.Context) error {
-	var cmd string
-	cmd = fmt.Sprintf(`daylock true`)
-	_, err := c.client.SendCommand(cmd)
+// CreateCommandBlock places a command block of the given kind facing the given
+// direction, loaded with command. auto makes it run without redstone; a
+// conditional block only runs if the block behind it succeeded.
+func (c Client) CreateCommandBlock(ctx context.Context, kind string, x, y, z int, facing string, command string, auto, conditional bool) error {
+	block, err := commandBlockArg(kind, facing, command, auto, conditional)
+	if err != nil {
+		return err
+	}
+	_, err = c.send(ctx, fmt.Sprintf("setblock %d %d %d %s replace", x, y, z, block))
 	return err
 }
 
-// Creates operator status for the specified user name
-func (c Client) CreateOp(ctx context.Context, name string) error {
-	var cmd string
-	cmd = fmt.Sprintf(`op %s`, name)
-
-	_, err := c.client.SendCommand(cmd)
+// UpdateCommandBlock changes the command and auto flag of an existing command block in place.
+func (c Client) UpdateCommandBlock(ctx context.Context, x, y, z int, command string, auto bool) error {
+	_, err := c.send(ctx, fmt.Sprintf("data merge block %d %d %d %s", x, y, z, commandBlockNBT(command, auto)))
 	return err
 }
 
-// Removes operator status for the specified user name
-func (c Client) RemoveOp(ctx context.Context, name string) error {
-	var cmd string
-	cmd = fmt.Sprintf(`deop %s`, name)
-
-	_, err := c.client.SendCommand(cmd)
-	return err
+func commandBlockArg(kind, facing, command string, auto, conditional bool) (string, error) {
+	id, ok := commandBlockKinds[kind]
+	if !ok {
+		return "", fmt.Errorf("unsupported command block kind %q", kind)
+	}
+	return fmt.Sprintf("%s[facing=%s,conditional=%t]%s", id, facing, conditional, commandBlockNBT(command, auto)), nil
 }
 
-// Creates a team with a given name and optional display name.
-func (c Client) CreateTeam(ctx context.Context, name string, displayName string) error {
-	var cmd string
-	if displayName != "" {
-		cmd = fmt.Sprintf(`team add %s "%s"`, name, displayName)
-	} else {
-		cmd = fmt.Sprintf(`team add %s`, name)
+func commandBlockNBT(command string, auto bool) string {
+	autoByte := 0
+	if auto {
+		autoByte = 1
 	}
+	return fmt.Sprintf("{Command:%s,auto:%db}", quoteArg(command), autoByte)
+}
 
-	_, err := c.client.SendCommand(cmd)
-	return err
+// CreateStairs places a stairs block (e.g., "minecraft:oak_stairs") with orientation.
+func (c Client) CreateStairs(ctx context.Context, material string, x, y, z int, facing, half, shape string, waterlogged bool, mode string) error {
+	cmd := fmt.Sprintf(
+		`setblock %d %d %d %s[facing=%s,half=%s,shape=%s,waterlogged=%t] %s`,
+		x, y, z, material, facing, half, shape, waterlogged, setblockMode(mode),
+	)
+	return c.setblock(ctx, cmd, mode, x, y, z)
 }
 
-// Deletes a team by name.
-func (c Client) DeleteTeam(ctx context.Context, name string) error {
-	cmd := fmt.Sprintf("team remove %s", name)
-	_, err := c.client.SendCommand(cmd)
+// Creates an entity. noGravity keeps it floating where it was summoned and
+// invulnerable protects it from players and the environment. A nil rotation
+// leaves the default facing. extraTags are added to the tracking tag, and a
+// non-empty deathLootTable replaces the mob's drops.
+func (c Client) CreateEntity(ctx context.Context, entity string, position string, id string, name string, nameVisible bool, noGravity bool, invulnerable bool, silent bool, rotation *[2]float64, extraTags []string, deathLootTable string) error {
+	nbt := BuildMobNBT(MobNBTOptions{
+		ID:             id,
+		ExtraTags:      extraTags,
+		Name:           name,
+		NameVisible:    nameVisible,
+		NoGravity:      noGravity,
+		Invulnerable:   invulnerable,
+		Silent:         silent,
+		Rotation:       rotation,
+		DeathLootTable: deathLootTable,
+	})
+	command := fmt.Sprintf("summon %s %s %s", entity, position, nbt)
+	_, err := c.send(ctx, command)
 	if err != nil {
 		return err
 	}
@@ -340,305 +632,3372 @@ func (c Client) DeleteTeam(ctx context.Context, name string) error {
 	return nil
 }
 
-// --- New: Set options via /team modify
-// Color: e.g. white, gray, dark_gray, black, red, dark_red, gold, yellow, green, dark_green,
-// aqua, dark_aqua, blue, dark_blue, light_purple, dark_purple
-func (c Client) SetTeamColor(ctx context.Context, name, color string) error {
-	color = strings.ToLower(color)
-	_, err := c.client.SendCommand(fmt.Sprintf("team modify %s color %s", name, color))
+// MobNBTOptions are the summon NBT fields shared by mobs and the other
+// entities the provider summons. Zero values are left out, so the game's
+// defaults apply.
+type MobNBTOptions struct {
+	// Type is written as the id tag. A summon command names the entity
+	// itself, so only Passengers need it.
+	Type string
+
+	// ID is the tracking tag, always the first of Tags. ExtraTags follow it.
+	// Without an ID, entities that are found by group tag instead get only
+	// ExtraTags, and no CustomName unless Name is set.
+	ID        string
+	ExtraTags []string
+	// Name is the CustomName; without one the entity is named by ID.
+	Name        string
+	NameVisible bool
+
+	// Fields are mob-specific tags, e.g. IsBaby:1b, kept in the given order
+	// right after the identity tags.
+	Fields []string
+
+	PersistenceRequired bool
+	// Health is clamped by the summon to the default max health.
+	Health float32
+
+	// Equipment adds ArmorItems, HandItems and any drop chances.
+	// ItemComponents selects the 1.20.5+ item stack format.
+	Equipment      *Equipment
+	ItemComponents bool
+
+	NoGravity    bool
+	Invulnerable bool
+	// Silent mutes the mob, for farms that would otherwise flood players
+	// with sounds.
+	Silent   bool
+	NoAI     bool
+	Rotation *[2]float64
+	// DeathLootTable replaces the mob's drops.
+	DeathLootTable string
+
+	// Passengers ride the entity, each rendered like a summon of its own.
+	Passengers []MobNBTOptions
+}
+
+// BuildMobNBT renders the summon NBT compound for opts. Tags always come in
+// the same order: id, identity (Tags, CustomName, CustomNameVisible), Fields,
+// PersistenceRequired, Health, equipment, NoGravity, Invulnerable, Silent,
+// NoAI, Rotation, DeathLootTable, Passengers. For example
+//
+//	{Tags:["<id>"],CustomName:'{"text":"<id>"}',Health:30.000000f,NoAI:1b}
+func BuildMobNBT(opts MobNBTOptions) string {
+	var tags []string
+	if opts.Type != "" {
+		tags = append(tags, fmt.Sprintf(`id:"%s"`, opts.Type))
+	}
+	if opts.ID != "" {
+		tags = append(tags, taggedIdentityNBT(opts.ID, opts.Name, opts.NameVisible, opts.ExtraTags)...)
+	} else {
+		tags = append(tags, untrackedIdentityNBT(opts.Name, opts.NameVisible, opts.ExtraTags)...)
+	}
+	tags = append(tags, opts.Fields...)
+	if opts.PersistenceRequired {
+		tags = append(tags, "PersistenceRequired:1b")
+	}
+	tags = append(tags, healthNBT(opts.Health)...)
+	if opts.Equipment != nil {
+		tags = append(tags, equipmentNBT(*opts.Equipment, opts.ItemComponents)...)
+	}
+	tags = append(tags, noGravityNBT(opts.NoGravity)...)
+	tags = append(tags, invulnerableNBT(opts.Invulnerable)...)
+	if opts.Silent {
+		tags = append(tags, "Silent:1b")
+	}
+	if opts.NoAI {
+		tags = append(tags, "NoAI:1b")
+	}
+	tags = append(tags, rotationNBT(opts.Rotation)...)
+	tags = append(tags, deathLootTableNBT(opts.DeathLootTable)...)
+	if len(opts.Passengers) > 0 {
+		riders := make([]string, len(opts.Passengers))
+		for i, rider := range opts.Passengers {
+			riders[i] = BuildMobNBT(rider)
+		}
+		tags = append(tags, "Passengers:["+strings.Join(riders, ",")+"]")
+	}
+	return "{" + strings.Join(tags, ",") + "}"
+}
+
+// taggedIdentityNBT tags the entity with id so it can always be found again.
+// The CustomName carries the display name when one is given, otherwise the
+// id, which keeps CustomName-based lookups working for unnamed entities.
+// Extra scoreboard tags follow the id, e.g. Tags:["<id>","boss"], so
+// datapacks can select the entity; a repeat of the id in extraTags is dropped.
+func taggedIdentityNBT(id, name string, nameVisible bool, extraTags []string) []string {
+	customName := id
+	if name != "" {
+		customName = name
+	}
+
+	quoted := []string{fmt.Sprintf(`"%s"`, id)}
+	for _, t := range extraTags {
+		if t != id {
+			quoted = append(quoted, fmt.Sprintf(`"%s"`, t))
+		}
+	}
+
+	tags := []string{
+		fmt.Sprintf(`Tags:[%s]`, strings.Join(quoted, ",")),
+		customNameNBT(customName),
+	}
+	if nameVisible {
+		tags = append(tags, "CustomNameVisible:1b")
+	}
+	return tags
+}
+
+// untrackedIdentityNBT is the identity of an entity without an id: just the
+// given tags, if any, and the name, if one is given.
+func untrackedIdentityNBT(name string, nameVisible bool, tags []string) []string {
+	var out []string
+	if len(tags) > 0 {
+		quoted := make([]string, len(tags))
+		for i, t := range tags {
+			quoted[i] = fmt.Sprintf(`"%s"`, t)
+		}
+		out = append(out, fmt.Sprintf(`Tags:[%s]`, strings.Join(quoted, ",")))
+	}
+	if name != "" {
+		out = append(out, customNameNBT(name))
+		if nameVisible {
+			out = append(out, "CustomNameVisible:1b")
+		}
+	}
+	return out
+}
+
+// customNameNBT renders the CustomName tag for a plain text name.
+func customNameNBT(name string) string {
+	// JSON-escape for the text component, then escape again for the single-quoted SNBT string.
+	escaped := strings.NewReplacer(`\`, `\\\\`, `"`, `\\"`, `'`, `\'`).Replace(name)
+	return fmt.Sprintf(`CustomName:'{"text":"%s"}'`, escaped)
+}
+
+// nbtBool renders b as an NBT byte, 1b or 0b.
+func nbtBool(b bool) string {
+	if b {
+		return "1b"
+	}
+	return "0b"
+}
+
+// noGravityNBT returns the NoGravity tag when set, and nothing otherwise.
+func noGravityNBT(noGravity bool) []string {
+	if noGravity {
+		return []string{"NoGravity:1b"}
+	}
+	return nil
+}
+
+// healthNBT returns the Health tag when health is above zero, and nothing
+// otherwise, leaving the mob at its default full health.
+func healthNBT(health float32) []string {
+	if health > 0 {
+		return []string{fmt.Sprintf("Health:%ff", health)}
+	}
+	return nil
+}
+
+// invulnerableNBT returns the Invulnerable tag when set, and nothing otherwise.
+// Invulnerable entities still die to /kill, so tag-based deletes keep working.
+func invulnerableNBT(invulnerable bool) []string {
+	if invulnerable {
+		return []string{"Invulnerable:1b"}
+	}
+	return nil
+}
+
+// deathLootTableNBT returns the DeathLootTable tag when a table is set, and
+// nothing otherwise. The mob then drops that table's loot instead of its own.
+func deathLootTableNBT(table string) []string {
+	if table == "" {
+		return nil
+	}
+	return []string{fmt.Sprintf(`DeathLootTable:"%s"`, table)}
+}
+
+// rotationNBT returns the Rotation tag ([yaw, pitch] in degrees) when set,
+// and nothing otherwise.
+func rotationNBT(rotation *[2]float64) []string {
+	if rotation == nil {
+		return nil
+	}
+	return []string{"Rotation:" + nbtFloatList(rotation[:])}
+}
+
+// FormatPosition renders entity coordinates for a command, e.g. "0.5 64 0.5".
+// Whole numbers print without a fraction and large ones without an exponent,
+// which the command parser would reject.
+func FormatPosition(x, y, z float64) string {
+	format := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%s %s %s", format(x), format(y), format(z))
+}
+
+// entitySelectors matches an entity created by this provider both by tag and
+// by CustomName, so entities summoned before tagging (or renamed ones) are found.
+func entitySelectors(entity, id string) []string {
+	return []string{
+		SelectorByTag(entity, id),
+		fmt.Sprintf("@e[type=%s,nbt={CustomName:'{\"text\":\"%s\"}'}]", entity, id),
+	}
+}
+
+// Equipment describes what a summoned mob wears and holds. Empty item IDs
+// leave the slot empty; nil drop chances keep the game's defaults.
+type Equipment struct {
+	Feet, Legs, Chest, Head string
+	MainHand, OffHand       string
+
+	// Chances per slot in ArmorItems order (feet, legs, chest, head) and
+	// HandItems order (main, off). 0 never drops, 2 always drops undamaged.
+	ArmorDropChances *[4]float64
+	HandDropChances  *[2]float64
+}
+
+// CreateArmoredEntity summons an entity carrying the given equipment.
+// useComponents selects the 1.20.5+ item stack format (lowercase count).
+func (c Client) CreateArmoredEntity(ctx context.Context, entity, position, id, name string, nameVisible bool, eq Equipment, useComponents bool, noGravity bool, invulnerable bool, silent bool, rotation *[2]float64, extraTags []string, deathLootTable string) error {
+	nbt := BuildMobNBT(MobNBTOptions{
+		ID:             id,
+		ExtraTags:      extraTags,
+		Name:           name,
+		NameVisible:    nameVisible,
+		Equipment:      &eq,
+		ItemComponents: useComponents,
+		NoGravity:      noGravity,
+		Invulnerable:   invulnerable,
+		Silent:         silent,
+		Rotation:       rotation,
+		DeathLootTable: deathLootTable,
+	})
+	command := fmt.Sprintf("summon %s %s %s", entity, position, nbt)
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// itemStackNBT renders count of an item as an NBT stack; an empty item is "{}".
+// 1.20.5+ (useComponents) spells the count as an int named count.
+func itemStackNBT(item string, count int, useComponents bool) string {
+	if item == "" {
+		return "{}"
+	}
+	if useComponents {
+		return fmt.Sprintf(`{id:"%s",count:%d}`, item, count)
+	}
+	return fmt.Sprintf(`{id:"%s",Count:%db}`, item, count)
+}
+
+// equipmentNBT builds the equipment tags of the summon NBT, e.g.
+//
+//	ArmorItems:[{},{},{},{id:"minecraft:iron_helmet",count:1}],HandItems:[{},{}],ArmorDropChances:[0f,0f,0f,2f]
+func equipmentNBT(eq Equipment, useComponents bool) []string {
+	stack := func(item string) string {
+		return itemStackNBT(item, 1, useComponents)
+	}
+
+	tags := []string{
+		fmt.Sprintf("ArmorItems:[%s,%s,%s,%s]", stack(eq.Feet), stack(eq.Legs), stack(eq.Chest), stack(eq.Head)),
+		fmt.Sprintf("HandItems:[%s,%s]", stack(eq.MainHand), stack(eq.OffHand)),
+	}
+	if eq.ArmorDropChances != nil {
+		tags = append(tags, "ArmorDropChances:"+nbtFloatList(eq.ArmorDropChances[:]))
+	}
+	if eq.HandDropChances != nil {
+		tags = append(tags, "HandDropChances:"+nbtFloatList(eq.HandDropChances[:]))
+	}
+	return tags
+}
+
+// CreateZombie summons a zombie with common zombie-specific NBT attributes.
+// The zombie is tagged with id, so SelectorByTag finds it. A non-empty name
+// is shown above it at all times, as for a boss; otherwise it is named by id.
+func (c Client) CreateZombie(
+	ctx context.Context,
+	position string,
+	id string,
+	name string,
+	isBaby bool,
+	canBreakDoors bool,
+	canPickUpLoot bool,
+	persistenceRequired bool,
+	health float32,
+	noGravity bool,
+	invulnerable bool,
+	silent bool,
+	deathLootTable string,
+) error {
+	// Zombie-specific NBT tags, always given:
+	// - IsBaby (byte): 1b if baby, 0b if adult
+	// - CanBreakDoors (byte): 1b to allow breaking doors
+	// - CanPickUpLoot (byte): 1b to allow picking up items
+	nbt := BuildMobNBT(MobNBTOptions{
+		ID:          id,
+		Name:        name,
+		NameVisible: name != "",
+		Fields: []string{
+			fmt.Sprintf("IsBaby:%s", nbtBool(isBaby)),
+			fmt.Sprintf("CanBreakDoors:%s", nbtBool(canBreakDoors)),
+			fmt.Sprintf("CanPickUpLoot:%s", nbtBool(canPickUpLoot)),
+		},
+		PersistenceRequired: persistenceRequired,
+		Health:              health,
+		NoGravity:           noGravity,
+		Invulnerable:        invulnerable,
+		Silent:              silent,
+		DeathLootTable:      deathLootTable,
+	})
+	command := fmt.Sprintf("summon zombie %s %s", position, nbt)
+
+	_, err := c.send(ctx, command)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Create Sheep. A non-empty name is shown above the sheep at all times, and a
+// zero health leaves the sheep at its default full health.
+func (c Client) CreateSheep(ctx context.Context, position string, id string, name string, color string, sheared bool, health float32, noGravity bool, invulnerable bool, silent bool, deathLootTable string) error {
+	// Default to white if invalid color
+	colorVal, ok := dyeColorCode(color)
+	if !ok {
+		colorVal = 0
+	}
+
+	nbt := BuildMobNBT(MobNBTOptions{
+		ID:             id,
+		Name:           name,
+		NameVisible:    name != "",
+		Fields:         []string{fmt.Sprintf("Color:%d", colorVal), fmt.Sprintf("Sheared:%s", nbtBool(sheared))},
+		Health:         health,
+		NoGravity:      noGravity,
+		Invulnerable:   invulnerable,
+		Silent:         silent,
+		DeathLootTable: deathLootTable,
+	})
+	command := fmt.Sprintf("summon sheep %s %s", position, nbt)
+
+	_, err := c.send(ctx, command)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GuardianType returns the entity type of a guardian, or of an elder
+// guardian when elder is set.
+func GuardianType(elder bool) string {
+	if elder {
+		return "minecraft:elder_guardian"
+	}
+	return "minecraft:guardian"
+}
+
+// CreateGuardian summons a guardian, or an elder guardian. persistent keeps
+// it from despawning. Guardians summoned out of water flop around on land;
+// the game allows that, so only a failed summon (e.g. outside the world) is
+// an error.
+func (c Client) CreateGuardian(ctx context.Context, position, id string, elder, persistent bool) error {
+	nbt := BuildMobNBT(MobNBTOptions{ID: id, PersistenceRequired: persistent})
+	command := fmt.Sprintf("summon %s %s %s", GuardianType(elder), position, nbt)
+	out, err := c.send(ctx, command)
+	if err != nil {
+		return err
+	}
+	// e.g. "Invalid position for summon" or "Unable to summon entity"
+	lower := strings.ToLower(out)
+	if strings.Contains(lower, "invalid position") || strings.Contains(lower, "unable to summon") {
+		return fmt.Errorf("%s: %s", command, out)
+	}
+	return nil
+}
+
+// DyeColors are the 16 dye colors in the order of their numeric IDs, which
+// sheep and shulkers store as Color.
+var DyeColors = []string{
+	"white", "orange", "magenta", "light_blue", "yellow", "lime", "pink", "gray",
+	"light_gray", "cyan", "purple", "blue", "brown", "green", "red", "black",
+}
+
+func dyeColorCode(color string) (int, bool) {
+	for i, c := range DyeColors {
+		if c == color {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// ShulkerDefaultColor is the shulker color for "default", the undyed purple.
+const ShulkerDefaultColor = 16
+
+// ShulkerFaces are the block faces a shulker can attach to, in the order of
+// their AttachFace IDs.
+var ShulkerFaces = []string{"down", "up", "north", "south", "west", "east"}
+
+// MaxShulkerPeek is the Peek of a fully open shell.
+const MaxShulkerPeek = 100
+
+// shulkerNBT returns the Color, AttachFace and Peek tags. color is a dye
+// color or "default".
+func shulkerNBT(color, attachFace string, peek byte) ([]string, error) {
+	colorVal, ok := dyeColorCode(color)
+	if color == "default" {
+		colorVal, ok = ShulkerDefaultColor, true
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown shulker color %q", color)
+	}
+	face := -1
+	for i, f := range ShulkerFaces {
+		if f == attachFace {
+			face = i
+		}
+	}
+	if face < 0 {
+		return nil, fmt.Errorf("unknown attach face %q", attachFace)
+	}
+	if peek > MaxShulkerPeek {
+		return nil, fmt.Errorf("peek must be at most %d (got %d)", MaxShulkerPeek, peek)
+	}
+	return []string{
+		fmt.Sprintf("Color:%db", colorVal),
+		fmt.Sprintf("AttachFace:%db", face),
+		fmt.Sprintf("Peek:%db", peek),
+	}, nil
+}
+
+// CreateShulker summons a shulker of the given dye color (or "default"),
+// attached to the given face of its block and with its lid open peek
+// percent.
+func (c Client) CreateShulker(ctx context.Context, position, id string, color string, attachFace string, peek byte) error {
+	fields, err := shulkerNBT(color, attachFace, peek)
+	if err != nil {
+		return err
+	}
+	nbt := BuildMobNBT(MobNBTOptions{ID: id, Fields: fields})
+	_, err = c.send(ctx, fmt.Sprintf("summon minecraft:shulker %s %s", position, nbt))
+	return err
+}
+
+// DismountPassengers makes everything riding the named entity get off, so a
+// following kill only removes the vehicle itself. Requires 1.19.4+ (`execute on`).
+func (c Client) DismountPassengers(ctx context.Context, entity string, id string) error {
+	for _, sel := range entitySelectors(entity, id) {
+		if _, err := c.send(ctx, fmt.Sprintf("execute as %s on passengers run ride @s dismount", sel)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deletes an entity. An entity that is already gone (despawned, killed in
+// game) is not an error: "No entity was found" counts as success.
+//
+// Only the entity is killed. Entity types aren't item IDs, so there is no
+// matching inventory item for `clear` to remove.
+func (c Client) DeleteEntity(ctx context.Context, entity string, position string, id string) error {
+	for _, sel := range entitySelectors(entity, id) {
+		// Only transport errors matter; the reply is "Killed ..." or
+		// "No entity was found", and both leave the entity gone.
+		if _, err := c.send(ctx, fmt.Sprintf("kill %s", sel)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
+// GameMode names keyed by the numeric values returned by Minecraft.
+var gameModeNames = map[int]string{
+	0: "survival",
+	1: "creative",
+	2: "adventure",
+	3: "spectator",
+}
+///data get storage minecraft:server worldDefaultGameMode
+// GetDefaultGameMode queries the server for the world’s default game mode
+// and returns it as a lowercase string (e.g. "creative").
+func (c Client) GetDefaultGameMode(ctx context.Context) (string, error) {
+	out, err := c.send(ctx, `/data get storage minecraft:server worldDefaultGameMode`)
+	if err != nil {
+		return "", fmt.Errorf("send command: %w", err)
+	}
+	// Typical output:
+	// Storage minecraft:server has the following data: {worldDefaultGameMode:1}
+
+	// Find the last colon and take everything after it.
+	parts := strings.Split(out, ":")
+	if len(parts) < 2 {
+		return "", fmt.Errorf("unexpected response: %q", out)
+	}
+	numStr := strings.TrimRight(strings.TrimSpace(parts[len(parts)-1]), "}")
+	id, err := strconv.Atoi(numStr)
+	if err != nil {
+		return "", fmt.Errorf("parse int: %w", err)
+	}
+	name, ok := gameModeNames[id]
+	if !ok {
+		return "", fmt.Errorf("unknown game mode id %d", id)
+	}
+	return name, nil
+}
+
+// GetUserGameMode runs `/data get entity <name> playerGameType`
+// and returns the player's current game mode as a lowercase string
+// ("survival", "creative", "adventure", or "spectator").
+func (c Client) GetUserGameMode(ctx context.Context, name string) (string, error) {
+	out, err := c.send(ctx, fmt.Sprintf(`/data get entity %s playerGameType`, name))
+	if err != nil {
+		return "", fmt.Errorf("send command: %w", err)
+	}
+	// Look for the final colon and grab everything after it.
+	parts := strings.Split(out, ":")
+	if len(parts) < 2 {
+		return "", fmt.Errorf("unexpected response: %q", out)
+	}
+	numStr := strings.TrimSpace(parts[len(parts)-1])
+	id, err := strconv.Atoi(numStr)
+	if err != nil {
+		return "", fmt.Errorf("parse int: %w", err)
+	}
+	nameStr, ok := gameModeNames[id]
+	if !ok {
+		return "", fmt.Errorf("unknown game mode id %d", id)
+	}
+	return nameStr, nil
+}
+
+// Sets the default game mode
+func (c Client) SetDefaultGameMode(ctx context.Context, gamemode string) error {
+	var cmd string
+	cmd = fmt.Sprintf(`defaultgamemode %s`, c.gameModeArg(gamemode))
+
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+
+// gameModeArg returns mode as the gamemode commands take it: the name, or
+// before 1.13 (which dropped numeric modes) the numeric ID where known.
+func (c Client) gameModeArg(mode string) string {
+	if c.versionAtLeast(1, 13, 0) {
+		return mode
+	}
+	for id, name := range gameModeNames {
+		if strings.EqualFold(name, mode) {
+			return strconv.Itoa(id)
+		}
+	}
+	return mode
+}
+
+// Sets the user game mode
+func (c Client) SetUserGameMode(ctx context.Context, gamemode string, name string) error {
+	var cmd string
+	cmd = fmt.Sprintf(`gamemode %s %s`, c.gameModeArg(gamemode), name)
+
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+func (c Client) EnableDayLock(ctx context.Context) error {
+    // 1) Lock the time to day
+    if _, err := c.send(ctx, "daylock true"); err != nil {
+        return fmt.Errorf("daylock true failed: %w", err)
+    }
+
+    // 2) Immediately set the world time to day
+    if _, err := c.send(ctx, "time set day"); err != nil {
+        return fmt.Errorf("time set day failed: %w", err)
+    }
+	return nil
+}
+
+
+func (c Client) DisableDayLock(ctx context.Context) error {
+	var cmd string
+	cmd = fmt.Sprintf(`daylock true`)
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+// SetTime sets the world clock to a named time (day, noon, night, midnight) or a tick count.
+func (c Client) SetTime(ctx context.Context, value string) error {
+	_, err := c.send(ctx, fmt.Sprintf("time set %s", value))
+	return err
+}
+
+// SetWeather sets the weather to clear, rain or thunder.
+func (c Client) SetWeather(ctx context.Context, weather string) error {
+	_, err := c.send(ctx, fmt.Sprintf("weather %s", weather))
+	return err
+}
+
+// SaveAll writes the world to disk with `save-all`. flush waits until every
+// chunk is written, which is what backups need. It fails unless the server
+// confirms with "Saved the game".
+func (c Client) SaveAll(ctx context.Context, flush bool) error {
+	out, err := c.send(ctx, saveAllCommand(flush))
+	if err != nil {
+		return err
+	}
+	return checkSaveReply(out)
+}
+
+func saveAllCommand(flush bool) string {
+	if flush {
+		return "save-all flush"
+	}
+	return "save-all"
+}
+
+// checkSaveReply accepts replies such as
+// "Saving the game (this may take a moment!)Saved the game".
+func checkSaveReply(out string) error {
+	if strings.Contains(strings.ToLower(out), "saved the game") {
+		return nil
+	}
+	return fmt.Errorf("save not confirmed: %s", strings.TrimSpace(out))
+}
+
+// SaveOff stops the server saving the world automatically until SaveOn.
+// Running it while saving is already off is not an error.
+func (c Client) SaveOff(ctx context.Context) error {
+	_, err := c.send(ctx, "save-off")
+	return err
+}
+
+// SaveOn turns automatic saving back on after SaveOff.
+func (c Client) SaveOn(ctx context.Context) error {
+	_, err := c.send(ctx, "save-on")
+	return err
+}
+
+// Reload reloads datapacks, functions and loot tables with `reload`. The
+// server keeps the old data when the new set fails to load; that is
+// reported as an error.
+func (c Client) Reload(ctx context.Context) error {
+	out, err := c.send(ctx, "reload")
+	if err != nil {
+		return err
+	}
+	return checkReloadReply(out)
+}
+
+// checkReloadReply accepts "Reloading!" and rejects replies mentioning a
+// failure, such as "Failed to reload data, keeping old data".
+func checkReloadReply(out string) error {
+	if strings.Contains(strings.ToLower(out), "fail") {
+		return fmt.Errorf("reload failed: %s", strings.TrimSpace(out))
+	}
+	return nil
+}
+
+// EnableDatapack turns on a datapack by its list name, e.g. "file/mypack".
+// The name is quoted as is, so it must not contain `"` or `\`. Enabling an
+// already enabled pack is not an error.
+func (c Client) EnableDatapack(ctx context.Context, name string) error {
+	out, err := c.send(ctx, fmt.Sprintf(`datapack enable "%s"`, name))
+	if err != nil {
+		return err
+	}
+	return checkDatapackReply(out)
+}
+
+// DisableDatapack turns a datapack off. Disabling one that is already off is
+// not an error.
+func (c Client) DisableDatapack(ctx context.Context, name string) error {
+	out, err := c.send(ctx, fmt.Sprintf(`datapack disable "%s"`, name))
+	if err != nil {
+		return err
+	}
+	return checkDatapackReply(out)
+}
+
+// checkDatapackReply rejects "Unknown data pack 'file/x'"; other replies
+// ("Enabled pack ...", "Pack ... is already enabled!") mean the pack is in
+// the wanted state.
+func checkDatapackReply(out string) error {
+	if strings.Contains(strings.ToLower(out), "unknown data pack") {
+		return fmt.Errorf("%s", strings.TrimSpace(out))
+	}
+	return nil
+}
+
+// ListDatapacks lists the enabled and the available (installed but off)
+// datapacks by name, parsed from `datapack list`:
+//
+//	There are 2 data pack(s) enabled: [vanilla (built-in)], [file/mypack (world)]
+//	There are no more data packs available
+func (c Client) ListDatapacks(ctx context.Context) (enabled, available []string, err error) {
+	out, err := c.send(ctx, "datapack list")
+	if err != nil {
+		return nil, nil, err
+	}
+	return parseDatapackList(out)
+}
+
+var (
+	// datapackSectionPattern splits a `datapack list` reply into its
+	// "There are ... enabled/available" parts; RCON may join the lines.
+	datapackSectionPattern = regexp.MustCompile(`(?i)there are (?:no more |no )?(?:\d+ )?data packs?(?:\(s\))? (enabled|available)`)
+	// datapackEntryPattern matches one "[name (source)]" entry.
+	datapackEntryPattern = regexp.MustCompile(`\[([^\[\]]+?)(?: \([^()]*\))?\]`)
+)
+
+func parseDatapackList(out string) (enabled, available []string, err error) {
+	sections := datapackSectionPattern.FindAllStringSubmatchIndex(out, -1)
+	if len(sections) == 0 {
+		return nil, nil, fmt.Errorf("unexpected datapack list reply: %q", out)
+	}
+	enabled, available = []string{}, []string{}
+	for i, sec := range sections {
+		end := len(out)
+		if i+1 < len(sections) {
+			end = sections[i+1][0]
+		}
+		var names []string
+		for _, m := range datapackEntryPattern.FindAllStringSubmatch(out[sec[1]:end], -1) {
+			names = append(names, m[1])
+		}
+		if strings.EqualFold(out[sec[2]:sec[3]], "enabled") {
+			enabled = append(enabled, names...)
+		} else {
+			available = append(available, names...)
+		}
+	}
+	return enabled, available, nil
+}
+
+// GetTPS returns the ticks per second averaged over the last 1, 5 and 15
+// minutes, from the `tps` command of Paper and Spigot. 20 is a healthy
+// server. On vanilla, which has no such command, the error wraps
+// ErrUnsupported.
+func (c Client) GetTPS(ctx context.Context) (tps1, tps5, tps15 float64, err error) {
+	out, err := c.send(ctx, "tps")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return parseTPS(out)
+}
+
+// Color codes such as "§a" that Bukkit puts in front of each figure.
+var formattingCodePattern = regexp.MustCompile(`§.`)
+
+// parseTPS reads replies such as
+//
+//	§6TPS from last 1m, 5m, 15m: §a20.0, §a*20.0, §a19.87
+//
+// where "*" marks a figure capped at 20. Forks that add windows (e.g. "5s")
+// are handled by pairing each figure with its label.
+func parseTPS(out string) (tps1, tps5, tps15 float64, err error) {
+	text := formattingCodePattern.ReplaceAllString(out, "")
+	if isUnknownCommand(text) {
+		return 0, 0, 0, fmt.Errorf("tps: %w", ErrUnsupported)
+	}
+	bad := fmt.Errorf("unexpected tps reply: %q", out)
+	start := strings.Index(text, "last ")
+	colon := strings.Index(text, ":")
+	if start < 0 || colon < start {
+		return 0, 0, 0, bad
+	}
+	labels := strings.Split(text[start+len("last "):colon], ",")
+	line := strings.SplitN(text[colon+1:], "\n", 2)[0]
+	figures := strings.Split(line, ",")
+	if len(figures) < len(labels) {
+		return 0, 0, 0, bad
+	}
+
+	tps := map[string]float64{}
+	for i, label := range labels {
+		f := strings.TrimPrefix(strings.TrimSpace(figures[i]), "*")
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return 0, 0, 0, bad
+		}
+		tps[strings.TrimSpace(label)] = v
+	}
+	for _, window := range []string{"1m", "5m", "15m"} {
+		if _, ok := tps[window]; !ok {
+			return 0, 0, 0, bad
+		}
+	}
+	return tps["1m"], tps["5m"], tps["15m"], nil
+}
+
+// SetDifficulty sets the world difficulty (peaceful, easy, normal or hard).
+func (c Client) SetDifficulty(ctx context.Context, difficulty string) error {
+	_, err := c.send(ctx, fmt.Sprintf("difficulty %s", difficulty))
+	return err
+}
+
+// GetDifficulty returns the world difficulty in lower case, parsed from the
+// reply to a bare `difficulty` ("The difficulty is Normal").
+func (c Client) GetDifficulty(ctx context.Context) (string, error) {
+	out, err := c.send(ctx, "difficulty")
+	if err != nil {
+		return "", err
+	}
+	return parseDifficulty(out)
+}
+
+func parseDifficulty(out string) (string, error) {
+	const marker = "difficulty is "
+	i := strings.Index(strings.ToLower(out), marker)
+	if i < 0 {
+		return "", fmt.Errorf("unexpected difficulty reply: %q", out)
+	}
+	fields := strings.Fields(out[i+len(marker):])
+	if len(fields) == 0 {
+		return "", fmt.Errorf("unexpected difficulty reply: %q", out)
+	}
+	return strings.ToLower(fields[0]), nil
+}
+
+// DefaultWorldBorderSize is the border diameter of a fresh world.
+const DefaultWorldBorderSize = 59999968
+
+// SetWorldBorderSize sets the world border diameter in blocks. A nil seconds
+// moves the border at once; otherwise it grows or shrinks over that many
+// seconds.
+func (c Client) SetWorldBorderSize(ctx context.Context, diameter float64, seconds *int) error {
+	_, err := c.send(ctx, worldBorderSizeCommand(diameter, seconds))
+	return err
+}
+
+func worldBorderSizeCommand(diameter float64, seconds *int) string {
+	cmd := "worldborder set " + strconv.FormatFloat(diameter, 'f', -1, 64)
+	if seconds != nil {
+		cmd += fmt.Sprintf(" %d", *seconds)
+	}
+	return cmd
+}
+
+// SetWorldBorderCenter moves the center of the world border.
+func (c Client) SetWorldBorderCenter(ctx context.Context, x, z float64) error {
+	_, err := c.send(ctx, fmt.Sprintf("worldborder center %s %s",
+		strconv.FormatFloat(x, 'f', -1, 64), strconv.FormatFloat(z, 'f', -1, 64)))
+	return err
+}
+
+// Creates operator status for the specified user name
+func (c Client) CreateOp(ctx context.Context, name string) error {
+	var cmd string
+	cmd = fmt.Sprintf(`op %s`, name)
+
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+// Removes operator status for the specified user name
+func (c Client) RemoveOp(ctx context.Context, name string) error {
+	var cmd string
+	cmd = fmt.Sprintf(`deop %s`, name)
+
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+// ErrUnsupported is returned, wrapped, when the server can't answer a
+// question reliably. Callers should skip the check rather than guess.
+var ErrUnsupported = errors.New("not supported by this server")
+
+// OpEntry is one record of the server's ops.json.
+type OpEntry struct {
+	UUID                string `json:"uuid"`
+	Name                string `json:"name"`
+	Level               int    `json:"level"`
+	BypassesPlayerLimit bool   `json:"bypassesPlayerLimit"`
+}
+
+// ReadOpsFile reads ops.json from the server's data directory. Vanilla RCON has
+// no command that lists operators, so this is the only reliable way to read them.
+func ReadOpsFile(dir string) ([]OpEntry, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, "ops.json"))
+	if err != nil {
+		return nil, err
+	}
+	return parseOps(raw)
+}
+
+func parseOps(raw []byte) ([]OpEntry, error) {
+	var ops []OpEntry
+	if err := json.Unmarshal(raw, &ops); err != nil {
+		return nil, fmt.Errorf("invalid ops.json: %w", err)
+	}
+	return ops, nil
+}
+
+// DefaultSpawnProtection is the vanilla spawn-protection radius used when
+// server.properties doesn't set one.
+const DefaultSpawnProtection = 16
+
+// ReadSpawnProtection reads the spawn-protection radius from server.properties
+// in the server's data directory.
+func ReadSpawnProtection(dir string) (int, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, "server.properties"))
+	if err != nil {
+		return 0, err
+	}
+	return parseSpawnProtection(string(raw))
+}
+
+func parseSpawnProtection(props string) (int, error) {
+	for _, line := range strings.Split(props, "\n") {
+		kv := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) != "spawn-protection" {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return 0, fmt.Errorf("invalid spawn-protection %q in server.properties", kv[1])
+		}
+		return n, nil
+	}
+	return DefaultSpawnProtection, nil
+}
+
+// spawnProbeTag marks the short-lived marker GetWorldSpawn summons.
+const spawnProbeTag = "tf_spawn_probe"
+
+// GetWorldSpawn returns the world spawn block. Vanilla has no command that
+// prints it, but RCON commands run at the world spawn, so a marker summoned
+// at ~ ~ ~ lands there; its position is read back and the marker removed.
+func (c Client) GetWorldSpawn(ctx context.Context) ([3]int, error) {
+	var spawn [3]int
+	if _, err := c.send(ctx, "summon minecraft:marker ~ ~ ~ "+BuildMobNBT(MobNBTOptions{ExtraTags: []string{spawnProbeTag}})); err != nil {
+		return spawn, fmt.Errorf("send command: %w", err)
+	}
+	selector := fmt.Sprintf("@e[type=minecraft:marker,tag=%s]", spawnProbeTag)
+	defer c.send(ctx, fmt.Sprintf("kill %s", selector))
+
+	out, err := c.send(ctx, fmt.Sprintf("data get entity %s Pos", limitOne(selector)))
+	if err != nil {
+		return spawn, fmt.Errorf("send command: %w", err)
+	}
+	return parseBlockPos(out)
+}
+
+// LocateKinds are what Locate can search for, as the locate subcommands
+// name them (1.19+).
+var LocateKinds = []string{"structure", "biome", "poi"}
+
+// Location is where locate found the nearest match. Structures are located
+// by X and Z only; HasY is false for them and Pos[1] is 0.
+type Location struct {
+	Pos      [3]int
+	HasY     bool
+	Distance int
+}
+
+// Locate finds the structure, biome or point of interest nearest to where
+// RCON commands run, which is the world spawn. target is an ID such as
+// minecraft:plains or a tag such as #minecraft:village. Servers before 1.19
+// have no locate subcommands and get ErrUnsupported.
+func (c Client) Locate(ctx context.Context, kind, target string) (Location, error) {
+	out, err := c.send(ctx, fmt.Sprintf("locate %s %s", kind, target))
+	if err != nil {
+		return Location{}, err
+	}
+	if isUnknownCommand(out) || strings.Contains(strings.ToLower(out), "incorrect argument") {
+		return Location{}, fmt.Errorf("locate %s: %w", kind, ErrUnsupported)
+	}
+	return parseLocation(out)
+}
+
+// Typical output:
+// The nearest #minecraft:village (minecraft:village_plains) is at [-1120, ~, 704] (1247 blocks away)
+// The nearest minecraft:plains is at [96, 63, -32] (101 blocks away)
+// Could not find a structure of type "#minecraft:village" nearby
+func parseLocation(out string) (Location, error) {
+	var loc Location
+	start := strings.Index(out, " is at [")
+	if start < 0 {
+		return loc, fmt.Errorf("locate failed: %s", strings.TrimSpace(out))
+	}
+	rest := out[start+len(" is at ["):]
+	end := strings.Index(rest, "]")
+	if end < 0 {
+		return loc, fmt.Errorf("unexpected response: %q", out)
+	}
+	fields := strings.Split(rest[:end], ",")
+	if len(fields) != 3 {
+		return loc, fmt.Errorf("unexpected response: %q", out)
+	}
+	for i, f := range fields {
+		f = strings.TrimSpace(f)
+		if i == 1 && f == "~" {
+			continue
+		}
+		v, err := strconv.Atoi(f)
+		if err != nil {
+			return loc, fmt.Errorf("unexpected response: %q", out)
+		}
+		loc.Pos[i] = v
+		if i == 1 {
+			loc.HasY = true
+		}
+	}
+	if _, err := fmt.Sscanf(strings.TrimSpace(rest[end+1:]), "(%d blocks away)", &loc.Distance); err != nil {
+		return loc, fmt.Errorf("unexpected response: %q", out)
+	}
+	return loc, nil
+}
+
+// GetEntityPos returns the exact position of the entity with the given
+// CustomName, e.g. a marker summoned by this provider.
+func (c Client) GetEntityPos(ctx context.Context, customName string) ([3]float64, error) {
+	out, err := c.send(ctx, fmt.Sprintf("data get entity %s Pos", limitOne(SelectorByCustomName(customName))))
+	if err != nil {
+		return [3]float64{}, fmt.Errorf("send command: %w", err)
+	}
+	if strings.Contains(strings.ToLower(out), "no entity was found") {
+		return [3]float64{}, fmt.Errorf("no entity named %q", customName)
+	}
+	return parsePos(out)
+}
+
+// OffsetBlockPos returns the block at offset from pos, counting from the
+// block pos is in (coordinates are floored, so -0.5 is block -1).
+func OffsetBlockPos(pos [3]float64, offset [3]int) [3]int {
+	var block [3]int
+	for i := range pos {
+		block[i] = int(math.Floor(pos[i])) + offset[i]
+	}
+	return block
+}
+
+// parseBlockPos is parsePos floored to the block the position is in.
+func parseBlockPos(out string) ([3]int, error) {
+	pos, err := parsePos(out)
+	if err != nil {
+		return [3]int{}, err
+	}
+	return OffsetBlockPos(pos, [3]int{}), nil
+}
+
+// Typical output:
+// Marker has the following entity data: [12.0d, 64.0d, -8.0d]
+func parsePos(out string) ([3]float64, error) {
+	var pos [3]float64
+	start, end := strings.LastIndex(out, "["), strings.LastIndex(out, "]")
+	if start < 0 || end < start {
+		return pos, fmt.Errorf("unexpected response: %q", out)
+	}
+	fields := strings.Split(out[start+1:end], ",")
+	if len(fields) != 3 {
+		return pos, fmt.Errorf("unexpected response: %q", out)
+	}
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(f), "d"), 64)
+		if err != nil {
+			return pos, fmt.Errorf("unexpected response: %q", out)
+		}
+		pos[i] = v
+	}
+	return pos, nil
+}
+
+// BanPlayer permanently bans a player, optionally with a reason.
+func (c Client) BanPlayer(ctx context.Context, player, reason string) error {
+	cmd := strings.TrimSpace(fmt.Sprintf("ban %s %s", player, reason))
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+// TempBanPlayer bans a player for a limited duration (e.g. "1d", "3h") using the
+// `tempban` command provided by plugins such as EssentialsX. An empty duration
+// falls back to a permanent ban.
+func (c Client) TempBanPlayer(ctx context.Context, player, duration, reason string) error {
+	if duration == "" {
+		return c.BanPlayer(ctx, player, reason)
+	}
+	cmd := strings.TrimSpace(fmt.Sprintf("tempban %s %s %s", player, duration, reason))
+	out, err := c.send(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	if isUnknownCommand(out) {
+		return fmt.Errorf("tempban is not supported on this server (requires a plugin such as EssentialsX)")
+	}
+	return nil
+}
+
+// PardonPlayer lifts a ban on a player.
+func (c Client) PardonPlayer(ctx context.Context, player string) error {
+	_, err := c.send(ctx, fmt.Sprintf("pardon %s", player))
+	return err
+}
+
+// ListBans returns the banned player names and IP addresses from
+// `banlist players` and `banlist ips`.
+func (c Client) ListBans(ctx context.Context) (players []string, ips []string, err error) {
+	out, err := c.send(ctx, "banlist players")
+	if err != nil {
+		return nil, nil, fmt.Errorf("send command: %w", err)
+	}
+	if players, err = parseBanList(out); err != nil {
+		return nil, nil, err
+	}
+	out, err = c.send(ctx, "banlist ips")
+	if err != nil {
+		return nil, nil, fmt.Errorf("send command: %w", err)
+	}
+	if ips, err = parseBanList(out); err != nil {
+		return nil, nil, err
+	}
+	return players, ips, nil
+}
+
+// Typical output, one ban per line:
+// There are 2 ban(s):
+// Steve was banned by Server: Banned by an operator.
+// 10.0.0.1 was banned by Rcon: spam: again
+// There are no bans
+func parseBanList(out string) ([]string, error) {
+	if strings.Contains(out, "There are no bans") {
+		return []string{}, nil
+	}
+	var count int
+	if _, err := fmt.Sscanf(strings.TrimSpace(out), "There are %d ban", &count); err != nil {
+		return nil, fmt.Errorf("unexpected response: %q", out)
+	}
+	i := strings.Index(out, ":")
+	if i < 0 {
+		return nil, fmt.Errorf("unexpected response: %q", out)
+	}
+	bans := []string{}
+	for _, line := range strings.Split(out[i+1:], "\n") {
+		// The reason may contain anything, so only the text before the
+		// first " was banned by " is taken as the name.
+		j := strings.Index(line, " was banned by ")
+		if j < 0 {
+			continue
+		}
+		if name := strings.TrimSpace(line[:j]); name != "" {
+			bans = append(bans, name)
+		}
+	}
+	// A server that joins lines without a separator can't be split reliably;
+	// report that rather than return a short or garbled list.
+	if len(bans) != count {
+		return nil, fmt.Errorf("expected %d bans but parsed %d from response: %q", count, len(bans), out)
+	}
+	return bans, nil
+}
+
+// ValidTeamColors lists the colors accepted by `team modify <team> color`.
+// The first 16 are the chat formatting colors; "reset" clears the color.
+var ValidTeamColors = []string{
+	"black", "dark_blue", "dark_green", "dark_aqua",
+	"dark_red", "dark_purple", "gold", "gray",
+	"dark_gray", "blue", "green", "aqua",
+	"red", "light_purple", "yellow", "white",
+	"reset",
+}
+
+// Creates a team with a given name and optional display name.
+func (c Client) CreateTeam(ctx context.Context, name string, displayName string) error {
+	var cmd string
+	if displayName != "" {
+		cmd = fmt.Sprintf(`team add %s "%s"`, name, displayName)
+	} else {
+		cmd = fmt.Sprintf(`team add %s`, name)
+	}
+
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+// Deletes a team by name.
+func (c Client) DeleteTeam(ctx context.Context, name string) error {
+	cmd := fmt.Sprintf("team remove %s", name)
+	_, err := c.send(ctx, cmd)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// --- New: Set options via /team modify
+// Color: e.g. white, gray, dark_gray, black, red, dark_red, gold, yellow, green, dark_green,
+// aqua, dark_aqua, blue, dark_blue, light_purple, dark_purple
+func (c Client) SetTeamColor(ctx context.Context, name, color string) error {
+	color = strings.ToLower(color)
+	_, err := c.send(ctx, fmt.Sprintf("team modify %s color %s", name, color))
+	return err
+}
+
+func (c Client) SetTeamFriendlyFire(ctx context.Context, name string, enabled bool) error {
+	_, err := c.send(ctx, teamBoolCommand(name, "friendlyFire", enabled))
+	return err
+}
+
+func (c Client) SetTeamSeeFriendlyInvisibles(ctx context.Context, name string, enabled bool) error {
+	_, err := c.send(ctx, teamBoolCommand(name, "seeFriendlyInvisibles", enabled))
+	return err
+}
+
+// teamBoolCommand builds `team modify <name> <option> true|false`.
+func teamBoolCommand(name, option string, enabled bool) string {
+	return fmt.Sprintf("team modify %s %s %s", name, option, strconv.FormatBool(enabled))
+}
+
+// Nametag visibility: always | never | hideForOtherTeams | hideForOwnTeam
+func (c Client) SetTeamNametagVisibility(ctx context.Context, name, mode string) error {
+	mode = strings.TrimSpace(mode)
+	_, err := c.send(ctx, fmt.Sprintf("team modify %s nametagVisibility %s", name, mode))
+	return err
+}
+
+// Collision rule: always | never | pushOtherTeams | pushOwnTeam
+func (c Client) SetTeamCollisionRule(ctx context.Context, name, rule string) error {
+	rule = strings.TrimSpace(rule)
+	_, err := c.send(ctx, fmt.Sprintf("team modify %s collisionRule %s", name, rule))
+	return err
+}
+
+// Display name: Minecraft accepts a text component; a plain quoted string also works.
+// Safest is a simple text component.
+func (c Client) SetTeamDisplayName(ctx context.Context, name, display string) error {
+	_, err := c.send(ctx, teamTextCommand(name, "displayName", display))
+	return err
+}
+
+// SetTeamPrefix sets the text shown before member names. An empty prefix
+// resets it to the default (none).
+func (c Client) SetTeamPrefix(ctx context.Context, name, prefix string) error {
+	_, err := c.send(ctx, teamTextCommand(name, "prefix", prefix))
+	return err
+}
+
+// SetTeamSuffix sets the text shown after member names. An empty suffix
+// resets it to the default (none).
+func (c Client) SetTeamSuffix(ctx context.Context, name, suffix string) error {
+	_, err := c.send(ctx, teamTextCommand(name, "suffix", suffix))
+	return err
+}
+
+// teamTextCommand builds `team modify <team> <option> {"text":"..."}`.
+func teamTextCommand(name, option, text string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text)
+	return fmt.Sprintf(`team modify %s %s {"text":"%s"}`, name, option, escaped)
+}
+
+// ShowTitle shows text to target as a title, subtitle or actionbar. A
+// subtitle only appears together with the next title.
+func (c Client) ShowTitle(ctx context.Context, target, kind, text string) error {
+	cmd, err := titleCommand(target, kind, text)
+	if err != nil {
+		return err
+	}
+	_, err = c.send(ctx, cmd)
+	return err
+}
+
+// titleCommand builds `title <target> <title|subtitle|actionbar> {"text":"..."}`.
+func titleCommand(target, kind, text string) (string, error) {
+	switch kind {
+	case "title", "subtitle", "actionbar":
+	default:
+		return "", fmt.Errorf("unsupported title type %q (want title, subtitle or actionbar)", kind)
+	}
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text)
+	return fmt.Sprintf(`title %s %s {"text":"%s"}`, target, kind, escaped), nil
+}
+
+// SetTitleTimes sets how long target's titles fade in, stay and fade out, in ticks.
+func (c Client) SetTitleTimes(ctx context.Context, target string, fadeIn, stay, fadeOut int) error {
+	_, err := c.send(ctx, fmt.Sprintf("title %s times %d %d %d", target, fadeIn, stay, fadeOut))
+	return err
+}
+
+// ClearTitle removes the title and subtitle target is currently shown.
+func (c Client) ClearTitle(ctx context.Context, target string) error {
+	_, err := c.send(ctx, fmt.Sprintf("title %s clear", target))
+	return err
+}
+
+// ResetTitleTimes restores target's title fade times to the defaults.
+func (c Client) ResetTitleTimes(ctx context.Context, target string) error {
+	_, err := c.send(ctx, fmt.Sprintf("title %s reset", target))
+	return err
+}
+
+// teamOptionDefaults holds the vanilla value of each team option, as written
+// after `team modify <name> <option>`.
+var teamOptionDefaults = map[string]string{
+	"color":                  "reset",
+	"friendlyFire":           "true",
+	"seeFriendlyInvisibles":  "true",
+	"nametagVisibility":      "always",
+	"deathMessageVisibility": "always",
+	"collisionRule":          "always",
+	"prefix":                 `{"text":""}`,
+	"suffix":                 `{"text":""}`,
+}
+
+// ResetTeamOption puts a team option (e.g. "color", "friendlyFire") back to
+// the value a new team starts with.
+func (c Client) ResetTeamOption(ctx context.Context, name, option string) error {
+	def, ok := teamOptionDefaults[option]
+	if !ok {
+		return fmt.Errorf("unsupported team option %q", option)
+	}
+	_, err := c.send(ctx, fmt.Sprintf("team modify %s %s %s", name, option, def))
+	return err
+}
+
+// TeamExists reports whether the team exists, using `team list <name>`.
+// Vanilla has no command that prints a team's options (`team list` only shows
+// members), so they can't be read back.
+func (c Client) TeamExists(ctx context.Context, name string) (bool, error) {
+	out, err := c.send(ctx, fmt.Sprintf("team list %s", name))
+	if err != nil {
+		return false, fmt.Errorf("send command: %w", err)
+	}
+	return parseTeamExists(out)
+}
+
+// Typical output:
+// Team [Blue] has 2 members: Steve, Alex
+// There are no members on team [Blue]
+// Unknown team 'Blue'
+func parseTeamExists(out string) (bool, error) {
+	switch {
+	case strings.Contains(out, "Unknown team"):
+		return false, nil
+	case strings.Contains(out, "no members"), strings.Contains(out, " member"):
+		return true, nil
+	}
+	return false, fmt.Errorf("unexpected response: %q", out)
+}
+
+// Join arbitrary targets to a team (players or selectors).
+// Examples:
+//
+//	JoinTeamTargets(ctx, "blue", "Steve")
+//	JoinTeamTargets(ctx, "red", "@a[team=]")
+//	JoinTeamTargets(ctx, "blue", "@e[type=minecraft:zombie,limit=5]")
+func (c Client) JoinTeamTargets(ctx context.Context, team string, targets ...string) error {
+	_, _, err := c.JoinTeamTargetsCounted(ctx, team, targets...)
+	return err
+}
+
+// JoinTeamTargetsCounted is JoinTeamTargets, but also reports how many entities
+// joined. ok is false when the server reply couldn't be parsed.
+func (c Client) JoinTeamTargetsCounted(ctx context.Context, team string, targets ...string) (n int, ok bool, err error) {
+	if len(targets) == 0 {
+		return 0, true, nil
+	}
+	cmd := fmt.Sprintf("team join %s %s", team, strings.Join(targets, " "))
+	out, err := c.send(ctx, cmd)
+	if err != nil {
+		return 0, false, err
+	}
+	n, ok = parseAffectedCount(out)
+	return n, ok, nil
+}
+
+// Make the given targets leave whichever team they’re in.
+// Examples:
+//
+//	LeaveTeamTargets(ctx, "Steve")
+//	LeaveTeamTargets(ctx, "@e[type=minecraft:zombie,distance=..10]")
+func (c Client) LeaveTeamTargets(ctx context.Context, targets ...string) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	cmd := fmt.Sprintf("team leave %s", strings.Join(targets, " "))
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+// ---------- Convenience: players by name ----------
+
+func (c Client) JoinTeamPlayers(ctx context.Context, team string, players ...string) error {
+	// Players can be batched in one command
+	return c.JoinTeamTargets(ctx, team, players...)
+}
+
+func (c Client) LeaveTeamPlayers(ctx context.Context, players ...string) error {
+	return c.LeaveTeamTargets(ctx, players...)
+}
+
+// ListTeamMembers returns the names of everyone on the team.
+func (c Client) ListTeamMembers(ctx context.Context, team string) ([]string, error) {
+	out, err := c.send(ctx, fmt.Sprintf("team list %s", team))
+	if err != nil {
+		return nil, fmt.Errorf("send command: %w", err)
+	}
+	return parseTeamMembers(out)
+}
+
+// Typical output:
+// Team [Blue] has 2 members: Steve, Alex
+// There are no members on team [Blue]
+func parseTeamMembers(out string) ([]string, error) {
+	if strings.Contains(out, "no members") {
+		return []string{}, nil
+	}
+	// Look for the colon after "member(s)" so a display name containing ':' doesn't confuse us.
+	m := strings.Index(out, " member")
+	if m < 0 {
+		return nil, fmt.Errorf("unexpected response: %q", out)
+	}
+	i := strings.Index(out[m:], ":")
+	if i < 0 {
+		return nil, fmt.Errorf("unexpected response: %q", out)
+	}
+	var members []string
+	for _, name := range strings.Split(out[m+i+1:], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			members = append(members, name)
+		}
+	}
+	return members, nil
+}
+
+// SelectorByTag matches entities of the given type carrying tag.
+func SelectorByTag(entity, tag string) string {
+	return fmt.Sprintf("@e[type=%s,tag=%s]", entity, tag)
+}
+
+// ---------- Convenience: entities by stable CustomName ----------
+// You mentioned you embed a UUID in the entity's CustomName when creating it.
+// We can build a selector that matches that name exactly.
+//
+// NOTE: We escape double-quotes in the name to keep the JSON valid.
+func SelectorByCustomName(name string) string {
+	escaped := strings.ReplaceAll(name, `"`, `\"`)
+	// Matches exact name text component
+	return fmt.Sprintf(`@e[nbt={CustomName:'{"text":"%s"}'}]`, escaped)
+}
+
+func (c Client) JoinTeamEntityByName(ctx context.Context, team string, customName string) error {
+	sel := SelectorByCustomName(customName)
+	return c.JoinTeamTargets(ctx, team, sel)
+}
+
+func (c Client) LeaveTeamEntityByName(ctx context.Context, customName string) error {
+	sel := SelectorByCustomName(customName)
+	return c.LeaveTeamTargets(ctx, sel)
+}
+
+// ---------- Convenience: bulk entities by tag (recommended) ----------
+// If you also tag entities (e.g., `tag add <id>` or in your summon NBT), selectors by tag
+// are very cheap and reliable. This joins/leaves all matching entities.
+
+// JoinTeamEntitiesByTag joins every entity tagged tag to team. It fails when
+// the server reports that nothing joined, e.g. because no entity has the tag.
+func (c Client) JoinTeamEntitiesByTag(ctx context.Context, team, tag string) error {
+	n, ok, err := c.JoinTeamTargetsCounted(ctx, team, fmt.Sprintf(`@e[tag=%s]`, tag))
+	if err != nil {
+		return err
+	}
+	if ok && n == 0 {
+		return fmt.Errorf("no entity tagged %q joined team %s", tag, team)
+	}
+	return nil
+}
+
+func (c Client) LeaveTeamEntitiesByTag(ctx context.Context, tag string) error {
+	return c.LeaveTeamTargets(ctx, fmt.Sprintf(`@e[tag=%s]`, tag))
+}
+
+// Set a boolean gamerule, e.g. keepInventory, doDaylightCycle, mobGriefing, etc.
+func (c Client) SetGameRuleBool(ctx context.Context, rule string, value bool) error {
+	rule = strings.TrimSpace(rule)
+	if !isBoolRule(rule) {
+		return fmt.Errorf("gamerule %q is not a known boolean rule", rule)
+	}
+	_, err := c.send(ctx, fmt.Sprintf("gamerule %s %s", rule, strconv.FormatBool(value)))
+	return err
+}
+
+// Set an integer gamerule, e.g. randomTickSpeed, maxEntityCramming, spawnRadius, playersSleepingPercentage, maxCommandChainLength.
+func (c Client) SetGameRuleInt(ctx context.Context, rule string, value int) error {
+	rule = strings.TrimSpace(rule)
+	if !isIntRule(rule) {
+		return fmt.Errorf("gamerule %q is not a known integer rule", rule)
+	}
+	_, err := c.send(ctx, fmt.Sprintf("gamerule %s %d", rule, value))
+	return err
+}
+
+// Read current value as a raw string. For bool rules, returns "true"/"false"; for int rules, returns the number.
+func (c Client) GetGameRule(ctx context.Context, rule string) (string, error) {
+	rule = strings.TrimSpace(rule)
+	// Query form: /gamerule <rule>
+	out, err := c.send(ctx, fmt.Sprintf("gamerule %s", rule))
+	if err != nil {
+		return "", err
+	}
+	// Server usually replies with just the value, but some servers/plugins may add text.
+	// Try to extract the last token that parses for ints or matches true/false.
+	line := strings.TrimSpace(out)
+	fields := strings.Fields(line)
+	if len(fields) == 1 {
+		return fields[0], nil
+	}
+	// Heuristic: scan from end for a bool or int-looking token.
+	for i := len(fields) - 1; i >= 0; i-- {
+		f := strings.TrimSpace(fields[i])
+		lf := strings.ToLower(f)
+		if lf == "true" || lf == "false" {
+			return lf, nil
+		}
+		if _, err := strconv.Atoi(f); err == nil {
+			return f, nil
+		}
+	}
+	// Fallback: return raw output
+	return line, nil
+}
+
+// Reset (aka "delete") a gamerule back to its vanilla default.
+// Returns an error if we don't have a known default for that rule.
+func (c Client) ResetGameRuleToDefault(ctx context.Context, rule string) error {
+	rule = strings.TrimSpace(rule)
+
+	if def, ok := defaultBoolRules[rule]; ok {
+		return c.SetGameRuleBool(ctx, rule, def)
+	}
+	if def, ok := defaultIntRules[rule]; ok {
+		return c.SetGameRuleInt(ctx, rule, def)
+	}
+	return fmt.Errorf("no known default for gamerule %q; cannot reset", rule)
+}
+
+// ---- Known rules & defaults (Java Edition) ----
+
+// Keep this list small and pragmatic; extend as you need.
+// Boolean rules (subset of commonly used)
+var boolRules = map[string]struct{}{
+	"announceAdvancements":       {},
+	"disableElytraMovementCheck": {},
+	"disablePlayerMovementCheck": {},
+	"disableRaids":               {},
+	"doDaylightCycle":            {},
+	"doEntityDrops":              {},
+	"doFireTick":                 {},
+	"doInsomnia":                 {},
+	"doImmediateRespawn":         {},
+	"doLimitedCrafting":          {},
+	"doMobLoot":                  {},
+	"doMobSpawning":              {},
+	"doPatrolSpawning":           {},
+	"doTileDrops":                {},
+	"doTraderSpawning":           {},
+	"doVinesSpread":              {},
+	"doWeatherCycle":             {},
+	"doWardenSpawning":           {},
+	"drowningDamage":             {},
+	"fallDamage":                 {},
+	"fireDamage":                 {},
+	"forgiveDeadPlayers":         {},
+	"keepInventory":              {},
+	"logAdminCommands":           {},
+	"mobGriefing":                {},
+	"naturalRegeneration":        {},
+	"reducedDebugInfo":           {},
+	"sendCommandFeedback":        {},
+	"showDeathMessages":          {},
+	"spectatorsGenerateChunks":   {},
+	"universalAnger":             {},
+}
+
+// Integer rules (subset of commonly used)
+var intRules = map[string]struct{}{
+	"maxCommandChainLength":     {},
+	"maxEntityCramming":         {},
+	"playersSleepingPercentage": {},
+	"randomTickSpeed":           {},
+	"spawnRadius":               {},
+}
+
+// Vanilla defaults (Java). Extend as needed.
+var defaultBoolRules = map[string]bool{
+	"announceAdvancements":       true,
+	"disableElytraMovementCheck": false,
+	"disablePlayerMovementCheck": false,
+	"disableRaids":               false,
+	"doDaylightCycle":            true,
+	"doEntityDrops":              true,
+	"doFireTick":                 true,
+	"doInsomnia":                 true,
+	"doImmediateRespawn":         false,
+	"doLimitedCrafting":          false,
+	"doMobLoot":                  true,
+	"doMobSpawning":              true,
+	"doPatrolSpawning":           true,
+	"doTileDrops":                true,
+	"doTraderSpawning":           true,
+	"doVinesSpread":              true,
+	"doWeatherCycle":             true,
+	"doWardenSpawning":           true,
+	"drowningDamage":             true,
+	"fallDamage":                 true,
+	"fireDamage":                 true,
+	"forgiveDeadPlayers":         true,
+	"keepInventory":              false,
+	"logAdminCommands":           true,
+	"mobGriefing":                true,
+	"naturalRegeneration":        true,
+	"reducedDebugInfo":           false,
+	"sendCommandFeedback":        true,
+	"showDeathMessages":          true,
+	"spectatorsGenerateChunks":   true,
+	"universalAnger":             false,
+}
+
+var defaultIntRules = map[string]int{
+	"maxCommandChainLength":     65536,
+	"maxEntityCramming":         24,
+	"playersSleepingPercentage": 100,
+	"randomTickSpeed":           3,
+	"spawnRadius":               5,
+}
+
+// ---- Internals ----
+
+func isBoolRule(rule string) bool {
+	_, ok := boolRules[rule]
+	return ok
+}
+
+func isIntRule(rule string) bool {
+	_, ok := intRules[rule]
+	return ok
+}
+
+func (c Client) FillBlock(ctx context.Context, material string, sx, sy, sz, ex, ey, ez int) error {
+	command := fmt.Sprintf("fill %d %d %d %d %d %d %s hollow", sx, sy, sz, ex, ey, ez, material)
+	_, err := c.send(ctx, command)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FillFrame draws the 12 edges of the cuboid between from and to (inclusive)
+// with material, one fill per edge, leaving the faces and inside alone. Flat
+// or thin cuboids share edges; each distinct edge is filled once.
+func (c Client) FillFrame(ctx context.Context, material string, from, to [3]int) error {
+	for _, command := range frameCommands(material, from, to) {
+		out, err := c.send(ctx, command)
+		if err != nil {
+			return err
+		}
+		// e.g. "Too many blocks in the specified area (maximum 32768, specified 40000)"
+		if strings.Contains(strings.ToLower(out), "too many blocks") {
+			return fmt.Errorf("%s: %s", command, out)
+		}
+	}
+	return nil
+}
+
+// frameCommands returns the fill commands for the edges of the cuboid: the
+// four running along X, then Y, then Z.
+func frameCommands(material string, from, to [3]int) []string {
+	var lo, hi [3]int
+	for i := range from {
+		lo[i], hi[i] = from[i], to[i]
+		if lo[i] > hi[i] {
+			lo[i], hi[i] = hi[i], lo[i]
+		}
+	}
+
+	var commands []string
+	seen := map[string]bool{}
+	for axis := 0; axis < 3; axis++ {
+		// The other two axes sit at each combination of their lo and hi.
+		u, v := (axis+1)%3, (axis+2)%3
+		for _, cu := range []int{lo[u], hi[u]} {
+			for _, cv := range []int{lo[v], hi[v]} {
+				var start, end [3]int
+				start[axis], end[axis] = lo[axis], hi[axis]
+				start[u], end[u] = cu, cu
+				start[v], end[v] = cv, cv
+				command := fmt.Sprintf("fill %d %d %d %d %d %d %s", start[0], start[1], start[2], end[0], end[1], end[2], material)
+				if !seen[command] {
+					seen[command] = true
+					commands = append(commands, command)
+				}
+			}
+		}
+	}
+	return commands
+}
+
+// KillEntitiesInRegion removes entities whose hitbox touches the inclusive
+// region between from and to, e.g. the mobs and dropped items left behind by
+// clearing it with air. excludePlayers keeps players out of the selector.
+func (c Client) KillEntitiesInRegion(ctx context.Context, from, to [3]int, excludePlayers bool) error {
+	// The reply is "Killed ..." or "No entity was found"; both are fine.
+	_, err := c.send(ctx, "kill "+regionEntitySelector(from, to, excludePlayers))
+	return err
+}
+
+// regionEntitySelector builds a volume selector for the region, e.g.
+//
+//	@e[type=!minecraft:player,x=0,y=60,z=0,dx=9,dy=4,dz=9]
+//
+// dx/dy/dz extend the box from x/y/z by that many blocks plus one, so a size
+// minus one covers exactly the region.
+func regionEntitySelector(from, to [3]int, excludePlayers bool) string {
+	var lo, size [3]int
+	for i := range from {
+		lo[i] = from[i]
+		size[i] = to[i] - from[i]
+		if size[i] < 0 {
+			lo[i] = to[i]
+			size[i] = -size[i]
+		}
+	}
+
+	var args []string
+	if excludePlayers {
+		args = append(args, "type=!minecraft:player")
+	}
+	args = append(args,
+		fmt.Sprintf("x=%d,y=%d,z=%d", lo[0], lo[1], lo[2]),
+		fmt.Sprintf("dx=%d,dy=%d,dz=%d", size[0], size[1], size[2]),
+	)
+	return fmt.Sprintf("@e[%s]", strings.Join(args, ","))
+}
+
+// MaxScanVolume caps the number of blocks CountBlocks will test, since every
+// block costs one RCON round trip.
+const MaxScanVolume = 4096
+
+// RegionVolume returns the number of blocks in the cuboid between from and to (inclusive).
+func RegionVolume(from, to [3]int) int {
+	v := 1
+	for i := range from {
+		d := to[i] - from[i]
+		if d < 0 {
+			d = -d
+		}
+		v *= d + 1
+	}
+	return v
+}
+
+// CountBlocks counts the blocks matching material (e.g. minecraft:stone or
+// minecraft:oak_stairs[half=top]) in the cuboid between from and to, testing
+// each one with `execute if block`.
+func (c Client) CountBlocks(ctx context.Context, material string, from, to [3]int) (int, error) {
+	return countBlocks(from, to, func(x, y, z int) (bool, error) {
+		return c.BlockMatches(ctx, material, x, y, z)
+	})
+}
+
+// BlockMatches reports whether the block at x, y, z is material, using
+// `execute if block`. States and NBT given in material must match; anything
+// left out matches any value.
+func (c Client) BlockMatches(ctx context.Context, material string, x, y, z int) (bool, error) {
+	out, err := c.send(ctx, fmt.Sprintf("execute if block %d %d %d %s", x, y, z, material))
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case strings.Contains(out, "Test passed"):
+		return true, nil
+	case strings.Contains(out, "Test failed"):
+		return false, nil
+	}
+	return false, fmt.Errorf("unexpected reply testing block at %d %d %d: %s", x, y, z, strings.TrimSpace(out))
+}
+
+// countBlocks walks the region and counts the positions for which test reports a match.
+func countBlocks(from, to [3]int, test func(x, y, z int) (bool, error)) (int, error) {
+	if v := RegionVolume(from, to); v > MaxScanVolume {
+		return 0, fmt.Errorf("region holds %d blocks, more than the %d that can be scanned", v, MaxScanVolume)
+	}
+	var lo, hi [3]int
+	for i := range from {
+		lo[i], hi[i] = from[i], to[i]
+		if lo[i] > hi[i] {
+			lo[i], hi[i] = hi[i], lo[i]
+		}
+	}
+
+	count := 0
+	for x := lo[0]; x <= hi[0]; x++ {
+		for y := lo[1]; y <= hi[1]; y++ {
+			for z := lo[2]; z <= hi[2]; z++ {
+				ok, err := test(x, y, z)
+				if err != nil {
+					return 0, err
+				}
+				if ok {
+					count++
+				}
+			}
+		}
+	}
+	return count, nil
+}
+
+// MaxStructureSize is the largest edge a structure block can save.
+const MaxStructureSize = 48
+
+// SaveStructure saves the cuboid between from and to (inclusive) as the named
+// structure (e.g. "terraform:house"), which /place template can load later.
+// Java has no save command, so a structure block in SAVE mode is placed just
+// above the region and powered with a redstone block; both are then removed,
+// leaving air in those two positions.
+func (c Client) SaveStructure(ctx context.Context, name string, from, to [3]int) error {
+	cmds, err := saveStructureCommands(name, from, to)
+	if err != nil {
+		return err
+	}
+	for i, cmd := range cmds {
+		if _, err := c.send(ctx, cmd); err != nil {
+			if i > 0 {
+				// Best effort: don't leave the helper blocks behind.
+				for _, cleanup := range cmds[2:] {
+					_, _ = c.send(ctx, cleanup)
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func saveStructureCommands(name string, from, to [3]int) ([]string, error) {
+	var lo, size [3]int
+	for i := range from {
+		lo[i] = from[i]
+		if to[i] < lo[i] {
+			lo[i] = to[i]
+		}
+		size[i] = from[i] - to[i]
+		if size[i] < 0 {
+			size[i] = -size[i]
+		}
+		size[i]++
+		if size[i] > MaxStructureSize {
+			return nil, fmt.Errorf("structure is %d blocks along axis %d; a structure block saves at most %d", size[i], i, MaxStructureSize)
+		}
+	}
+
+	// The structure block sits on top of the region's low corner column, so
+	// the region starts size[1] blocks below it.
+	bx, by, bz := lo[0], lo[1]+size[1], lo[2]
+	block := fmt.Sprintf(
+		`minecraft:structure_block{mode:"SAVE",name:"%s",posX:0,posY:%d,posZ:0,sizeX:%d,sizeY:%d,sizeZ:%d,ignoreEntities:1b}`,
+		name, -size[1], size[0], size[1], size[2],
+	)
+	return []string{
+		fmt.Sprintf("setblock %d %d %d %s replace", bx, by, bz, block),
+		fmt.Sprintf("setblock %d %d %d minecraft:redstone_block replace", bx, by+1, bz),
+		fmt.Sprintf("setblock %d %d %d minecraft:air replace", bx, by+1, bz),
+		fmt.Sprintf("setblock %d %d %d minecraft:air replace", bx, by, bz),
+	}, nil
+}
+
+// FillBiome sets the biome of the cuboid between from and to (inclusive), e.g.
+// `fillbiome 0 60 0 15 80 15 minecraft:cherry_grove`. Requires 1.19.3+.
+func (c Client) FillBiome(ctx context.Context, biome string, from, to [3]int) error {
+	out, err := c.send(ctx, fillBiomeCommand(biome, from, to))
+	if err != nil {
+		return err
+	}
+	if isUnknownCommand(out) {
+		return fmt.Errorf("fillbiome is not supported on this server (requires 1.19.3+)")
+	}
+	return nil
+}
+
+func fillBiomeCommand(biome string, from, to [3]int) string {
+	return fmt.Sprintf("fillbiome %d %d %d %d %d %d %s", from[0], from[1], from[2], to[0], to[1], to[2], biome)
+}
+
+// ListPlayers runs `/list` and returns the online count, the server's max
+// player slots and the names of the players currently online.
+func (c Client) ListPlayers(ctx context.Context) (online int, max int, players []string, err error) {
+	out, err := c.send(ctx, "list")
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("send command: %w", err)
+	}
+	return parseListPlayers(out)
+}
+
+// listHeaderPattern matches the count line of a `/list` reply in the wordings
+// servers use:
+//
+//	There are 1 of a max of 20 players online: Steve    (vanilla 1.13+, Paper)
+//	There are 1/20 players online:                      (vanilla 1.12 and older, Spigot)
+//	There are 1 out of maximum 20 players online.       (Bukkit, Essentials)
+var listHeaderPattern = regexp.MustCompile(`There are (\d+)\s*(?:of a max of|out of maximum|/)\s*(\d+) players online[.:]?`)
+
+// parseListPlayers reads a `/list` reply. Names follow the header on the same
+// line or on later lines; plugins may prefix a line with a group ("admins:
+// Steve, Alex"). A reply in none of the known wordings is an error wrapping
+// ErrUnsupported, so callers can keep what they know instead of failing.
+func parseListPlayers(out string) (online int, max int, players []string, err error) {
+	out = formattingCodePattern.ReplaceAllString(out, "")
+	loc := listHeaderPattern.FindStringSubmatchIndex(out)
+	if loc == nil {
+		return 0, 0, nil, fmt.Errorf("unrecognized list reply %q: %w", out, ErrUnsupported)
+	}
+	online, _ = strconv.Atoi(out[loc[2]:loc[3]])
+	max, _ = strconv.Atoi(out[loc[4]:loc[5]])
+
+	for _, line := range strings.Split(out[loc[1]:], "\n") {
+		if i := strings.Index(line, ":"); i >= 0 {
+			line = line[i+1:]
+		}
+		for _, n := range strings.Split(line, ",") {
+			if n = strings.TrimSpace(n); n != "" {
+				players = append(players, n)
+			}
+		}
+	}
+	return online, max, players, nil
+}
+
+// SetMaxPlayers changes the player slot count via `setmaxplayers` (Bukkit/Paper).
+// Vanilla servers don't have the command and reply with "Unknown command".
+func (c Client) SetMaxPlayers(ctx context.Context, n int) error {
+	if n <= 0 {
+		return fmt.Errorf("max players must be positive (got %d)", n)
+	}
+	out, err := c.send(ctx, fmt.Sprintf("setmaxplayers %d", n))
+	if err != nil {
+		return err
+	}
+	if isUnknownCommand(out) {
+		return fmt.Errorf("setmaxplayers is not supported on this server (requires Bukkit/Paper)")
+	}
+	return nil
+}
+
+// SetMOTD changes the server list message via `setmotd`, a command provided by
+// MOTD plugins on Bukkit/Paper. Vanilla keeps the MOTD in server.properties and
+// cannot change it over RCON, so it replies with "Unknown command".
+func (c Client) SetMOTD(ctx context.Context, motd string) error {
+	cmd, err := setMOTDCommand(motd)
+	if err != nil {
+		return err
+	}
+	out, err := c.send(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	if isUnknownCommand(out) {
+		return fmt.Errorf("setmotd is not supported on this server (vanilla reads the MOTD from server.properties; install a plugin that provides setmotd)")
+	}
+	return nil
+}
+
+func setMOTDCommand(motd string) (string, error) {
+	if strings.TrimSpace(motd) == "" {
+		return "", fmt.Errorf("motd cannot be empty")
+	}
+	if strings.ContainsAny(motd, "\r\n") {
+		return "", fmt.Errorf("motd cannot contain raw line breaks; use the plugin's own line separator")
+	}
+	return "setmotd " + motd, nil
+}
+
+// isUnknownCommand reports whether the server rejected a command it doesn't know.
+func isUnknownCommand(out string) bool {
+	out = strings.ToLower(out)
+	return strings.Contains(out, "unknown command") || strings.Contains(out, "unknown or incomplete command")
+}
+
+// SetObjectiveDisplay shows an objective in a display slot
+// (list, sidebar, belowName or sidebar.team.<color>).
+func (c Client) SetObjectiveDisplay(ctx context.Context, slot, objective string) error {
+	cmd := fmt.Sprintf("scoreboard objectives setdisplay %s %s", slot, objective)
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+// ClearObjectiveDisplay empties a display slot.
+func (c Client) ClearObjectiveDisplay(ctx context.Context, slot string) error {
+	cmd := fmt.Sprintf("scoreboard objectives setdisplay %s", slot)
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+// CreateBlockDisplay summons a minecraft:block_display rendering blockState
+// (e.g. "minecraft:oak_stairs[facing=east]") with the given scale and translation.
+// Billboard is one of fixed, vertical, horizontal or center; empty means fixed.
+// A nil rotation leaves the default facing.
+func (c Client) CreateBlockDisplay(ctx context.Context, position string, id string, blockState string, scale [3]float64, translation [3]float64, billboard string, rotation *[2]float64) error {
+	if billboard == "" {
+		billboard = "fixed"
+	}
+	// Block displays are found by name alone, so they carry no tracking tag.
+	nbt := BuildMobNBT(MobNBTOptions{
+		Name: id,
+		Fields: []string{
+			"block_state:" + blockStateNBT(blockState),
+			"transformation:" + transformationNBT(scale, translation),
+			fmt.Sprintf(`billboard:"%s"`, billboard),
+		},
+		Rotation: rotation,
+	})
+	_, err := c.send(ctx, fmt.Sprintf("summon minecraft:block_display %s %s", position, nbt))
+	return err
+}
+
+// UpdateBlockDisplay merges a new transformation and billboard into an existing block display.
+func (c Client) UpdateBlockDisplay(ctx context.Context, id string, scale [3]float64, translation [3]float64, billboard string) error {
+	if billboard == "" {
+		billboard = "fixed"
+	}
+	command := fmt.Sprintf(
+		`data merge entity %s {transformation:%s,billboard:"%s"}`,
+		limitOne(SelectorByCustomName(id)), transformationNBT(scale, translation), billboard,
+	)
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// blockStateNBT converts "name[k=v,...]" into {Name:"name",Properties:{k:"v",...}}.
+func blockStateNBT(state string) string {
+	name := state
+	props := ""
+	if i := strings.Index(state, "["); i >= 0 && strings.HasSuffix(state, "]") {
+		name = state[:i]
+		props = state[i+1 : len(state)-1]
+	}
+	if props == "" {
+		return fmt.Sprintf(`{Name:"%s"}`, name)
+	}
+
+	var pairs []string
+	for _, kv := range strings.Split(props, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		pairs = append(pairs, fmt.Sprintf(`%s:"%s"`, strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])))
+	}
+	return fmt.Sprintf(`{Name:"%s",Properties:{%s}}`, name, strings.Join(pairs, ","))
+}
+
+// transformationNBT builds a display entity transformation with identity rotations.
+func transformationNBT(scale [3]float64, translation [3]float64) string {
+	return fmt.Sprintf(
+		"{left_rotation:[0f,0f,0f,1f],right_rotation:[0f,0f,0f,1f],translation:%s,scale:%s}",
+		nbtFloatList(translation[:]), nbtFloatList(scale[:]),
+	)
+}
+
+// nbtFloatList renders values as an NBT float list, e.g. [1f,0.5f,1f].
+func nbtFloatList(values []float64) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.FormatFloat(v, 'f', -1, 32) + "f"
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
+
+// nbtDoubleList renders values as an NBT double list, e.g. [0.5d,1d,0d].
+func nbtDoubleList(values []float64) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.FormatFloat(v, 'f', -1, 64) + "d"
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
+
+// PoseNBT holds armor stand limb rotations in degrees around x, y and z.
+// Nil parts are left out of the merge and keep their current rotation.
+type PoseNBT struct {
+	Head     *[3]float64
+	Body     *[3]float64
+	LeftArm  *[3]float64
+	RightArm *[3]float64
+	LeftLeg  *[3]float64
+	RightLeg *[3]float64
+}
+
+// String renders the pose as an NBT compound, e.g. {Head:[10f,0f,0f],RightArm:[-90f,0f,0f]}.
+func (p PoseNBT) String() string {
+	parts := []struct {
+		key string
+		rot *[3]float64
+	}{
+		{"Head", p.Head},
+		{"Body", p.Body},
+		{"LeftArm", p.LeftArm},
+		{"RightArm", p.RightArm},
+		{"LeftLeg", p.LeftLeg},
+		{"RightLeg", p.RightLeg},
+	}
+
+	var tags []string
+	for _, part := range parts {
+		if part.rot != nil {
+			tags = append(tags, part.key+":"+nbtFloatList(part.rot[:]))
+		}
+	}
+	return "{" + strings.Join(tags, ",") + "}"
+}
+
+// SetArmorStandPose merges the given limb rotations into the armor stand named customName.
+func (c Client) SetArmorStandPose(ctx context.Context, customName string, pose PoseNBT) error {
+	command := fmt.Sprintf("data merge entity %s {Pose:%s}", limitOne(SelectorByCustomName(customName)), pose)
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// ResetArmorStandPose drops the Pose tag so the armor stand returns to its default stance.
+func (c Client) ResetArmorStandPose(ctx context.Context, customName string) error {
+	_, err := c.send(ctx, fmt.Sprintf("data remove entity %s Pose", limitOne(SelectorByCustomName(customName))))
+	return err
+}
+
+// SelectorByID matches the entity of any type tagged with a tracking id.
+func SelectorByID(id string) string {
+	return fmt.Sprintf("@e[tag=%s]", id)
+}
+
+// SetEntityGlowing turns the glowing outline of the entity tagged id on or
+// off through its Glowing flag, which unlike the effect also works on
+// non-living entities such as displays.
+func (c Client) SetEntityGlowing(ctx context.Context, id string, on bool) error {
+	out, err := c.send(ctx, glowingCommand(SelectorByID(id), on))
+	if err != nil {
+		return err
+	}
+	return checkEntityFound(out, id)
+}
+
+// glowingCommand builds e.g. `data merge entity @e[tag=x,limit=1] {Glowing:1b}`.
+func glowingCommand(selector string, on bool) string {
+	flag := 0
+	if on {
+		flag = 1
+	}
+	return fmt.Sprintf("data merge entity %s {Glowing:%db}", limitOne(selector), flag)
+}
+
+// SetEntityInvisible gives the entity tagged id a never-ending invisibility
+// effect without particles, or clears it.
+func (c Client) SetEntityInvisible(ctx context.Context, id string, on bool) error {
+	out, err := c.send(ctx, invisibleCommand(SelectorByID(id), on, c.versionAtLeast(1, 19, 4)))
+	if err != nil {
+		return err
+	}
+	return checkEntityFound(out, id)
+}
+
+// invisibleCommand builds the effect give/clear command. Servers before
+// 1.19.4 have no `infinite` duration, so the longest one is used there.
+func invisibleCommand(selector string, on, infinite bool) string {
+	if !on {
+		return fmt.Sprintf("effect clear %s minecraft:invisibility", selector)
+	}
+	duration := "1000000"
+	if infinite {
+		duration = "infinite"
+	}
+	return fmt.Sprintf("effect give %s minecraft:invisibility %s 0 true", selector, duration)
+}
+
+// checkEntityFound reports a missing entity, which data and effect only
+// mention in the reply.
+func checkEntityFound(out, id string) error {
+	if strings.Contains(strings.ToLower(out), "no entity was found") {
+		return fmt.Errorf("no entity tagged %q", id)
+	}
+	return nil
+}
+
+// limitOne narrows an @e[...] selector to a single entity, as /data requires.
+func limitOne(selector string) string {
+	return strings.TrimSuffix(selector, "]") + ",limit=1]"
+}
+
+// SummonGroup summons count copies of entityType around position ("x y z").
+// Every member carries the shared groupTag plus "<groupTag>_<index>", so the
+// whole group can be removed with KillGroup.
+func (c Client) SummonGroup(ctx context.Context, entityType, position, groupTag string, count int) error {
+	var x, y, z float64
+	if _, err := fmt.Sscanf(position, "%g %g %g", &x, &y, &z); err != nil {
+		return fmt.Errorf("invalid position %q: %w", position, err)
+	}
+
+	for i := 0; i < count; i++ {
+		// Small deterministic jitter on a 3x3 grid so members don't stack exactly.
+		jx := float64(i%3-1) * 0.5
+		jz := float64((i/3)%3-1) * 0.5
+		nbt := BuildMobNBT(MobNBTOptions{ExtraTags: []string{groupTag, fmt.Sprintf("%s_%d", groupTag, i)}})
+		command := fmt.Sprintf("summon %s %s %s", entityType, FormatPosition(x+jx, y, z+jz), nbt)
+		if _, err := c.send(ctx, command); err != nil {
+			return fmt.Errorf("summon member %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// KillGroup removes every entity carrying the group tag.
+func (c Client) KillGroup(ctx context.Context, groupTag string) error {
+	_, err := c.send(ctx, fmt.Sprintf("kill @e[tag=%s]", groupTag))
+	return err
+}
+
+// MaxStackDepth caps how many entities SummonStack puts on top of each other.
+const MaxStackDepth = 10
+
+// StackEntry is one entity in a SummonStack stack. Name, if set, becomes a
+// visible custom name.
+type StackEntry struct {
+	Entity string
+	Name   string
+}
+
+// SummonStack summons stack[0] at position ("x y z") with each following
+// entry riding the one before it, e.g. a spider with a skeleton on top, in a
+// single command. Every entity carries groupTag, so KillGroup removes them all.
+func (c Client) SummonStack(ctx context.Context, position, groupTag string, stack []StackEntry) error {
+	if len(stack) < 1 || len(stack) > MaxStackDepth {
+		return fmt.Errorf("a stack needs between 1 and %d entities (got %d)", MaxStackDepth, len(stack))
+	}
+	command := fmt.Sprintf("summon %s %s %s", stack[0].Entity, position, BuildMobNBT(stackOptions(groupTag, stack)))
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// stackOptions describes stack[0] with the rest of the stack nested as its
+// passenger, e.g. for a spider and a skeleton:
+//
+//	{Tags:["g"],Passengers:[{id:"minecraft:skeleton",Tags:["g"]}]}
+func stackOptions(groupTag string, stack []StackEntry) MobNBTOptions {
+	opts := MobNBTOptions{ExtraTags: []string{groupTag}, Name: stack[0].Name, NameVisible: true}
+	if len(stack) > 1 {
+		rider := stackOptions(groupTag, stack[1:])
+		rider.Type = stack[1].Entity
+		opts.Passengers = []MobNBTOptions{rider}
+	}
+	return opts
+}
+
+var affectedCountPattern = regexp.MustCompile(`(?i)\b(\d+)\s+(?:entities|entity|targets|target|players|player|members|member)\b`)
+
+// parseAffectedCount extracts how many targets a command touched from replies such as
+// "Killed 3 entities", "Applied effect Speed to 2 targets" or "Added 4 entities to team red".
+// A single named target ("Killed Steve") counts as one, and "No entity was found" as zero.
+// ok is false when the reply isn't recognised.
+func parseAffectedCount(out string) (n int, ok bool) {
+	line := strings.TrimSpace(out)
+	lower := strings.ToLower(line)
+
+	if strings.HasPrefix(lower, "no entity was found") ||
+		strings.HasPrefix(lower, "no player was found") ||
+		strings.HasPrefix(lower, "no targets") {
+		return 0, true
+	}
+	if m := affectedCountPattern.FindStringSubmatch(line); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err == nil {
+			return n, true
+		}
+	}
+	for _, prefix := range []string{"killed ", "applied ", "added ", "gave ", "teleported "} {
+		if strings.HasPrefix(lower, prefix) {
+			return 1, true
+		}
+	}
+	return 0, false
+}
+
+// KillByType kills every entity of entityType, or with center and radius
+// only those within radius blocks of center. Players are never killed. n is
+// how many died; ok is false when the reply couldn't be parsed.
+func (c Client) KillByType(ctx context.Context, entityType string, center *[3]int, radius *int) (n int, ok bool, err error) {
+	selector, err := killByTypeSelector(entityType, center, radius)
+	if err != nil {
+		return 0, false, err
+	}
+	out, err := c.send(ctx, "kill "+selector)
+	if err != nil {
+		return 0, false, err
+	}
+	n, ok = parseAffectedCount(out)
+	return n, ok, nil
+}
+
+// killByTypeSelector builds the KillByType selector, e.g.
+//
+//	@e[type=minecraft:zombie]
+//	@e[type=minecraft:zombie,x=0,y=64,z=0,distance=..16]
+//
+// A single positive type already leaves players out, so player itself is
+// the only type that has to be refused.
+func killByTypeSelector(entityType string, center *[3]int, radius *int) (string, error) {
+	if strings.TrimPrefix(entityType, "minecraft:") == "player" {
+		return "", fmt.Errorf("refusing to kill players")
+	}
+	if (center == nil) != (radius == nil) {
+		return "", fmt.Errorf("center and radius must be given together")
+	}
+	if radius == nil {
+		return fmt.Sprintf("@e[type=%s]", entityType), nil
+	}
+	if *radius <= 0 {
+		return "", fmt.Errorf("radius must be positive (got %d)", *radius)
+	}
+	return fmt.Sprintf("@e[type=%s,x=%d,y=%d,z=%d,distance=..%d]", entityType, center[0], center[1], center[2], *radius), nil
+}
+
+// CloneRegion copies the cuboid between the two corners so that its lowest
+// corner lands at (dx, dy, dz). Both areas must be loaded.
+func (c Client) CloneRegion(ctx context.Context, sx, sy, sz, ex, ey, ez, dx, dy, dz int) error {
+	command := fmt.Sprintf("clone %d %d %d %d %d %d %d %d %d replace", sx, sy, sz, ex, ey, ez, dx, dy, dz)
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// stagingClaimTag marks the marker entities that claim staging slots.
+const stagingClaimTag = "tf_staging_claim"
+
+// stagingClaimSelector selects the claim marker in the block at corner.
+func stagingClaimSelector(corner [3]int) string {
+	return fmt.Sprintf("@e[type=minecraft:marker,tag=%s,x=%d,y=%d,z=%d,dx=0,dy=0,dz=0]", stagingClaimTag, corner[0], corner[1], corner[2])
+}
+
+// ClaimStagingSlot claims the staging slot whose lowest corner is at corner by
+// summoning a tagged marker there, so the claim survives this run and is seen
+// by every client of the server. It reports false, claiming nothing, when the
+// slot is already claimed. The slot must be loaded.
+func (c Client) ClaimStagingSlot(ctx context.Context, corner [3]int) (bool, error) {
+	out, err := c.send(ctx, "execute if entity "+stagingClaimSelector(corner))
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case strings.Contains(out, "Test passed"):
+		return false, nil
+	case !strings.Contains(out, "Test failed"):
+		return false, fmt.Errorf("unexpected reply checking the staging slot at %d %d %d: %s", corner[0], corner[1], corner[2], strings.TrimSpace(out))
+	}
+	_, err = c.send(ctx, fmt.Sprintf("summon minecraft:marker %d %d %d %s", corner[0], corner[1], corner[2], BuildMobNBT(MobNBTOptions{ExtraTags: []string{stagingClaimTag}})))
+	return true, err
+}
+
+// ReleaseStagingSlot removes the claim ClaimStagingSlot put on the slot at corner.
+func (c Client) ReleaseStagingSlot(ctx context.Context, corner [3]int) error {
+	_, err := c.send(ctx, "kill "+stagingClaimSelector(corner))
+	return err
+}
+
+// MoveRegion moves the cuboid between from1 and from2 so that its lowest
+// corner lands at dest, leaving air behind. The game refuses overlapping
+// source and destination areas.
+func (c Client) MoveRegion(ctx context.Context, from1, from2, dest [3]int) error {
+	command := fmt.Sprintf("clone %d %d %d %d %d %d %d %d %d replace move",
+		from1[0], from1[1], from1[2], from2[0], from2[1], from2[2], dest[0], dest[1], dest[2])
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// ForceloadAdd keeps every chunk between the two block coordinates loaded.
+func (c Client) ForceloadAdd(ctx context.Context, x1, z1, x2, z2 int) error {
+	_, err := c.send(ctx, fmt.Sprintf("forceload add %d %d %d %d", x1, z1, x2, z2))
+	return err
+}
+
+// ForceloadRemove stops force-loading the chunks between the two block coordinates.
+func (c Client) ForceloadRemove(ctx context.Context, x1, z1, x2, z2 int) error {
+	_, err := c.send(ctx, fmt.Sprintf("forceload remove %d %d %d %d", x1, z1, x2, z2))
+	return err
+}
+
+// ForceloadQuery reports whether the chunk containing block (x, z) is force-loaded.
+func (c Client) ForceloadQuery(ctx context.Context, x, z int) (bool, error) {
+	out, err := c.send(ctx, fmt.Sprintf("forceload query %d %d", x, z))
+	if err != nil {
+		return false, fmt.Errorf("send command: %w", err)
+	}
+	return parseForceloadQuery(out)
+}
+
+// Typical output:
+// Chunk at [0, 0] in minecraft:overworld is marked for force loading
+// Chunk at [0, 0] in minecraft:overworld is not marked for force loading
+func parseForceloadQuery(out string) (bool, error) {
+	switch {
+	case strings.Contains(out, "is not marked for force loading"):
+		return false, nil
+	case strings.Contains(out, "is marked for force loading"):
+		return true, nil
+	default:
+		return false, fmt.Errorf("unexpected response: %q", out)
+	}
+}
+
+// RegenerateChunksFunction is the function RegenerateChunks calls. Vanilla
+// can't regenerate terrain, so a server opts in with a datapack defining it,
+// usually wrapping a plugin command. It gets the chunk range as the macro
+// arguments from_x, from_z, to_x and to_z, in chunk coordinates with from
+// never greater than to.
+const RegenerateChunksFunction = "terraform:regenerate_chunks"
+
+// RegenerateChunks resets the chunks between two block coordinates (x, z) to
+// freshly generated terrain by calling RegenerateChunksFunction, e.g.
+//
+//	function terraform:regenerate_chunks {from_x:-1,from_z:0,to_x:2,to_z:3}
+//
+// A server without the function, or too old for function macros (1.20.2),
+// gives an error wrapping ErrUnsupported.
+func (c Client) RegenerateChunks(ctx context.Context, from, to [2]int) error {
+	out, err := c.send(ctx, regenerateChunksCommand(from, to))
+	if err != nil {
+		return err
+	}
+	// e.g. "Unknown function terraform:regenerate_chunks", or before 1.20.2
+	// "Incorrect argument for command" at the macro arguments.
+	lower := strings.ToLower(out)
+	if strings.Contains(lower, "unknown function") || strings.Contains(lower, "incorrect argument") || isUnknownCommand(out) {
+		return fmt.Errorf("chunk regeneration needs a datapack defining %s: %w", RegenerateChunksFunction, ErrUnsupported)
+	}
+	return nil
+}
+
+func regenerateChunksCommand(from, to [2]int) string {
+	chunk := func(a, b int) (int, int) {
+		ca, cb := floorDiv16(a), floorDiv16(b)
+		if ca > cb {
+			ca, cb = cb, ca
+		}
+		return ca, cb
+	}
+	x1, x2 := chunk(from[0], to[0])
+	z1, z2 := chunk(from[1], to[1])
+	return fmt.Sprintf("function %s {from_x:%d,from_z:%d,to_x:%d,to_z:%d}", RegenerateChunksFunction, x1, z1, x2, z2)
+}
+
+// floorDiv16 returns the chunk coordinate of a block coordinate.
+func floorDiv16(v int) int {
+	if v < 0 {
+		return -((-v + 15) / 16)
+	}
+	return v / 16
+}
+
+// StrikeLightning summons a lightning bolt at position ("x y z"). A cosmetic
+// bolt (Effects:0b) is purely visual and doesn't start fires.
+func (c Client) StrikeLightning(ctx context.Context, position string, cosmetic bool) error {
+	command := fmt.Sprintf("summon minecraft:lightning_bolt %s", position)
+	if cosmetic {
+		command += " {Effects:0b}"
+	}
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// MaxXPOrbValue is the most experience one orb can hold; Value is a short.
+const MaxXPOrbValue = math.MaxInt16
+
+// SummonXPOrb summons an experience orb worth value points at position ("x y z").
+func (c Client) SummonXPOrb(ctx context.Context, position string, value int) error {
+	command, err := xpOrbCommand(position, value)
+	if err != nil {
+		return err
+	}
+	_, err = c.send(ctx, command)
+	return err
+}
+
+// xpOrbCommand builds the summon command, e.g.
+//
+//	summon minecraft:experience_orb 0 64 0 {Value:10s}
+func xpOrbCommand(position string, value int) (string, error) {
+	if value < 1 || value > MaxXPOrbValue {
+		return "", fmt.Errorf("value must be between 1 and %d (got %d)", MaxXPOrbValue, value)
+	}
+	nbt := BuildMobNBT(MobNBTOptions{Fields: []string{fmt.Sprintf("Value:%ds", value)}})
+	return fmt.Sprintf("summon minecraft:experience_orb %s %s", position, nbt), nil
+}
+
+// MaxMotion is the largest per-tick speed an entity keeps when summoned; the
+// game zeroes any Motion component above it.
+const MaxMotion = 10.0
+
+// SummonProjectile summons entityType (an arrow, snowball, fireball, ...) at
+// position ("x y z") moving with the given velocity in blocks per tick.
+func (c Client) SummonProjectile(ctx context.Context, entityType, position string, motion [3]float64) error {
+	nbt := BuildMobNBT(MobNBTOptions{Fields: []string{"Motion:" + nbtDoubleList(motion[:])}})
+	command := fmt.Sprintf("summon %s %s %s", entityType, position, nbt)
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// MaxItemStack is the largest count a dropped item stack may hold.
+const MaxItemStack = 64
+
+// CreateDroppedItem drops count of item at position ("x y z"), tagged and
+// named with id. noDespawn keeps it from despawning after five minutes;
+// pickupLocked stops anyone from picking it up, for display-only items.
+func (c Client) CreateDroppedItem(ctx context.Context, position, id, item string, count int, noDespawn, pickupLocked, useComponents bool) error {
+	if count < 1 || count > MaxItemStack {
+		return fmt.Errorf("item count must be between 1 and %d (got %d)", MaxItemStack, count)
+	}
+	fields := []string{"Item:" + itemStackNBT(item, count, useComponents)}
+	if noDespawn {
+		// Age counts up to 6000 ticks; -32768 is the magic value that never despawns.
+		fields = append(fields, "Age:-32768s")
+	}
+	if pickupLocked {
+		// 32767 is the magic value for "never pick up".
+		fields = append(fields, "PickupDelay:32767s")
+	}
+	nbt := BuildMobNBT(MobNBTOptions{ID: id, Fields: fields})
+	_, err := c.send(ctx, fmt.Sprintf("summon minecraft:item %s %s", position, nbt))
+	return err
+}
+
+// ContainerSlots is how many slots `item replace block` can address in each
+// container block. A double chest is two chest blocks of 27 slots each.
+var ContainerSlots = map[string]int{
+	"minecraft:chest":         27,
+	"minecraft:trapped_chest": 27,
+	"minecraft:barrel":        27,
+	"minecraft:shulker_box":   27,
+	"minecraft:dispenser":     9,
+	"minecraft:dropper":       9,
+	"minecraft:crafter":       9,
+	"minecraft:hopper":        5,
+	"minecraft:brewing_stand": 5,
+	"minecraft:furnace":       3,
+	"minecraft:blast_furnace": 3,
+	"minecraft:smoker":        3,
+}
+
+// ReplaceBlockItem puts count of item into slot (container.<slot>) of the
+// container block at x, y, z, replacing whatever was there. Replacing with
+// minecraft:air empties the slot.
+func (c Client) ReplaceBlockItem(ctx context.Context, x, y, z int, slot int, item string, count int) error {
+	if slot < 0 {
+		return fmt.Errorf("slot must not be negative (got %d)", slot)
+	}
+	if count < 1 || count > MaxItemStack {
+		return fmt.Errorf("item count must be between 1 and %d (got %d)", MaxItemStack, count)
+	}
+	out, err := c.send(ctx, fmt.Sprintf("item replace block %d %d %d container.%d with %s %d", x, y, z, slot, item, count))
+	if err != nil {
+		return err
+	}
+	// e.g. "Target position 1, 64, 2 is not a container" or
+	// "The target does not have slot container.30".
+	if lower := strings.ToLower(out); strings.Contains(lower, "is not a container") || strings.Contains(lower, "does not have slot") {
+		return fmt.Errorf("item replace at %d %d %d: %s", x, y, z, strings.TrimSpace(out))
+	}
+	return nil
+}
+
+// CheckCompoundNBT does a light structural check of an SNBT compound such as
+// {owner:"build",level:3}: it must be wrapped in braces, with brackets
+// balanced and quoted strings closed. It does not parse values.
+func CheckCompoundNBT(nbt string) error {
+	nbt = strings.TrimSpace(nbt)
+	if !strings.HasPrefix(nbt, "{") || !strings.HasSuffix(nbt, "}") {
+		return fmt.Errorf("NBT must be a compound wrapped in {} (got %q)", nbt)
+	}
+	var stack []rune
+	var quote rune
+	escaped := false
+	for _, r := range nbt {
+		switch {
+		case quote != 0:
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == quote:
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '{' || r == '[':
+			stack = append(stack, r)
+		case r == '}' || r == ']':
+			open := '{'
+			if r == ']' {
+				open = '['
+			}
+			if len(stack) == 0 || stack[len(stack)-1] != open {
+				return fmt.Errorf("NBT has an unbalanced %q (got %q)", r, nbt)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if quote != 0 {
+		return fmt.Errorf("NBT has an unterminated string (got %q)", nbt)
+	}
+	if len(stack) != 0 {
+		return fmt.Errorf("NBT has an unclosed %q (got %q)", stack[len(stack)-1], nbt)
+	}
+	return nil
+}
+
+// CreateMarker summons a marker entity at position ("x y z"), tagged and named
+// with id. data, if set, is an SNBT compound stored in the marker's data tag
+// for datapacks and scripts to read.
+func (c Client) CreateMarker(ctx context.Context, position, id, data string) error {
+	var fields []string
+	if data != "" {
+		if err := CheckCompoundNBT(data); err != nil {
+			return err
+		}
+		fields = append(fields, "data:"+strings.TrimSpace(data))
+	}
+	nbt := BuildMobNBT(MobNBTOptions{ID: id, Fields: fields})
+	_, err := c.send(ctx, fmt.Sprintf("summon minecraft:marker %s %s", position, nbt))
+	return err
+}
+
+// SetMarkerData replaces the data tag of the named marker; an empty data
+// clears it.
+func (c Client) SetMarkerData(ctx context.Context, customName, data string) error {
+	if data == "" {
+		data = "{}"
+	}
+	if err := CheckCompoundNBT(data); err != nil {
+		return err
+	}
+	command := fmt.Sprintf("data modify entity %s data set value %s", limitOne(SelectorByCustomName(customName)), strings.TrimSpace(data))
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// CloudEffect is one status effect applied by an area effect cloud.
+// Duration is in ticks; Amplifier 0 is level I.
+type CloudEffect struct {
+	ID        string
+	Amplifier int
+	Duration  int
+}
+
+// CreateEffectCloud summons an area_effect_cloud at position ("x y z"),
+// tagged and named with id, that applies effects to entities inside radius
+// for duration ticks. particle and color are optional ("" and nil keep the
+// defaults). useComponents selects the 1.20.5+ layout, where effects and
+// color live under potion_contents and the particle is a compound.
+func (c Client) CreateEffectCloud(ctx context.Context, position, id string, radius float64, duration int, effects []CloudEffect, particle string, color *int, useComponents bool) error {
+	nbt := BuildMobNBT(MobNBTOptions{ID: id, Fields: effectCloudNBT(radius, duration, effects, particle, color, useComponents)})
+	_, err := c.send(ctx, fmt.Sprintf("summon minecraft:area_effect_cloud %s %s", position, nbt))
+	return err
+}
+
+// effectCloudNBT returns the cloud's own tags, e.g.
+//
+//	Radius:3f,Duration:600,Effects:[{Id:"minecraft:poison",Amplifier:1b,Duration:200}]
+func effectCloudNBT(radius float64, duration int, effects []CloudEffect, particle string, color *int, useComponents bool) []string {
+	tags := []string{
+		"Radius:" + strconv.FormatFloat(radius, 'f', -1, 64) + "f",
+		fmt.Sprintf("Duration:%d", duration),
+	}
+
+	list := make([]string, len(effects))
+	for i, e := range effects {
+		// The amplifier is an unsigned byte stored in a signed one, so
+		// 128-255 are written as their negative equivalents.
+		amplifier := int8(e.Amplifier)
+		if useComponents {
+			list[i] = fmt.Sprintf(`{id:"%s",amplifier:%db,duration:%d}`, e.ID, amplifier, e.Duration)
+		} else {
+			list[i] = fmt.Sprintf(`{Id:"%s",Amplifier:%db,Duration:%d}`, e.ID, amplifier, e.Duration)
+		}
+	}
+	effectList := "[" + strings.Join(list, ",") + "]"
+
+	if useComponents {
+		contents := []string{"custom_effects:" + effectList}
+		if color != nil {
+			contents = append(contents, fmt.Sprintf("custom_color:%d", *color))
+		}
+		tags = append(tags, "potion_contents:{"+strings.Join(contents, ",")+"}")
+		if particle != "" {
+			tags = append(tags, fmt.Sprintf(`Particle:{type:"%s"}`, particle))
+		}
+		return tags
+	}
+
+	tags = append(tags, "Effects:"+effectList)
+	if color != nil {
+		tags = append(tags, fmt.Sprintf("Color:%d", *color))
+	}
+	if particle != "" {
+		tags = append(tags, fmt.Sprintf(`Particle:"%s"`, particle))
+	}
+	return tags
+}
+
+// itemFrameFacings maps a direction to the item frame Facing byte.
+var itemFrameFacings = map[string]int{
+	"down": 0, "up": 1, "north": 2, "south": 3, "west": 4, "east": 5,
+}
+
+// CreateItemFrame summons an item frame (or glow item frame) on the block face
+// at position ("x y z") pointing facing, holding item turned rotation eighths.
+// The frame is tagged and named with id so it can be found again.
+func (c Client) CreateItemFrame(ctx context.Context, position, id, facing, item string, rotation int, glow, useComponents bool) error {
+	f, ok := itemFrameFacings[facing]
+	if !ok {
+		return fmt.Errorf("unsupported item frame facing %q", facing)
+	}
+	entity := "minecraft:item_frame"
+	if glow {
+		entity = "minecraft:glow_item_frame"
+	}
+	fields := []string{
+		fmt.Sprintf("Facing:%db", f),
+		fmt.Sprintf("ItemRotation:%db", rotation),
+	}
+	if item != "" {
+		fields = append(fields, "Item:"+itemStackNBT(item, 1, useComponents))
+	}
+	nbt := BuildMobNBT(MobNBTOptions{ID: id, Fields: fields})
+	_, err := c.send(ctx, fmt.Sprintf("summon %s %s %s", entity, position, nbt))
+	return err
+}
+
+// SetItemFrameRotation turns the item in the named item frame to rotation
+// (0-7, in 45 degree steps) without replacing the frame.
+func (c Client) SetItemFrameRotation(ctx context.Context, customName string, rotation int) error {
+	if rotation < 0 || rotation > 7 {
+		return fmt.Errorf("item frame rotation must be between 0 and 7 (got %d)", rotation)
+	}
+	command := fmt.Sprintf("data merge entity %s {ItemRotation:%db}", limitOne(SelectorByCustomName(customName)), rotation)
+	_, err := c.send(ctx, command)
+	return err
+}
+
+// SummonFallingBlock spawns a falling_block entity of the given block state
+// (e.g. "minecraft:sand" or "minecraft:oak_stairs[facing=east]"). A positive
+// time sets its age in ticks; noGravity keeps it hanging in place.
+func (c Client) SummonFallingBlock(ctx context.Context, position, blockState string, noGravity bool, time int) error {
+	_, err := c.send(ctx, fmt.Sprintf("summon minecraft:falling_block %s %s", position, fallingBlockNBT(blockState, noGravity, time)))
+	return err
+}
+
+func fallingBlockNBT(blockState string, noGravity bool, time int) string {
+	fields := []string{"BlockState:" + blockStateNBT(blockState)}
+	if time > 0 {
+		fields = append(fields, fmt.Sprintf("Time:%d", time))
+	}
+	return BuildMobNBT(MobNBTOptions{Fields: fields, NoGravity: noGravity})
+}
+
+// DamageTarget runs `damage <target> <amount> [type] [at <location> | by <source>]`.
+// location and source are mutually exclusive and need a damage type; when
+// either is set without one, minecraft:generic is used.
+func (c Client) DamageTarget(ctx context.Context, target string, amount float64, damageType, source, location string) error {
+	out, err := c.send(ctx, damageCommand(target, amount, damageType, source, location))
+	if err != nil {
+		return err
+	}
+
+	// Typical failures:
+	// Target is invulnerable to the given damage type
+	// No entity was found
+	lower := strings.ToLower(out)
+	switch {
+	case strings.Contains(lower, "invulnerable"):
+		return fmt.Errorf("target %s is invulnerable: %s", target, out)
+	case strings.Contains(lower, "no entity was found"):
+		return fmt.Errorf("no entity found for %s", target)
+	}
+	return nil
+}
+
+func damageCommand(target string, amount float64, damageType, source, location string) string {
+	cmd := fmt.Sprintf("damage %s %s", target, strconv.FormatFloat(amount, 'f', -1, 64))
+	if damageType == "" && (source != "" || location != "") {
+		damageType = "minecraft:generic"
+	}
+	if damageType != "" {
+		cmd += " " + damageType
+	}
+	switch {
+	case location != "":
+		cmd += " at " + location
+	case source != "":
+		cmd += " by " + source
+	}
+	return cmd
+}
+
+// Horizontal facings in clockwise order (seen from above).
+var clockwiseFacings = []string{"north", "east", "south", "west"}
+
+// nextFacing returns the facing one quarter turn clockwise from f.
+func nextFacing(f string) (string, error) {
+	for i, cur := range clockwiseFacings {
+		if cur == f {
+			return clockwiseFacings[(i+1)%len(clockwiseFacings)], nil
+		}
+	}
+	return "", fmt.Errorf("cannot rotate a block facing %q", f)
+}
+
+// GetBlockFacing finds the facing state of the block at x, y, z. RCON can't
+// report an arbitrary block's state, so each facing is probed with
+// `execute if block` against the expected material.
+func (c Client) GetBlockFacing(ctx context.Context, material string, x, y, z int) (string, error) {
+	for _, f := range []string{"north", "east", "south", "west", "up", "down"} {
+		out, err := c.send(ctx, fmt.Sprintf("execute if block %d %d %d %s[facing=%s]", x, y, z, material, f))
+		if err != nil {
+			return "", fmt.Errorf("send command: %w", err)
+		}
+		if strings.Contains(out, "Test passed") {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("block at %d %d %d is not %s with a facing state", x, y, z, material)
+}
+
+// RotateBlock turns the directional block at x, y, z a quarter turn clockwise
+// and returns its new facing. Other block states are reset to their defaults.
+func (c Client) RotateBlock(ctx context.Context, material string, x, y, z int) (string, error) {
+	current, err := c.GetBlockFacing(ctx, material, x, y, z)
+	if err != nil {
+		return "", err
+	}
+	next, err := nextFacing(current)
+	if err != nil {
+		return "", err
+	}
+	if _, err := c.send(ctx, fmt.Sprintf("setblock %d %d %d %s[facing=%s] replace", x, y, z, material, next)); err != nil {
+		return "", err
+	}
+	return next, nil
+}
+
+// ObjectiveCriteria lists the single-word objective criteria. Criteria are
+// case-sensitive.
+var ObjectiveCriteria = []string{
+	"dummy", "trigger", "deathCount", "playerKillCount", "totalKillCount",
+	"health", "xp", "level", "food", "air", "armor",
+}
+
+// objectiveStatTypes are the statistic types usable in vanilla stat criteria
+// such as minecraft.killed:minecraft.zombie.
+var objectiveStatTypes = []string{
+	"custom", "mined", "broken", "crafted", "used", "picked_up", "dropped", "killed", "killed_by",
+}
+
+// statCriterionPattern matches <namespace>.<type>:<namespace>.<id>.
+var statCriterionPattern = regexp.MustCompile(`^([a-z0-9_.-]+)\.([a-z0-9_]+):[a-z0-9_.-]+\.[a-z0-9_/.-]+$`)
+
+// ValidateObjectiveCriterion checks a criterion before it reaches the server,
+// which would otherwise only report a generic error at apply time. Besides
+// ObjectiveCriteria it accepts teamkill.<color>, killedByTeam.<color> and
+// stat criteria; stats outside the minecraft namespace are assumed valid.
+func ValidateObjectiveCriterion(criterion string) error {
+	for _, c := range ObjectiveCriteria {
+		if criterion == c {
+			return nil
+		}
+		if strings.EqualFold(criterion, c) {
+			return fmt.Errorf("criteria are case-sensitive: use %q instead of %q", c, criterion)
+		}
+	}
+
+	for _, prefix := range []string{"teamkill.", "killedByTeam."} {
+		if !strings.HasPrefix(criterion, prefix) {
+			continue
+		}
+		color := strings.TrimPrefix(criterion, prefix)
+		// "reset" is a team color but not a criterion suffix.
+		for _, c := range ValidTeamColors[:16] {
+			if color == c {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q needs a chat color after %q, e.g. %sred", criterion, prefix, prefix)
+	}
+
+	if strings.HasPrefix(criterion, "stat.") {
+		return fmt.Errorf("%q uses the pre-1.13 stat.* form; use minecraft.<type>:minecraft.<id> instead, e.g. minecraft.killed:minecraft.zombie", criterion)
+	}
+
+	if strings.Contains(criterion, ":") {
+		m := statCriterionPattern.FindStringSubmatch(criterion)
+		if m == nil {
+			return fmt.Errorf("%q is not a stat criterion; expected <namespace>.<type>:<namespace>.<id>, e.g. minecraft.killed:minecraft.zombie", criterion)
+		}
+		if m[1] == "minecraft" {
+			for _, t := range objectiveStatTypes {
+				if m[2] == t {
+					return nil
+				}
+			}
+			return fmt.Errorf("%q has unknown stat type %q; expected one of %s", criterion, m[2], strings.Join(objectiveStatTypes, ", "))
+		}
+		return nil
+	}
+
+	if hint := closestCriterion(criterion); hint != "" {
+		return fmt.Errorf("unknown criterion %q; did you mean %q?", criterion, hint)
+	}
+	return fmt.Errorf("unknown criterion %q; expected one of %s, teamkill.<color>, killedByTeam.<color> or a stat such as minecraft.killed:minecraft.zombie",
+		criterion, strings.Join(ObjectiveCriteria, ", "))
+}
+
+// closestCriterion returns the single-word criterion within two edits of s,
+// or "" if none is that close.
+func closestCriterion(s string) string {
+	best, bestDist := "", 3
+	for _, c := range ObjectiveCriteria {
+		if d := editDistance(strings.ToLower(s), strings.ToLower(c)); d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minOf(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
+
+func minOf(first int, rest ...int) int {
+	for _, v := range rest {
+		if v < first {
+			first = v
+		}
+	}
+	return first
+}
+
+// CreateObjective adds a scoreboard objective with the given criterion
+// (e.g. "dummy", "trigger", "minecraft.killed:minecraft.zombie").
+func (c Client) CreateObjective(ctx context.Context, name, criterion, displayName string) error {
+	cmd := fmt.Sprintf("scoreboard objectives add %s %s", name, criterion)
+	if displayName != "" {
+		escaped := strings.ReplaceAll(displayName, `"`, `\"`)
+		cmd += fmt.Sprintf(` {"text":"%s"}`, escaped)
+	}
+	_, err := c.send(ctx, cmd)
+	return err
+}
+
+// ModifyObjectiveDisplayName changes the name shown for an objective in the
+// sidebar, tab list and below names.
+func (c Client) ModifyObjectiveDisplayName(ctx context.Context, name, displayName string) error {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(displayName)
+	_, err := c.send(ctx, fmt.Sprintf(`scoreboard objectives modify %s displayname {"text":"%s"}`, name, escaped))
+	return err
+}
+
+// IsObjectiveRenderType reports whether t is integer or hearts.
+func IsObjectiveRenderType(t string) bool {
+	return t == "integer" || t == "hearts"
+}
+
+// ModifyObjectiveRenderType sets how an objective's scores are drawn in the
+// tab list: "integer" or "hearts".
+func (c Client) ModifyObjectiveRenderType(ctx context.Context, name, renderType string) error {
+	if !IsObjectiveRenderType(renderType) {
+		return fmt.Errorf("unsupported render type %q (want integer or hearts)", renderType)
+	}
+	_, err := c.send(ctx, fmt.Sprintf("scoreboard objectives modify %s rendertype %s", name, renderType))
+	return err
+}
+
+// RemoveObjective deletes a scoreboard objective and all of its scores.
+func (c Client) RemoveObjective(ctx context.Context, name string) error {
+	_, err := c.send(ctx, fmt.Sprintf("scoreboard objectives remove %s", name))
+	return err
+}
+
+// EnableTrigger lets the target run `/trigger <objective>` (once, until re-enabled).
+func (c Client) EnableTrigger(ctx context.Context, target, objective string) error {
+	_, err := c.send(ctx, fmt.Sprintf("scoreboard players enable %s %s", target, objective))
 	return err
 }
 
-func (c Client) SetTeamFriendlyFire(ctx context.Context, name string, enabled bool) error {
-	val := "true"
-	if !enabled {
-		val = "false"
+// GetScore reads a single score holder's value for an objective.
+func (c Client) GetScore(ctx context.Context, target, objective string) (int, error) {
+	out, err := c.send(ctx, fmt.Sprintf("scoreboard players get %s %s", target, objective))
+	if err != nil {
+		return 0, fmt.Errorf("send command: %w", err)
+	}
+	return parseScore(out)
+}
+
+// ExecuteStoreResult runs command and stores its numeric result in the
+// target's score for objective, then reads the score back, e.g. the number of
+// entities matched by `execute if entity @e[type=minecraft:cow]`.
+func (c Client) ExecuteStoreResult(ctx context.Context, target, objective, command string) (int, error) {
+	out, err := c.send(ctx, executeStoreCommand(target, objective, command))
+	if err != nil {
+		return 0, fmt.Errorf("send command: %w", err)
+	}
+	if err := checkExecuteStoreReply(out, objective); err != nil {
+		return 0, err
+	}
+	return c.GetScore(ctx, target, objective)
+}
+
+// executeStoreCommand builds e.g.
+//
+//	execute store result score #cows counts run execute if entity @e[type=minecraft:cow]
+func executeStoreCommand(target, objective, command string) string {
+	command = strings.TrimPrefix(strings.TrimSpace(command), "/")
+	return fmt.Sprintf("execute store result score %s %s run %s", target, objective, command)
+}
+
+// checkExecuteStoreReply turns the parse errors of an execute store into
+// errors. A command that merely fails still stores 0 and is not an error.
+//
+// Typical failures:
+// Unknown scoreboard objective 'counts'
+// Unknown or incomplete command, see below for error
+func checkExecuteStoreReply(out, objective string) error {
+	lower := strings.ToLower(out)
+	switch {
+	case strings.Contains(lower, "unknown scoreboard objective"):
+		return fmt.Errorf("objective %q does not exist", objective)
+	case isUnknownCommand(out), strings.Contains(lower, "incorrect argument"):
+		return fmt.Errorf("command rejected: %s", strings.TrimSpace(out))
+	}
+	return nil
+}
+
+// Operators accepted by `scoreboard players operation`.
+var scoreOperators = map[string]bool{
+	"+=": true, "-=": true, "*=": true, "/=": true, "%=": true,
+	"=": true, "<": true, ">": true, "><": true,
+}
+
+// IsScoreOperator reports whether op is a valid scoreboard operation.
+func IsScoreOperator(op string) bool {
+	return scoreOperators[op]
+}
+
+// ScoreOperation applies `target targetObj op source sourceObj`, e.g. adding
+// one player's kills to a team total with "+=".
+func (c Client) ScoreOperation(ctx context.Context, target, targetObj, op, source, sourceObj string) error {
+	if !IsScoreOperator(op) {
+		return fmt.Errorf("unsupported scoreboard operator %q", op)
 	}
-	_, err := c.client.SendCommand(fmt.Sprintf("team modify %s friendlyFire %s", name, val))
+	cmd := fmt.Sprintf("scoreboard players operation %s %s %s %s %s", target, targetObj, op, source, sourceObj)
+	_, err := c.send(ctx, cmd)
 	return err
 }
 
-func (c Client) SetTeamSeeFriendlyInvisibles(ctx context.Context, name string, enabled bool) error {
-	val := "true"
-	if !enabled {
-		val = "false"
+// Typical output:
+// Steve has 5 [menu]
+// Can't get value of menu for Steve; none is set
+func parseScore(out string) (int, error) {
+	fields := strings.Fields(out)
+	for i := 0; i+1 < len(fields); i++ {
+		if fields[i] == "has" {
+			if n, err := strconv.Atoi(fields[i+1]); err == nil {
+				return n, nil
+			}
+		}
 	}
-	_, err := c.client.SendCommand(fmt.Sprintf("team modify %s seeFriendlyInvisibles %s", name, val))
-	return err
+	return 0, fmt.Errorf("unexpected response: %q", out)
 }
 
-// Nametag visibility: always | never | hideForOtherTeams | hideForOwnTeam
-func (c Client) SetTeamNametagVisibility(ctx context.Context, name, mode string) error {
-	mode = strings.TrimSpace(mode)
-	_, err := c.client.SendCommand(fmt.Sprintf("team modify %s nametagVisibility %s", name, mode))
+// GiveEnchantedItem gives the player one item with the given enchantment levels.
+// useComponents selects the item component syntax introduced in 1.20.5;
+// older servers need the legacy {Enchantments:[...]} NBT tag instead.
+func (c Client) GiveEnchantedItem(ctx context.Context, player, item string, enchants map[string]int, useComponents bool) error {
+	_, err := c.send(ctx, fmt.Sprintf("give %s %s", player, enchantedItemArg(item, enchants, useComponents)))
 	return err
 }
 
-// Collision rule: always | never | pushOtherTeams | pushOwnTeam
-func (c Client) SetTeamCollisionRule(ctx context.Context, name, rule string) error {
-	rule = strings.TrimSpace(rule)
-	_, err := c.client.SendCommand(fmt.Sprintf("team modify %s collisionRule %s", name, rule))
-	return err
+// enchantedItemArg renders an item argument with enchantments, e.g.
+//
+//	minecraft:diamond_sword[enchantments={levels:{"minecraft:sharpness":5}}]
+//	minecraft:diamond_sword{Enchantments:[{id:"minecraft:sharpness",lvl:5s}]}
+func enchantedItemArg(item string, enchants map[string]int, useComponents bool) string {
+	if len(enchants) == 0 {
+		return item
+	}
+
+	// Sort so the command is stable across runs.
+	ids := make([]string, 0, len(enchants))
+	for id := range enchants {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		if useComponents {
+			parts[i] = fmt.Sprintf(`"%s":%d`, id, enchants[id])
+		} else {
+			parts[i] = fmt.Sprintf(`{id:"%s",lvl:%ds}`, id, enchants[id])
+		}
+	}
+
+	if useComponents {
+		return fmt.Sprintf("%s[enchantments={levels:{%s}}]", item, strings.Join(parts, ","))
+	}
+	return fmt.Sprintf("%s{Enchantments:[%s]}", item, strings.Join(parts, ","))
 }
 
-// Display name: Minecraft accepts a text component; a plain quoted string also works.
-// Safest is a simple text component.
-func (c Client) SetTeamDisplayName(ctx context.Context, name, display string) error {
-	escaped := strings.ReplaceAll(display, `"`, `\"`)
-	cmd := fmt.Sprintf(`team modify %s displayName {"text":"%s"}`, name, escaped)
-	_, err := c.client.SendCommand(cmd)
-	return err
+// MaxLoreLines is the most lore lines an item may carry.
+const MaxLoreLines = 256
+
+// ItemComponents are the 1.20.5+ item components supported by GiveComponentItem.
+// Zero values leave a component out.
+type ItemComponents struct {
+	CustomName      string
+	Lore            []string
+	Unbreakable     bool
+	CustomModelData *int
+	GlintOverride   *bool
+
+	// FloatModelData writes custom_model_data as {floats:[N]} (1.21.4+)
+	// instead of a plain int.
+	FloatModelData bool
+	// SNBTText writes names and lore as SNBT strings (1.21.5+) instead of
+	// JSON text components.
+	SNBTText bool
 }
 
-// Join arbitrary targets to a team (players or selectors).
-// Examples:
-//
-//	JoinTeamTargets(ctx, "blue", "Steve")
-//	JoinTeamTargets(ctx, "red", "@a[team=]")
-//	JoinTeamTargets(ctx, "blue", "@e[type=minecraft:zombie,limit=5]")
-func (c Client) JoinTeamTargets(ctx context.Context, team string, targets ...string) error {
-	if len(targets) == 0 {
-		return nil
+// GiveComponentItem gives the player one item with the given components.
+// It needs a 1.20.5+ server; older ones only understand item NBT.
+func (c Client) GiveComponentItem(ctx context.Context, player, item string, components ItemComponents) error {
+	arg, err := componentItemArg(item, components)
+	if err != nil {
+		return err
 	}
-	cmd := fmt.Sprintf("team join %s %s", team, strings.Join(targets, " "))
-	_, err := c.client.SendCommand(cmd)
+	_, err = c.send(ctx, fmt.Sprintf("give %s %s", player, arg))
 	return err
 }
 
-// Make the given targets leave whichever team they’re in.
-// Examples:
+// componentItemArg renders an item argument with components, e.g.
 //
-//	LeaveTeamTargets(ctx, "Steve")
-//	LeaveTeamTargets(ctx, "@e[type=minecraft:zombie,distance=..10]")
-func (c Client) LeaveTeamTargets(ctx context.Context, targets ...string) error {
-	if len(targets) == 0 {
-		return nil
+//	minecraft:stick[custom_name='{"text":"Wand"}',lore=['{"text":"Zap"}'],unbreakable={}]
+func componentItemArg(item string, comp ItemComponents) (string, error) {
+	if len(comp.Lore) > MaxLoreLines {
+		return "", fmt.Errorf("at most %d lore lines are allowed (got %d)", MaxLoreLines, len(comp.Lore))
+	}
+	for _, line := range append([]string{comp.CustomName}, comp.Lore...) {
+		if strings.ContainsAny(line, "\r\n") {
+			return "", fmt.Errorf("item text must be a single line (got %q)", line)
+		}
 	}
-	cmd := fmt.Sprintf("team leave %s", strings.Join(targets, " "))
-	_, err := c.client.SendCommand(cmd)
-	return err
-}
 
-// ---------- Convenience: players by name ----------
+	text := func(s string) string {
+		if comp.SNBTText {
+			return quoteArg(s)
+		}
+		// JSON-escape for the text component, then escape again for the single-quoted SNBT string.
+		escaped := strings.NewReplacer(`\`, `\\\\`, `"`, `\\"`, `'`, `\'`).Replace(s)
+		return fmt.Sprintf(`'{"text":"%s"}'`, escaped)
+	}
 
-func (c Client) JoinTeamPlayers(ctx context.Context, team string, players ...string) error {
-	// Players can be batched in one command
-	return c.JoinTeamTargets(ctx, team, players...)
-}
+	var parts []string
+	if comp.CustomName != "" {
+		parts = append(parts, "custom_name="+text(comp.CustomName))
+	}
+	if len(comp.Lore) > 0 {
+		lines := make([]string, len(comp.Lore))
+		for i, line := range comp.Lore {
+			lines[i] = text(line)
+		}
+		parts = append(parts, fmt.Sprintf("lore=[%s]", strings.Join(lines, ",")))
+	}
+	if comp.Unbreakable {
+		parts = append(parts, "unbreakable={}")
+	}
+	if comp.CustomModelData != nil {
+		if comp.FloatModelData {
+			parts = append(parts, fmt.Sprintf("custom_model_data={floats:[%df]}", *comp.CustomModelData))
+		} else {
+			parts = append(parts, fmt.Sprintf("custom_model_data=%d", *comp.CustomModelData))
+		}
+	}
+	if comp.GlintOverride != nil {
+		parts = append(parts, fmt.Sprintf("enchantment_glint_override=%t", *comp.GlintOverride))
+	}
 
-func (c Client) LeaveTeamPlayers(ctx context.Context, players ...string) error {
-	return c.LeaveTeamTargets(ctx, players...)
+	if len(parts) == 0 {
+		return item, nil
+	}
+	return fmt.Sprintf("%s[%s]", item, strings.Join(parts, ",")), nil
 }
 
-// ---------- Convenience: entities by stable CustomName ----------
-// You mentioned you embed a UUID in the entity's CustomName when creating it.
-// We can build a selector that matches that name exactly.
-//
-// NOTE: We escape double-quotes in the name to keep the JSON valid.
-func selectorByCustomName(name string) string {
-	escaped := strings.ReplaceAll(name, `"`, `\"`)
-	// Matches exact name text component
-	return fmt.Sprintf(`@e[nbt={CustomName:'{"text":"%s"}'}]`, escaped)
+// Attribute modifier operations, in the pre-1.21 spelling used by this package,
+// mapped to their 1.21+ names.
+var modifierOperations = map[string]string{
+	"add":           "add_value",
+	"multiply_base": "add_multiplied_base",
+	"multiply":      "add_multiplied_total",
 }
 
-func (c Client) JoinTeamEntityByName(ctx context.Context, team string, customName string) error {
-	sel := selectorByCustomName(customName)
-	return c.JoinTeamTargets(ctx, team, sel)
+// IsModifierOperation reports whether op is add, multiply_base or multiply.
+func IsModifierOperation(op string) bool {
+	_, ok := modifierOperations[op]
+	return ok
 }
 
-func (c Client) LeaveTeamEntityByName(ctx context.Context, customName string) error {
-	sel := selectorByCustomName(customName)
-	return c.LeaveTeamTargets(ctx, sel)
+// AddAttributeModifier adds a named modifier to a single entity's attribute.
+// On 1.21+ (resourceLocation) the modifier is keyed by "terraform:<id>" and
+// name is unused; older servers key it by the UUID id and show name.
+func (c Client) AddAttributeModifier(ctx context.Context, target, attr, id, name string, value float64, operation string, resourceLocation bool) error {
+	cmd, err := addModifierCommand(target, attr, id, name, value, operation, resourceLocation)
+	if err != nil {
+		return err
+	}
+	out, err := c.send(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	if strings.Contains(strings.ToLower(out), "no entity was found") {
+		return fmt.Errorf("no entity found for %s", target)
+	}
+	return nil
 }
 
-// ---------- Convenience: bulk entities by tag (recommended) ----------
-// If you also tag entities (e.g., `tag add <id>` or in your summon NBT), selectors by tag
-// are very cheap and reliable. This joins/leaves all matching entities.
+// RemoveAttributeModifier removes a modifier added by AddAttributeModifier.
+func (c Client) RemoveAttributeModifier(ctx context.Context, target, attr, id string, resourceLocation bool) error {
+	_, err := c.send(ctx, fmt.Sprintf("attribute %s %s modifier remove %s", target, attr, modifierID(id, resourceLocation)))
+	return err
+}
 
-func (c Client) JoinTeamEntitiesByTag(ctx context.Context, team, tag string) error {
-	return c.JoinTeamTargets(ctx, team, fmt.Sprintf(`@e[tag=%s]`, tag))
+// SetAttributeBase sets the base value of an attribute on the single entity
+// matched by selector, e.g. raising minecraft:generic.max_health above 20.
+func (c Client) SetAttributeBase(ctx context.Context, selector, attr string, value float64) error {
+	_, err := c.send(ctx, setAttributeBaseCommand(limitOne(selector), attr, value))
+	return err
 }
 
-func (c Client) LeaveTeamEntitiesByTag(ctx context.Context, tag string) error {
-	return c.LeaveTeamTargets(ctx, fmt.Sprintf(`@e[tag=%s]`, tag))
+func setAttributeBaseCommand(target, attr string, value float64) string {
+	return fmt.Sprintf("attribute %s %s base set %s", target, attr, strconv.FormatFloat(value, 'f', -1, 64))
 }
 
-// Set a boolean gamerule, e.g. keepInventory, doDaylightCycle, mobGriefing, etc.
-func (c Client) SetGameRuleBool(ctx context.Context, rule string, value bool) error {
-	rule = strings.TrimSpace(rule)
-	if !isBoolRule(rule) {
-		return fmt.Errorf("gamerule %q is not a known boolean rule", rule)
-	}
-	val := "false"
-	if value {
-		val = "true"
-	}
-	_, err := c.client.SendCommand(fmt.Sprintf("gamerule %s %s", rule, val))
+// SetEntityHealth merges {Health:nf} into the single entity matched by selector.
+// Health is clamped to max health, so raise the attribute first.
+func (c Client) SetEntityHealth(ctx context.Context, selector string, health float64) error {
+	cmd := fmt.Sprintf("data merge entity %s {Health:%sf}", limitOne(selector), strconv.FormatFloat(health, 'f', -1, 32))
+	_, err := c.send(ctx, cmd)
 	return err
 }
 
-// Set an integer gamerule, e.g. randomTickSpeed, maxEntityCramming, spawnRadius, playersSleepingPercentage, maxCommandChainLength.
-func (c Client) SetGameRuleInt(ctx context.Context, rule string, value int) error {
-	rule = strings.TrimSpace(rule)
-	if !isIntRule(rule) {
-		return fmt.Errorf("gamerule %q is not a known integer rule", rule)
+// EquipmentSlots are the entity slots EquipFromLoot fills, armor from head
+// to feet and then the hands.
+var EquipmentSlots = []string{"armor.head", "armor.chest", "armor.legs", "armor.feet", "weapon.mainhand", "weapon.offhand"}
+
+// IsEquipmentSlot reports whether slot is one of EquipmentSlots.
+func IsEquipmentSlot(slot string) bool {
+	for _, s := range EquipmentSlots {
+		if s == slot {
+			return true
+		}
 	}
-	_, err := c.client.SendCommand(fmt.Sprintf("gamerule %s %d", rule, value))
-	return err
+	return false
 }
 
-// Read current value as a raw string. For bool rules, returns "true"/"false"; for int rules, returns the number.
-func (c Client) GetGameRule(ctx context.Context, rule string) (string, error) {
-	rule = strings.TrimSpace(rule)
-	// Query form: /gamerule <rule>
-	out, err := c.client.SendCommand(fmt.Sprintf("gamerule %s", rule))
-	if err != nil {
-		return "", err
+// EquipFromLoot rolls a loot table into one equipment slot of the entity
+// matched by selector, replacing what it held:
+//
+//	loot replace entity @e[type=minecraft:zombie,tag=<id>,limit=1] armor.head loot minecraft:chests/simple_dungeon
+//
+// Each call rolls the table again, so mobs equipped from the same table get
+// different gear. Extra stacks spill into the following slots, so tables
+// meant for a slot should roll a single item.
+func (c Client) EquipFromLoot(ctx context.Context, selector, slot, table string) error {
+	if !IsEquipmentSlot(slot) {
+		return fmt.Errorf("unsupported equipment slot %q", slot)
 	}
-	// Server usually replies with just the value, but some servers/plugins may add text.
-	// Try to extract the last token that parses for ints or matches true/false.
-	line := strings.TrimSpace(out)
-	fields := strings.Fields(line)
-	if len(fields) == 1 {
-		return fields[0], nil
+	out, err := c.send(ctx, fmt.Sprintf("loot replace entity %s %s loot %s", limitOne(selector), slot, table))
+	if err != nil {
+		return err
 	}
-	// Heuristic: scan from end for a bool or int-looking token.
-	for i := len(fields) - 1; i >= 0; i-- {
-		f := strings.TrimSpace(fields[i])
-		lf := strings.ToLower(f)
-		if lf == "true" || lf == "false" {
-			return lf, nil
-		}
-		if _, err := strconv.Atoi(f); err == nil {
-			return f, nil
-		}
+	// e.g. "No entity was found" or "Unknown loot table: minecraft:nope".
+	if lower := strings.ToLower(out); strings.Contains(lower, "no entity was found") || strings.Contains(lower, "unknown loot table") || isUnknownCommand(out) {
+		return fmt.Errorf("loot replace %s: %s", slot, strings.TrimSpace(out))
 	}
-	// Fallback: return raw output
-	return line, nil
+	return nil
 }
 
-// Reset (aka "delete") a gamerule back to its vanilla default.
-// Returns an error if we don't have a known default for that rule.
-func (c Client) ResetGameRuleToDefault(ctx context.Context, rule string) error {
-	rule = strings.TrimSpace(rule)
-
-	if def, ok := defaultBoolRules[rule]; ok {
-		return c.SetGameRuleBool(ctx, rule, def)
+func addModifierCommand(target, attr, id, name string, value float64, operation string, resourceLocation bool) (string, error) {
+	modern, ok := modifierOperations[operation]
+	if !ok {
+		return "", fmt.Errorf("unsupported modifier operation %q", operation)
 	}
-	if def, ok := defaultIntRules[rule]; ok {
-		return c.SetGameRuleInt(ctx, rule, def)
+	amount := strconv.FormatFloat(value, 'f', -1, 64)
+	if resourceLocation {
+		return fmt.Sprintf("attribute %s %s modifier add %s %s %s", target, attr, modifierID(id, true), amount, modern), nil
 	}
-	return fmt.Errorf("no known default for gamerule %q; cannot reset", rule)
-}
-
-// ---- Known rules & defaults (Java Edition) ----
-
-// Keep this list small and pragmatic; extend as you need.
-// Boolean rules (subset of commonly used)
-var boolRules = map[string]struct{}{
-	"announceAdvancements":       {},
-	"disableElytraMovementCheck": {},
-	"disablePlayerMovementCheck": {},
-	"disableRaids":               {},
-	"doDaylightCycle":            {},
-	"doEntityDrops":              {},
-	"doFireTick":                 {},
-	"doInsomnia":                 {},
-	"doImmediateRespawn":         {},
-	"doLimitedCrafting":          {},
-	"doMobLoot":                  {},
-	"doMobSpawning":              {},
-	"doPatrolSpawning":           {},
-	"doTileDrops":                {},
-	"doTraderSpawning":           {},
-	"doVinesSpread":              {},
-	"doWeatherCycle":             {},
-	"doWardenSpawning":           {},
-	"drowningDamage":             {},
-	"fallDamage":                 {},
-	"fireDamage":                 {},
-	"forgiveDeadPlayers":         {},
-	"keepInventory":              {},
-	"logAdminCommands":           {},
-	"mobGriefing":                {},
-	"naturalRegeneration":        {},
-	"reducedDebugInfo":           {},
-	"sendCommandFeedback":        {},
-	"showDeathMessages":          {},
-	"spectatorsGenerateChunks":   {},
-	"universalAnger":             {},
-}
-
-// Integer rules (subset of commonly used)
-var intRules = map[string]struct{}{
-	"maxCommandChainLength":     {},
-	"maxEntityCramming":         {},
-	"playersSleepingPercentage": {},
-	"randomTickSpeed":           {},
-	"spawnRadius":               {},
+	return fmt.Sprintf("attribute %s %s modifier add %s %s %s %s", target, attr, id, quoteArg(name), amount, operation), nil
 }
 
-// Vanilla defaults (Java). Extend as needed.
-var defaultBoolRules = map[string]bool{
-	"announceAdvancements":       true,
-	"disableElytraMovementCheck": false,
-	"disablePlayerMovementCheck": false,
-	"disableRaids":               false,
-	"doDaylightCycle":            true,
-	"doEntityDrops":              true,
-	"doFireTick":                 true,
-	"doInsomnia":                 true,
-	"doImmediateRespawn":         false,
-	"doLimitedCrafting":          false,
-	"doMobLoot":                  true,
-	"doMobSpawning":              true,
-	"doPatrolSpawning":           true,
-	"doTileDrops":                true,
-	"doTraderSpawning":           true,
-	"doVinesSpread":              true,
-	"doWeatherCycle":             true,
-	"doWardenSpawning":           true,
-	"drowningDamage":             true,
-	"fallDamage":                 true,
-	"fireDamage":                 true,
-	"forgiveDeadPlayers":         true,
-	"keepInventory":              false,
-	"logAdminCommands":           true,
-	"mobGriefing":                true,
-	"naturalRegeneration":        true,
-	"reducedDebugInfo":           false,
-	"sendCommandFeedback":        true,
-	"showDeathMessages":          true,
-	"spectatorsGenerateChunks":   true,
-	"universalAnger":             false,
+func modifierID(id string, resourceLocation bool) string {
+	if resourceLocation {
+		return "terraform:" + id
+	}
+	return id
 }
 
-var defaultIntRules = map[string]int{
-	"maxCommandChainLength":     65536,
-	"maxEntityCramming":         24,
-	"playersSleepingPercentage": 100,
-	"randomTickSpeed":           3,
-	"spawnRadius":               5,
+// quoteArg wraps s in double quotes for use as a quoted command argument.
+func quoteArg(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
 }
 
-// ---- Internals ----
-
-func isBoolRule(rule string) bool {
-	_, ok := boolRules[rule]
-	return ok
+// ExecuteOptions adds optional context modifiers after `as <sel> at @s`.
+type ExecuteOptions struct {
+	Positioned string // e.g. "~ ~1 ~"
+	Rotated    string // e.g. "0 90" (yaw pitch)
+	In         string // dimension, e.g. "minecraft:the_nether"
 }
 
-func isIntRule(rule string) bool {
-	_, ok := intRules[rule]
-	return ok
+// ExecuteAs runs a command as, and at, every entity matched by asSelector.
+func (c Client) ExecuteAs(ctx context.Context, asSelector, run string, opts ExecuteOptions) error {
+	_, err := c.send(ctx, executeAsCommand(asSelector, run, opts))
+	return err
 }
 
-func (c Client) FillBlock(ctx context.Context, material string, sx, sy, sz, ex, ey, ez int) error {
-	command := fmt.Sprintf("fill %d %d %d %d %d %d %s hollow", sx, sy, sz, ex, ey, ez, material)
-	_, err := c.client.SendCommand(command)
-	if err != nil {
-		return err
+// executeAsCommand builds e.g.
+//
+//	execute as @e[type=minecraft:cow] at @s positioned ~ ~1 ~ run particle minecraft:heart
+func executeAsCommand(asSelector, run string, opts ExecuteOptions) string {
+	parts := []string{"execute", "as", asSelector, "at", "@s"}
+	if opts.In != "" {
+		parts = append(parts, "in", opts.In)
 	}
-
-	return nil
+	if opts.Positioned != "" {
+		parts = append(parts, "positioned", opts.Positioned)
+	}
+	if opts.Rotated != "" {
+		parts = append(parts, "rotated", opts.Rotated)
+	}
+	parts = append(parts, "run", strings.TrimPrefix(strings.TrimSpace(run), "/"))
+	return strings.Join(parts, " ")
 }
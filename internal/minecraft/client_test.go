@@ -0,0 +1,2854 @@
+package minecraft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeRCON stands in for the server. It replies with reply, or "ok: <command>"
+// when reply is nil, and records every command it runs.
+type fakeRCON struct {
+	reply func(command string) (string, error)
+
+	mu       sync.Mutex
+	commands []string
+
+	inFlight   int32
+	overlapped int32
+}
+
+func (f *fakeRCON) SendCommand(command string) (string, error) {
+	if atomic.AddInt32(&f.inFlight, 1) > 1 {
+		atomic.StoreInt32(&f.overlapped, 1)
+	}
+	defer atomic.AddInt32(&f.inFlight, -1)
+
+	f.mu.Lock()
+	f.commands = append(f.commands, command)
+	f.mu.Unlock()
+
+	if f.reply != nil {
+		return f.reply(command)
+	}
+	return "ok: " + command, nil
+}
+
+// sent returns the commands run so far.
+func (f *fakeRCON) sent() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.commands...)
+}
+
+func TestSendSerializesConcurrentCommands(t *testing.T) {
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		time.Sleep(time.Millisecond)
+		return "reply to " + command, nil
+	}}
+	c := newClient(fake)
+
+	const n = 50
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			command := fmt.Sprintf("say %d", i)
+			out, err := c.send(context.Background(), command)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if want := "reply to " + command; out != want {
+				errs <- fmt.Errorf("%s: got reply %q, want %q", command, out, want)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	if atomic.LoadInt32(&fake.overlapped) != 0 {
+		t.Error("commands overlapped on the connection")
+	}
+	if got := len(fake.sent()); got != n {
+		t.Errorf("server ran %d commands, want %d", got, n)
+	}
+}
+
+func TestSetblockModes(t *testing.T) {
+	tests := []struct {
+		mode       string
+		wantBlock  string
+		wantStairs string
+	}{
+		{mode: "", wantBlock: "setblock 1 64 2 minecraft:stone replace", wantStairs: "setblock 1 64 2 minecraft:oak_stairs[facing=east,half=top,shape=straight,waterlogged=true] replace"},
+		{mode: "replace", wantBlock: "setblock 1 64 2 minecraft:stone replace", wantStairs: "setblock 1 64 2 minecraft:oak_stairs[facing=east,half=top,shape=straight,waterlogged=true] replace"},
+		{mode: "keep", wantBlock: "setblock 1 64 2 minecraft:stone keep", wantStairs: "setblock 1 64 2 minecraft:oak_stairs[facing=east,half=top,shape=straight,waterlogged=true] keep"},
+		{mode: "destroy", wantBlock: "setblock 1 64 2 minecraft:stone destroy", wantStairs: "setblock 1 64 2 minecraft:oak_stairs[facing=east,half=top,shape=straight,waterlogged=true] destroy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			fake := &fakeRCON{}
+			c := newClient(fake)
+			ctx := context.Background()
+			if err := c.CreateBlock(ctx, "minecraft:stone", 1, 64, 2, tt.mode); err != nil {
+				t.Fatal(err)
+			}
+			if err := c.CreateStairs(ctx, "minecraft:oak_stairs", 1, 64, 2, "east", "top", "straight", true, tt.mode); err != nil {
+				t.Fatal(err)
+			}
+			if err := c.DeleteBlock(ctx, 1, 64, 2); err != nil {
+				t.Fatal(err)
+			}
+			want := []string{tt.wantBlock, tt.wantStairs, "setblock 1 64 2 minecraft:air replace"}
+			if got := fake.sent(); !reflect.DeepEqual(got, want) {
+				t.Errorf("sent %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestSetblockKeepNotPlaced(t *testing.T) {
+	fake := &fakeRCON{reply: func(string) (string, error) { return "Could not set the block", nil }}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.CreateBlock(ctx, "minecraft:stone", 1, 64, 2, "keep"); !errors.Is(err, ErrBlockNotPlaced) {
+		t.Errorf("CreateBlock keep: got %v, want ErrBlockNotPlaced", err)
+	}
+	if err := c.CreateStairs(ctx, "minecraft:oak_stairs", 1, 64, 2, "east", "top", "straight", false, "keep"); !errors.Is(err, ErrBlockNotPlaced) {
+		t.Errorf("CreateStairs keep: got %v, want ErrBlockNotPlaced", err)
+	}
+	// Replacing a block with itself gets the same reply and isn't an error.
+	if err := c.CreateBlock(ctx, "minecraft:stone", 1, 64, 2, "replace"); err != nil {
+		t.Errorf("CreateBlock replace: %v", err)
+	}
+}
+
+func TestSetMaxPlayers(t *testing.T) {
+	fake := &fakeRCON{}
+	if err := newClient(fake).SetMaxPlayers(context.Background(), 50); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fake.sent(), []string{"setmaxplayers 50"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestSetMaxPlayersUnsupported(t *testing.T) {
+	fake := &fakeRCON{reply: func(string) (string, error) {
+		return "Unknown command. Type \"/help\" for help.", nil
+	}}
+	err := newClient(fake).SetMaxPlayers(context.Background(), 50)
+	if err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("got error %v, want a not supported error", err)
+	}
+}
+
+func TestParseListPlayers(t *testing.T) {
+	tests := []struct {
+		out     string
+		online  int
+		max     int
+		players []string
+	}{
+		{out: "There are 0 of a max of 20 players online: ", max: 20},
+		{out: "There are 2 of a max of 10 players online: Steve, Alex", online: 2, max: 10, players: []string{"Steve", "Alex"}},
+		{out: "There are 1/20 players online:\nSteve", online: 1, max: 20, players: []string{"Steve"}},
+		{out: "There are 0/20 players online:", max: 20},
+		{out: "There are §c2§6 out of maximum §c50§6 players online.\nadmins: Steve\ndefault: Alex", online: 2, max: 50, players: []string{"Steve", "Alex"}},
+	}
+	for _, tt := range tests {
+		online, max, players, err := parseListPlayers(tt.out)
+		if err != nil {
+			t.Errorf("parseListPlayers(%q): %v", tt.out, err)
+			continue
+		}
+		if online != tt.online || max != tt.max || !reflect.DeepEqual(players, tt.players) {
+			t.Errorf("parseListPlayers(%q) = %d, %d, %q; want %d, %d, %q", tt.out, online, max, players, tt.online, tt.max, tt.players)
+		}
+	}
+	if _, _, _, err := parseListPlayers("Unknown command"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("unexpected reply: got %v, want ErrUnsupported", err)
+	}
+}
+
+func TestObjectiveDisplayCommands(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.SetObjectiveDisplay(ctx, "sidebar.team.red", "kills"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ClearObjectiveDisplay(ctx, "sidebar.team.red"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"scoreboard objectives setdisplay sidebar.team.red kills",
+		"scoreboard objectives setdisplay sidebar.team.red",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestTransformationNBT(t *testing.T) {
+	got := transformationNBT([3]float64{2, 0.5, 1}, [3]float64{-0.5, 0, 0.25})
+	want := "{left_rotation:[0f,0f,0f,1f],right_rotation:[0f,0f,0f,1f],translation:[-0.5f,0f,0.25f],scale:[2f,0.5f,1f]}"
+	if got != want {
+		t.Errorf("transformationNBT = %s, want %s", got, want)
+	}
+}
+
+func TestBlockStateNBT(t *testing.T) {
+	tests := map[string]string{
+		"minecraft:stone": `{Name:"minecraft:stone"}`,
+		"minecraft:oak_stairs[facing=east, half=top]": `{Name:"minecraft:oak_stairs",Properties:{facing:"east",half:"top"}}`,
+	}
+	for state, want := range tests {
+		if got := blockStateNBT(state); got != want {
+			t.Errorf("blockStateNBT(%q) = %s, want %s", state, got, want)
+		}
+	}
+}
+
+func TestCreateBlockDisplay(t *testing.T) {
+	fake := &fakeRCON{}
+	err := newClient(fake).CreateBlockDisplay(context.Background(), "1 64 2", "id-1", "minecraft:glass", [3]float64{1, 1, 1}, [3]float64{0, 0, 0}, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `summon minecraft:block_display 1 64 2 {CustomName:'{"text":"id-1"}',block_state:{Name:"minecraft:glass"},transformation:{left_rotation:[0f,0f,0f,1f],right_rotation:[0f,0f,0f,1f],translation:[0f,0f,0f],scale:[1f,1f,1f]},billboard:"fixed"}`
+	if got := fake.sent(); len(got) != 1 || got[0] != want {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestSendReturnsWhenSlowCommandIsCancelled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		<-release
+		return "", nil
+	}}
+	c := newClient(fake)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	start := time.Now()
+	if _, err := c.send(ctx, "slow"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("cancelled command took %s to return", elapsed)
+	}
+
+	// An already cancelled context never reaches the server.
+	if _, err := c.send(ctx, "late"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+	if got, want := strings.Join(fake.sent(), ","), "slow"; got != want {
+		t.Errorf("server ran %s, want %s", got, want)
+	}
+}
+
+func TestSendAppliesCommandTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		<-release
+		return "", nil
+	}}
+	c := newClient(fake)
+	c.SetCommandTimeout(20 * time.Millisecond)
+
+	if _, err := c.send(context.Background(), "slow"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got error %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestRateLimiterSpacesCommands(t *testing.T) {
+	const interval = 20 * time.Millisecond
+	l := NewRateLimiter(float64(time.Second / interval))
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed, min := time.Since(start), time.Duration(i)*interval; elapsed < min {
+			t.Errorf("command %d went out after %s, want at least %s", i, elapsed, min)
+		}
+	}
+}
+
+func TestRateLimiterIsSharedByClients(t *testing.T) {
+	const interval = 20 * time.Millisecond
+	l := NewRateLimiter(float64(time.Second / interval))
+
+	var mu sync.Mutex
+	var times []time.Time
+	record := func(command string) (string, error) {
+		mu.Lock()
+		times = append(times, time.Now())
+		mu.Unlock()
+		return "", nil
+	}
+	clients := []*Client{newClient(&fakeRCON{reply: record}), newClient(&fakeRCON{reply: record})}
+
+	var wg sync.WaitGroup
+	for _, c := range clients {
+		c.SetRateLimiter(l)
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func(c *Client) {
+				defer wg.Done()
+				if _, err := c.send(context.Background(), "list"); err != nil {
+					t.Error(err)
+				}
+			}(c)
+		}
+	}
+	wg.Wait()
+
+	// The limiter hands out slots interval apart, so six commands across both
+	// clients span at least five intervals.
+	first, last := times[0], times[0]
+	for _, at := range times {
+		if at.Before(first) {
+			first = at
+		}
+		if at.After(last) {
+			last = at
+		}
+	}
+	if span, min := last.Sub(first), 5*interval-time.Millisecond; span < min {
+		t.Errorf("6 commands took %s, want at least %s", span, min)
+	}
+}
+
+func TestRateLimiterWaitIsCancelled(t *testing.T) {
+	l := NewRateLimiter(0.1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestSplitAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		host    string
+		port    int
+		wantErr bool
+	}{
+		{address: "localhost:25575", host: "localhost", port: 25575},
+		{address: "10.0.0.5:1", host: "10.0.0.5", port: 1},
+		{address: "[::1]:25575", host: "::1", port: 25575},
+		{address: "localhost", wantErr: true},
+		{address: "localhost:rcon", wantErr: true},
+		{address: "localhost:70000", wantErr: true},
+	}
+	for _, tt := range tests {
+		host, port, err := SplitAddress(tt.address)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("SplitAddress(%q) error = %v, wantErr %v", tt.address, err, tt.wantErr)
+			continue
+		}
+		if host != tt.host || port != tt.port {
+			t.Errorf("SplitAddress(%q) = %q, %d, want %q, %d", tt.address, host, port, tt.host, tt.port)
+		}
+	}
+}
+
+func TestNewRateLimiterDisabled(t *testing.T) {
+	for _, perSecond := range []float64{0, -1} {
+		l := NewRateLimiter(perSecond)
+		if l != nil {
+			t.Errorf("NewRateLimiter(%g) = %v, want nil", perSecond, l)
+		}
+		if err := l.Wait(context.Background()); err != nil {
+			t.Errorf("nil limiter: %s", err)
+		}
+	}
+}
+
+func TestSummonAndKillGroup(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.SummonGroup(ctx, "minecraft:cow", "10 64 -5", "herd", 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.KillGroup(ctx, "herd"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`summon minecraft:cow 9.5 64 -5.5 {Tags:["herd","herd_0"]}`,
+		`summon minecraft:cow 10 64 -5.5 {Tags:["herd","herd_1"]}`,
+		`summon minecraft:cow 10.5 64 -5.5 {Tags:["herd","herd_2"]}`,
+		`summon minecraft:cow 9.5 64 -5 {Tags:["herd","herd_3"]}`,
+		`kill @e[tag=herd]`,
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestParseAffectedCount(t *testing.T) {
+	cases := []struct {
+		out string
+		n   int
+		ok  bool
+	}{
+		{"Killed 3 entities", 3, true},
+		{"Killed Steve", 1, true},
+		{"Applied effect Speed to 2 targets", 2, true},
+		{"Applied effect Speed to Alex", 1, true},
+		{"Added 4 entities to team red", 4, true},
+		{"Added 0 entities to team red", 0, true},
+		{"No entity was found", 0, true},
+		{"No player was found", 0, true},
+		{"Unknown or incomplete command", 0, false},
+		{"", 0, false},
+	}
+	for _, tc := range cases {
+		n, ok := parseAffectedCount(tc.out)
+		if n != tc.n || ok != tc.ok {
+			t.Errorf("parseAffectedCount(%q) = %d, %v; want %d, %v", tc.out, n, ok, tc.n, tc.ok)
+		}
+	}
+}
+
+func TestForceloadCommands(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.ForceloadAdd(ctx, -16, 0, 47, 31); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ForceloadRemove(ctx, -16, 0, 47, 31); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"forceload add -16 0 47 31", "forceload remove -16 0 47 31"}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestParseForceloadQuery(t *testing.T) {
+	cases := []struct {
+		out     string
+		want    bool
+		wantErr bool
+	}{
+		{out: "Chunk at [0, 0] in minecraft:overworld is marked for force loading", want: true},
+		{out: "Chunk at [-1, 2] in minecraft:overworld is not marked for force loading", want: false},
+		{out: "Unknown or incomplete command", wantErr: true},
+	}
+	for _, tc := range cases {
+		got, err := parseForceloadQuery(tc.out)
+		if (err != nil) != tc.wantErr || got != tc.want {
+			t.Errorf("parseForceloadQuery(%q) = %t, %v", tc.out, got, err)
+		}
+	}
+}
+
+func TestStrikeLightning(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.StrikeLightning(ctx, "10 64 -3", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.StrikeLightning(ctx, "10 64 -3", true); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"summon minecraft:lightning_bolt 10 64 -3",
+		"summon minecraft:lightning_bolt 10 64 -3 {Effects:0b}",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestNextFacing(t *testing.T) {
+	for from, want := range map[string]string{"north": "east", "east": "south", "south": "west", "west": "north"} {
+		if got, err := nextFacing(from); err != nil || got != want {
+			t.Errorf("nextFacing(%q) = %q, %v; want %q", from, got, err, want)
+		}
+	}
+	if _, err := nextFacing("up"); err == nil {
+		t.Error("nextFacing(\"up\") succeeded, want an error")
+	}
+}
+
+func TestRotateBlock(t *testing.T) {
+	fake := &fakeRCON{reply: func(cmd string) (string, error) {
+		if cmd == "execute if block 1 64 2 minecraft:furnace[facing=south]" {
+			return "Test passed", nil
+		}
+		if strings.HasPrefix(cmd, "execute if block") {
+			return "Test failed", nil
+		}
+		return "Changed the block at 1, 64, 2", nil
+	}}
+	c := newClient(fake)
+	got, err := c.RotateBlock(context.Background(), "minecraft:furnace", 1, 64, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "west" {
+		t.Errorf("rotated to %q, want west", got)
+	}
+	want := []string{
+		"execute if block 1 64 2 minecraft:furnace[facing=north]",
+		"execute if block 1 64 2 minecraft:furnace[facing=east]",
+		"execute if block 1 64 2 minecraft:furnace[facing=south]",
+		"setblock 1 64 2 minecraft:furnace[facing=west] replace",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestSummonFallingBlock(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.SummonFallingBlock(ctx, "0 80 0", "minecraft:sand", false, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SummonFallingBlock(ctx, "0 80 0", "minecraft:oak_stairs[facing=east]", true, 1); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`summon minecraft:falling_block 0 80 0 {BlockState:{Name:"minecraft:sand"}}`,
+		`summon minecraft:falling_block 0 80 0 {BlockState:{Name:"minecraft:oak_stairs",Properties:{facing:"east"}},Time:1,NoGravity:1b}`,
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestPoseNBT(t *testing.T) {
+	pose := PoseNBT{
+		Head:     &[3]float64{10, 0, 0},
+		RightArm: &[3]float64{-90, 12.5, 0},
+	}
+	if got, want := pose.String(), "{Head:[10f,0f,0f],RightArm:[-90f,12.5f,0f]}"; got != want {
+		t.Errorf("PoseNBT = %s, want %s", got, want)
+	}
+	if got := (PoseNBT{}).String(); got != "{}" {
+		t.Errorf("empty PoseNBT = %s, want {}", got)
+	}
+
+	fake := &fakeRCON{}
+	if err := newClient(fake).SetArmorStandPose(context.Background(), "statue", pose); err != nil {
+		t.Fatal(err)
+	}
+	want := `data merge entity @e[nbt={CustomName:'{"text":"statue"}'},limit=1] {Pose:{Head:[10f,0f,0f],RightArm:[-90f,12.5f,0f]}}`
+	if got := fake.sent(); len(got) != 1 || got[0] != want {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestParseTeamMembers(t *testing.T) {
+	tests := map[string][]string{
+		"Team [Blue] has 2 members: Steve, Alex": {"Steve", "Alex"},
+		"Team [A: B] has 1 member: Steve":        {"Steve"},
+		"There are no members on team [Blue]":    {},
+	}
+	for out, want := range tests {
+		got, err := parseTeamMembers(out)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("parseTeamMembers(%q) = %q, %v; want %q", out, got, err, want)
+		}
+	}
+	if _, err := parseTeamMembers("Unknown team 'Blue'"); err == nil {
+		t.Error("an unknown team reply parsed")
+	}
+}
+
+func TestGiveEnchantedItem(t *testing.T) {
+	enchants := map[string]int{"minecraft:sharpness": 5, "minecraft:looting": 3}
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.GiveEnchantedItem(ctx, "Steve", "minecraft:diamond_sword", enchants, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.GiveEnchantedItem(ctx, "Steve", "minecraft:diamond_sword", enchants, false); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`give Steve minecraft:diamond_sword[enchantments={levels:{"minecraft:looting":3,"minecraft:sharpness":5}}]`,
+		`give Steve minecraft:diamond_sword{Enchantments:[{id:"minecraft:looting",lvl:3s},{id:"minecraft:sharpness",lvl:5s}]}`,
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q\nwant %q", got, want)
+	}
+}
+
+func TestEquipmentNBT(t *testing.T) {
+	eq := Equipment{
+		Head:             "minecraft:iron_helmet",
+		MainHand:         "minecraft:iron_sword",
+		ArmorDropChances: &[4]float64{0, 0, 0, 2},
+		HandDropChances:  &[2]float64{0.5, 0},
+	}
+	tests := []struct {
+		useComponents bool
+		want          string
+	}{
+		{
+			useComponents: true,
+			want:          `ArmorItems:[{},{},{},{id:"minecraft:iron_helmet",count:1}],HandItems:[{id:"minecraft:iron_sword",count:1},{}],ArmorDropChances:[0f,0f,0f,2f],HandDropChances:[0.5f,0f]`,
+		},
+		{
+			useComponents: false,
+			want:          `ArmorItems:[{},{},{},{id:"minecraft:iron_helmet",Count:1b}],HandItems:[{id:"minecraft:iron_sword",Count:1b},{}],ArmorDropChances:[0f,0f,0f,2f],HandDropChances:[0.5f,0f]`,
+		},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(equipmentNBT(eq, tt.useComponents), ","); got != tt.want {
+			t.Errorf("equipmentNBT(useComponents=%t) =\n%s\nwant\n%s", tt.useComponents, got, tt.want)
+		}
+	}
+
+	if got, want := strings.Join(equipmentNBT(Equipment{}, true), ","), `ArmorItems:[{},{},{},{}],HandItems:[{},{}]`; got != want {
+		t.Errorf("empty equipment = %s, want %s", got, want)
+	}
+}
+
+func TestCreateEntityNames(t *testing.T) {
+	tests := []struct {
+		name        string
+		nameVisible bool
+		want        string
+	}{
+		{want: `summon minecraft:pig 0 64 0 {Tags:["id-1"],CustomName:'{"text":"id-1"}'}`},
+		{name: "Wilbur", nameVisible: true, want: `summon minecraft:pig 0 64 0 {Tags:["id-1"],CustomName:'{"text":"Wilbur"}',CustomNameVisible:1b}`},
+		{name: `Say "oink"`, want: `summon minecraft:pig 0 64 0 {Tags:["id-1"],CustomName:'{"text":"Say \\"oink\\""}'}`},
+	}
+	for _, tt := range tests {
+		fake := &fakeRCON{}
+		if err := newClient(fake).CreateEntity(context.Background(), "minecraft:pig", "0 64 0", "id-1", tt.name, tt.nameVisible, false, false, false, nil, nil, ""); err != nil {
+			t.Fatal(err)
+		}
+		if got := fake.sent(); len(got) != 1 || got[0] != tt.want {
+			t.Errorf("name %q: sent %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	fake := &fakeRCON{}
+	eq := Equipment{Head: "minecraft:iron_helmet"}
+	if err := newClient(fake).CreateArmoredEntity(context.Background(), "minecraft:zombie", "0 64 0", "id-1", "Bob", false, eq, true, false, false, false, nil, nil, ""); err != nil {
+		t.Fatal(err)
+	}
+	want := `summon minecraft:zombie 0 64 0 {Tags:["id-1"],CustomName:'{"text":"Bob"}',ArmorItems:[{},{},{},{id:"minecraft:iron_helmet",count:1}],HandItems:[{},{}]}`
+	if got := fake.sent(); len(got) != 1 || got[0] != want {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestFillBiome(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	if err := c.FillBiome(context.Background(), "minecraft:cherry_grove", [3]int{0, 60, -16}, [3]int{15, 80, 15}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fake.sent(), []string{"fillbiome 0 60 -16 15 80 15 minecraft:cherry_grove"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+
+	old := newClient(&fakeRCON{reply: func(string) (string, error) {
+		return "Unknown command. Type \"/help\" for help.", nil
+	}})
+	if err := old.FillBiome(context.Background(), "minecraft:plains", [3]int{0, 0, 0}, [3]int{1, 1, 1}); err == nil {
+		t.Error("FillBiome on a server without fillbiome succeeded")
+	}
+}
+
+func TestDamageCommand(t *testing.T) {
+	tests := []struct {
+		damageType, source, location string
+		want                         string
+	}{
+		{want: "damage @p 4.5"},
+		{damageType: "minecraft:fall", want: "damage @p 4.5 minecraft:fall"},
+		{source: "@e[type=zombie,limit=1]", want: "damage @p 4.5 minecraft:generic by @e[type=zombie,limit=1]"},
+		{damageType: "minecraft:explosion", location: "0 64 0", want: "damage @p 4.5 minecraft:explosion at 0 64 0"},
+	}
+	for _, tt := range tests {
+		if got := damageCommand("@p", 4.5, tt.damageType, tt.source, tt.location); got != tt.want {
+			t.Errorf("damageCommand(%q, %q, %q) = %q, want %q", tt.damageType, tt.source, tt.location, got, tt.want)
+		}
+	}
+}
+
+func TestDamageTargetReplies(t *testing.T) {
+	for reply, wantErr := range map[string]bool{
+		"Applied 4.5 damage to Steve":                     false,
+		"Target is invulnerable to the given damage type": true,
+		"No entity was found":                             true,
+	} {
+		c := newClient(&fakeRCON{reply: func(string) (string, error) { return reply, nil }})
+		if err := c.DamageTarget(context.Background(), "@p", 4.5, "", "", ""); (err != nil) != wantErr {
+			t.Errorf("reply %q: err = %v, wantErr %t", reply, err, wantErr)
+		}
+	}
+}
+
+func TestScoreOperation(t *testing.T) {
+	ops := []string{"+=", "-=", "*=", "/=", "%=", "=", "<", ">", "><"}
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	var want []string
+	for _, op := range ops {
+		if err := c.ScoreOperation(context.Background(), "#total", "kills", op, "Steve", "kills"); err != nil {
+			t.Fatalf("%s: %v", op, err)
+		}
+		want = append(want, "scoreboard players operation #total kills "+op+" Steve kills")
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q\nwant %q", got, want)
+	}
+
+	if err := c.ScoreOperation(context.Background(), "#total", "kills", "^=", "Steve", "kills"); err == nil {
+		t.Error("an invalid operator was accepted")
+	}
+	if got := len(fake.sent()); got != len(ops) {
+		t.Errorf("an invalid operator was sent")
+	}
+}
+
+func TestSendRetriesUntilServerIsReady(t *testing.T) {
+	attempts := 0
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		if attempts++; attempts <= 2 {
+			return "Server is still starting! Please wait before reconnecting.", nil
+		}
+		return "Set the time to 1000", nil
+	}}
+	c := newClient(fake)
+	c.SetCommandRetries(3)
+
+	out, err := c.send(context.Background(), "time set day")
+	if err != nil {
+		t.Fatalf("got error %s, want success", err)
+	}
+	if out != "Set the time to 1000" {
+		t.Errorf("got reply %q", out)
+	}
+	if got := len(fake.sent()); got != 3 {
+		t.Errorf("server got %d attempts, want 3", got)
+	}
+}
+
+func TestSendDoesNotRetryOnceSent(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	tests := map[string]func(string) (string, error){
+		"connection reset": func(string) (string, error) {
+			return "", errors.New("read tcp 127.0.0.1:25575: connection reset by peer")
+		},
+		"timeout": func(string) (string, error) {
+			<-release
+			return "", nil
+		},
+	}
+	for name, reply := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake := &fakeRCON{reply: reply}
+			c := newClient(fake)
+			c.SetCommandTimeout(20 * time.Millisecond)
+			c.SetCommandRetries(3)
+
+			if _, err := c.send(context.Background(), "summon minecraft:pig"); err == nil {
+				t.Fatal("got no error")
+			}
+			if got := len(fake.sent()); got != 1 {
+				t.Errorf("server got %d attempts, want 1", got)
+			}
+		})
+	}
+}
+
+func TestBrokenAfterConnectionError(t *testing.T) {
+	fail := false
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		if fail {
+			return "", errors.New("connection reset by peer")
+		}
+		return "Unknown or incomplete command", nil
+	}}
+	c := newClient(fake)
+	copied := *c
+
+	if _, err := c.send(context.Background(), "bogus"); err != nil || c.Broken() {
+		t.Fatalf("a rejected command broke the client: %v", err)
+	}
+	fail = true
+	if _, err := c.send(context.Background(), "list"); err == nil {
+		t.Fatal("got no error")
+	}
+	if !c.Broken() || !copied.Broken() {
+		t.Errorf("Broken = %t, copy %t; want both true after a connection error", c.Broken(), copied.Broken())
+	}
+}
+
+func TestSendWithoutRetriesReturnsReply(t *testing.T) {
+	const starting = "Server is still starting! Please wait before reconnecting."
+	fake := &fakeRCON{reply: func(string) (string, error) { return starting, nil }}
+	out, err := newClient(fake).send(context.Background(), "time set day")
+	if err != nil || out != starting {
+		t.Errorf("got %q, %v; want the reply unchanged", out, err)
+	}
+}
+
+func TestSendRetriesCommandTimedOutWhileQueued(t *testing.T) {
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		if command == "slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		return "reply to " + command, nil
+	}}
+	c := newClient(fake)
+	c.SetCommandTimeout(20 * time.Millisecond)
+	c.SetCommandRetries(1)
+
+	slowDone := make(chan struct{})
+	go func() {
+		defer close(slowDone)
+		c.send(context.Background(), "slow")
+	}()
+	for len(fake.sent()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Queued behind "slow", the first attempt times out before it is sent.
+	out, err := c.send(context.Background(), "time set day")
+	<-slowDone
+	if err != nil || out != "reply to time set day" {
+		t.Fatalf("got %q, %v; want the reply after a retry", out, err)
+	}
+	if got := fake.sent(); len(got) != 2 || got[1] != "time set day" {
+		t.Errorf("server got %q, want the queued command once", got)
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	c := newClient(&fakeRCON{})
+	tests := []struct {
+		name string
+		out  string
+		err  error
+		want bool
+	}{
+		{name: "starting", out: "Server is still starting! Please wait before reconnecting.", want: true},
+		{name: "loading", out: "World still loading", want: true},
+		{name: "ordinary reply", out: "Set the time to 1000"},
+		{name: "timed out after sending", err: context.DeadlineExceeded},
+		{name: "connection reset", err: errors.New("connection reset by peer")},
+	}
+	for _, tt := range tests {
+		if got := c.isTransient(tt.out, tt.err); got != tt.want {
+			t.Errorf("%s: isTransient = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAttributeModifierCommands(t *testing.T) {
+	const id = "5f0b7e5c-8d1e-4a64-9a55-4a5b39d6f0a1"
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.AddAttributeModifier(ctx, "@p", "minecraft:generic.movement_speed", id, `Boots "of" speed`, 0.25, "multiply_base", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RemoveAttributeModifier(ctx, "@p", "minecraft:generic.movement_speed", id, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AddAttributeModifier(ctx, "@p", "minecraft:movement_speed", id, "unused", -1.5, "add", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RemoveAttributeModifier(ctx, "@p", "minecraft:movement_speed", id, true); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`attribute @p minecraft:generic.movement_speed modifier add ` + id + ` "Boots \"of\" speed" 0.25 multiply_base`,
+		`attribute @p minecraft:generic.movement_speed modifier remove ` + id,
+		`attribute @p minecraft:movement_speed modifier add terraform:` + id + ` -1.5 add_value`,
+		`attribute @p minecraft:movement_speed modifier remove terraform:` + id,
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q\nwant %q", got, want)
+	}
+
+	if err := c.AddAttributeModifier(ctx, "@p", "minecraft:max_health", id, "x", 1, "divide", true); err == nil {
+		t.Error("an invalid operation was accepted")
+	}
+}
+
+func TestExecuteAsCommand(t *testing.T) {
+	tests := []struct {
+		opts ExecuteOptions
+		want string
+	}{
+		{want: "execute as @e[type=minecraft:cow] at @s run particle minecraft:heart"},
+		{opts: ExecuteOptions{Positioned: "~ ~1 ~"}, want: "execute as @e[type=minecraft:cow] at @s positioned ~ ~1 ~ run particle minecraft:heart"},
+		{opts: ExecuteOptions{Rotated: "0 90"}, want: "execute as @e[type=minecraft:cow] at @s rotated 0 90 run particle minecraft:heart"},
+		{
+			opts: ExecuteOptions{Positioned: "~ ~1 ~", Rotated: "0 90", In: "minecraft:the_nether"},
+			want: "execute as @e[type=minecraft:cow] at @s in minecraft:the_nether positioned ~ ~1 ~ rotated 0 90 run particle minecraft:heart",
+		},
+	}
+	for _, tt := range tests {
+		if got := executeAsCommand("@e[type=minecraft:cow]", " /particle minecraft:heart", tt.opts); got != tt.want {
+			t.Errorf("executeAsCommand(%+v) = %q, want %q", tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestDeleteEntityToleratesMissingEntity(t *testing.T) {
+	fake := &fakeRCON{reply: func(string) (string, error) { return "No entity was found", nil }}
+	if err := newClient(fake).DeleteEntity(context.Background(), "minecraft:pig", "0 64 0", "id-1"); err != nil {
+		t.Fatalf("deleting a despawned entity: %v", err)
+	}
+	want := []string{
+		"kill @e[type=minecraft:pig,tag=id-1]",
+		`kill @e[type=minecraft:pig,nbt={CustomName:'{"text":"id-1"}'}]`,
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestTempBanPlayer(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.TempBanPlayer(ctx, "Griefer", "", "griefing"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.TempBanPlayer(ctx, "Griefer", "3h", "griefing"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.TempBanPlayer(ctx, "Griefer", "1d", ""); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"ban Griefer griefing", "tempban Griefer 3h griefing", "tempban Griefer 1d"}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+
+	vanilla := newClient(&fakeRCON{reply: func(string) (string, error) {
+		return "Unknown or incomplete command, see below for error", nil
+	}})
+	if err := vanilla.TempBanPlayer(ctx, "Griefer", "3h", ""); err == nil {
+		t.Error("tempban on a server without it succeeded")
+	}
+}
+
+func TestSetMOTD(t *testing.T) {
+	fake := &fakeRCON{reply: func(string) (string, error) { return "MOTD set", nil }}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.SetMOTD(ctx, "&aWelcome to the server"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fake.sent(), []string{"setmotd &aWelcome to the server"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+	for _, motd := range []string{" ", "line one\nline two"} {
+		if err := c.SetMOTD(ctx, motd); err == nil {
+			t.Errorf("SetMOTD(%q) succeeded", motd)
+		}
+	}
+	if got := len(fake.sent()); got != 1 {
+		t.Errorf("invalid MOTDs were sent")
+	}
+
+	vanilla := newClient(&fakeRCON{reply: func(string) (string, error) {
+		return "Unknown or incomplete command, see below for error", nil
+	}})
+	if err := vanilla.SetMOTD(ctx, "Welcome"); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("vanilla SetMOTD returned %v, want unsupported", err)
+	}
+}
+
+func TestEditSignText(t *testing.T) {
+	const blank = `'{"text":""}'`
+	tests := []struct {
+		name        string
+		front, back [4]string
+		want        string
+	}{
+		{
+			name:  "front only",
+			front: [4]string{"Welcome", `say "hi"`},
+			want: `data merge block 1 64 -2 {front_text:{messages:['{"text":"Welcome"}','{"text":"say \\"hi\\""}',` + blank + `,` + blank + `]},` +
+				`back_text:{messages:[` + blank + `,` + blank + `,` + blank + `,` + blank + `]}}`,
+		},
+		{
+			name:  "front and back",
+			front: [4]string{"North"},
+			back:  [4]string{"", "South"},
+			want: `data merge block 1 64 -2 {front_text:{messages:['{"text":"North"}',` + blank + `,` + blank + `,` + blank + `]},` +
+				`back_text:{messages:[` + blank + `,'{"text":"South"}',` + blank + `,` + blank + `]}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeRCON{reply: func(string) (string, error) { return "Modified block data of 1, 64, -2", nil }}
+			if err := newClient(fake).EditSignText(context.Background(), 1, 64, -2, tt.front, tt.back); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := fake.sent(), []string{tt.want}; !reflect.DeepEqual(got, want) {
+				t.Errorf("sent %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCountBlocks(t *testing.T) {
+	stone := map[string]bool{"0 64 0": true, "1 65 0": true, "1 64 1": true}
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		fields := strings.Fields(command)
+		if stone[strings.Join(fields[3:6], " ")] {
+			return "Test passed", nil
+		}
+		return "Test failed", nil
+	}}
+	c := newClient(fake)
+	ctx := context.Background()
+
+	got, err := c.CountBlocks(ctx, "minecraft:stone", [3]int{1, 65, 1}, [3]int{0, 64, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 3 {
+		t.Errorf("CountBlocks = %d, want 3", got)
+	}
+	if sent := fake.sent(); len(sent) != 8 || sent[0] != "execute if block 0 64 0 minecraft:stone" {
+		t.Errorf("sent %q, want 8 block tests starting at the low corner", sent)
+	}
+
+	if _, err := c.CountBlocks(ctx, "minecraft:stone", [3]int{0, 0, 0}, [3]int{16, 16, 16}); err == nil {
+		t.Error("CountBlocks over MaxScanVolume succeeded")
+	}
+	if len(fake.sent()) != 8 {
+		t.Error("oversized region was scanned")
+	}
+
+	bad := newClient(&fakeRCON{reply: func(string) (string, error) { return "Unknown block type", nil }})
+	if _, err := bad.CountBlocks(ctx, "minecraft:nope", [3]int{0, 0, 0}, [3]int{0, 0, 0}); err == nil {
+		t.Error("CountBlocks accepted an unexpected reply")
+	}
+}
+
+func TestParseOps(t *testing.T) {
+	raw := []byte(`[
+  {"uuid": "069a79f4-44e9-4726-a5be-fca90e38aaf5", "name": "Notch", "level": 4, "bypassesPlayerLimit": false},
+  {"uuid": "853c80ef-3c37-49fd-aa49-938b674adae6", "name": "jeb_", "level": 2, "bypassesPlayerLimit": true}
+]`)
+	got, err := parseOps(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []OpEntry{
+		{UUID: "069a79f4-44e9-4726-a5be-fca90e38aaf5", Name: "Notch", Level: 4},
+		{UUID: "853c80ef-3c37-49fd-aa49-938b674adae6", Name: "jeb_", Level: 2, BypassesPlayerLimit: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseOps = %+v, want %+v", got, want)
+	}
+
+	if ops, err := parseOps([]byte("[]")); err != nil || len(ops) != 0 {
+		t.Errorf("parseOps(empty) = %v, %v", ops, err)
+	}
+	if _, err := parseOps([]byte(`{"name": "Notch"}`)); err == nil {
+		t.Error("parseOps accepted an object")
+	}
+}
+
+func TestSaveStructureCommands(t *testing.T) {
+	want := []string{
+		`setblock 2 67 -5 minecraft:structure_block{mode:"SAVE",name:"terraform:house",posX:0,posY:-4,posZ:0,sizeX:3,sizeY:4,sizeZ:6,ignoreEntities:1b} replace`,
+		"setblock 2 68 -5 minecraft:redstone_block replace",
+		"setblock 2 68 -5 minecraft:air replace",
+		"setblock 2 67 -5 minecraft:air replace",
+	}
+	// Corners may be given in any order; the block goes above the low corner.
+	for _, corners := range [][2][3]int{
+		{{2, 63, -5}, {4, 66, 0}},
+		{{4, 66, 0}, {2, 63, -5}},
+		{{2, 66, 0}, {4, 63, -5}},
+	} {
+		got, err := saveStructureCommands("terraform:house", corners[0], corners[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("saveStructureCommands(%v, %v) = %q, want %q", corners[0], corners[1], got, want)
+		}
+	}
+
+	if _, err := saveStructureCommands("big", [3]int{0, 0, 0}, [3]int{48, 0, 0}); err == nil {
+		t.Error("49-block edge accepted")
+	}
+	if _, err := saveStructureCommands("max", [3]int{0, 0, 0}, [3]int{47, 47, 47}); err != nil {
+		t.Errorf("48-block edges rejected: %s", err)
+	}
+}
+
+func TestSaveStructureClearsHelperBlocksOnFailure(t *testing.T) {
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		if strings.Contains(command, "redstone_block") {
+			return "", errors.New("connection reset")
+		}
+		return "", nil
+	}}
+	if err := newClient(fake).SaveStructure(context.Background(), "x", [3]int{0, 0, 0}, [3]int{1, 1, 1}); err == nil {
+		t.Fatal("SaveStructure succeeded")
+	}
+	sent := fake.sent()
+	if len(sent) != 4 || sent[2] != "setblock 0 3 0 minecraft:air replace" || sent[3] != "setblock 0 2 0 minecraft:air replace" {
+		t.Errorf("sent %q, want both helper blocks cleared", sent)
+	}
+}
+
+func TestPlaceBlocks(t *testing.T) {
+	blocks := []BlockPlacement{
+		{X: 0, Y: 63, Z: 0, Block: "minecraft:hopper[facing=down]"},
+		{X: 0, Y: 64, Z: 0, Block: "minecraft:spawner"},
+		{X: 0, Y: 65, Z: 0, Block: "minecraft:glass"},
+	}
+
+	fake := &fakeRCON{}
+	if err := newClient(fake).PlaceBlocks(context.Background(), blocks); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"setblock 0 63 0 minecraft:hopper[facing=down] replace",
+		"setblock 0 64 0 minecraft:spawner replace",
+		"setblock 0 65 0 minecraft:glass replace",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+
+	failing := &fakeRCON{reply: func(command string) (string, error) {
+		if strings.Contains(command, "glass") {
+			return "", errors.New("connection reset")
+		}
+		return "", nil
+	}}
+	if err := newClient(failing).PlaceBlocks(context.Background(), blocks); err == nil {
+		t.Fatal("PlaceBlocks succeeded")
+	}
+	want = append(want,
+		"setblock 0 64 0 minecraft:air replace",
+		"setblock 0 63 0 minecraft:air replace",
+	)
+	if got := failing.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want the placed blocks cleared in reverse order: %q", got, want)
+	}
+}
+
+func TestCommandBlockArg(t *testing.T) {
+	got, err := commandBlockArg("repeating", "up", `say "hello" \o/`, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `minecraft:repeating_command_block[facing=up,conditional=false]{Command:"say \"hello\" \\o/",auto:1b}`
+	if got != want {
+		t.Errorf("commandBlockArg = %s, want %s", got, want)
+	}
+
+	got, _ = commandBlockArg("chain", "north", "time set day", false, true)
+	if want := `minecraft:chain_command_block[facing=north,conditional=true]{Command:"time set day",auto:0b}`; got != want {
+		t.Errorf("commandBlockArg = %s, want %s", got, want)
+	}
+
+	if _, err := commandBlockArg("minecart", "up", "say hi", false, false); err == nil {
+		t.Error("unknown kind accepted")
+	}
+}
+
+func TestUpdateCommandBlock(t *testing.T) {
+	fake := &fakeRCON{}
+	if err := newClient(fake).UpdateCommandBlock(context.Background(), 1, 2, 3, `tellraw @a {"text":"hi"}`, true); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{`data merge block 1 2 3 {Command:"tellraw @a {\"text\":\"hi\"}",auto:1b}`}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestSummonProjectile(t *testing.T) {
+	tests := []struct {
+		motion [3]float64
+		want   string
+	}{
+		{[3]float64{0, 1, 0}, "summon minecraft:arrow 0 70 0 {Motion:[0d,1d,0d]}"},
+		{[3]float64{0.5, -0.25, 1.125}, "summon minecraft:arrow 0 70 0 {Motion:[0.5d,-0.25d,1.125d]}"},
+		{[3]float64{0.1, 1e-7, -3}, "summon minecraft:arrow 0 70 0 {Motion:[0.1d,0.0000001d,-3d]}"},
+	}
+	for _, tt := range tests {
+		fake := &fakeRCON{}
+		if err := newClient(fake).SummonProjectile(context.Background(), "minecraft:arrow", "0 70 0", tt.motion); err != nil {
+			t.Fatal(err)
+		}
+		if got := fake.sent(); len(got) != 1 || got[0] != tt.want {
+			t.Errorf("motion %v: sent %q, want %q", tt.motion, got, tt.want)
+		}
+	}
+}
+
+func TestTeamTextCommands(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.SetTeamPrefix(ctx, "red", "[Red] "); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetTeamSuffix(ctx, "red", `"\o/"`); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetTeamPrefix(ctx, "red", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetTeamSuffix(ctx, "red", ""); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`team modify red prefix {"text":"[Red] "}`,
+		`team modify red suffix {"text":"\"\\o/\""}`,
+		`team modify red prefix {"text":""}`,
+		`team modify red suffix {"text":""}`,
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestParseDifficulty(t *testing.T) {
+	for out, want := range map[string]string{
+		"The difficulty is Normal":   "normal",
+		"The difficulty is peaceful": "peaceful",
+	} {
+		if got, err := parseDifficulty(out); err != nil || got != want {
+			t.Errorf("parseDifficulty(%q) = %q, %v; want %q", out, got, err, want)
+		}
+	}
+	for _, out := range []string{"", "Unknown command", "The difficulty is "} {
+		if got, err := parseDifficulty(out); err == nil {
+			t.Errorf("parseDifficulty(%q) = %q, want an error", out, got)
+		}
+	}
+}
+
+func TestSetItemFrameRotation(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	for _, rotation := range []int{0, 3, 7} {
+		if err := c.SetItemFrameRotation(ctx, "frame-1", rotation); err != nil {
+			t.Fatal(err)
+		}
+	}
+	const sel = `@e[nbt={CustomName:'{"text":"frame-1"}'},limit=1]`
+	want := []string{
+		"data merge entity " + sel + " {ItemRotation:0b}",
+		"data merge entity " + sel + " {ItemRotation:3b}",
+		"data merge entity " + sel + " {ItemRotation:7b}",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+
+	for _, rotation := range []int{-1, 8} {
+		if err := c.SetItemFrameRotation(ctx, "frame-1", rotation); err == nil {
+			t.Errorf("rotation %d accepted", rotation)
+		}
+	}
+	if len(fake.sent()) != len(want) {
+		t.Error("invalid rotations were sent")
+	}
+}
+
+func TestComponentItemArg(t *testing.T) {
+	modelData, glint := 7, false
+	tests := []struct {
+		name string
+		comp ItemComponents
+		want string
+	}{
+		{
+			name: "named lored unbreakable",
+			comp: ItemComponents{CustomName: "Wand", Lore: []string{"Zap", `It's "magic"`}, Unbreakable: true},
+			want: `minecraft:stick[custom_name='{"text":"Wand"}',lore=['{"text":"Zap"}','{"text":"It\'s \\"magic\\""}'],unbreakable={}]`,
+		},
+		{
+			name: "snbt text and float model data",
+			comp: ItemComponents{CustomName: "Wand", Lore: []string{"Zap"}, Unbreakable: true, CustomModelData: &modelData, FloatModelData: true, SNBTText: true},
+			want: `minecraft:stick[custom_name="Wand",lore=["Zap"],unbreakable={},custom_model_data={floats:[7f]}]`,
+		},
+		{
+			name: "int model data and glint",
+			comp: ItemComponents{CustomModelData: &modelData, GlintOverride: &glint},
+			want: `minecraft:stick[custom_model_data=7,enchantment_glint_override=false]`,
+		},
+		{name: "no components", want: "minecraft:stick"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := componentItemArg("minecraft:stick", tt.comp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := componentItemArg("minecraft:stick", ItemComponents{Lore: []string{"one\ntwo"}}); err == nil {
+		t.Error("multi-line lore accepted")
+	}
+	if _, err := componentItemArg("minecraft:stick", ItemComponents{Lore: make([]string, MaxLoreLines+1)}); err == nil {
+		t.Error("too many lore lines accepted")
+	}
+}
+
+func TestSetMarkerData(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.SetMarkerData(ctx, "m-1", ` {role:"exit",note:'a}b'} `); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetMarkerData(ctx, "m-1", ""); err != nil {
+		t.Fatal(err)
+	}
+	const sel = `@e[nbt={CustomName:'{"text":"m-1"}'},limit=1]`
+	want := []string{
+		"data modify entity " + sel + ` data set value {role:"exit",note:'a}b'}`,
+		"data modify entity " + sel + " data set value {}",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestCheckCompoundNBT(t *testing.T) {
+	for _, nbt := range []string{"{}", `{a:1,b:[1,2],c:{d:"}"}}`, `{s:'it\'s'}`} {
+		if err := CheckCompoundNBT(nbt); err != nil {
+			t.Errorf("CheckCompoundNBT(%q): %s", nbt, err)
+		}
+	}
+	for _, nbt := range []string{"", "[1]", "{a:[1}", `{a:"open}`, "{a:1}}"} {
+		if err := CheckCompoundNBT(nbt); err == nil {
+			t.Errorf("CheckCompoundNBT(%q) accepted", nbt)
+		}
+	}
+}
+
+func TestModifyObjective(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.ModifyObjectiveDisplayName(ctx, "kills", `Kills "total" \ all`); err != nil {
+		t.Fatal(err)
+	}
+	for _, renderType := range []string{"hearts", "integer"} {
+		if err := c.ModifyObjectiveRenderType(ctx, "kills", renderType); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.ModifyObjectiveRenderType(ctx, "kills", "stars"); err == nil {
+		t.Error("render type stars accepted")
+	}
+	want := []string{
+		`scoreboard objectives modify kills displayname {"text":"Kills \"total\" \\ all"}`,
+		"scoreboard objectives modify kills rendertype hearts",
+		"scoreboard objectives modify kills rendertype integer",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestRegionEntitySelector(t *testing.T) {
+	tests := []struct {
+		from, to       [3]int
+		excludePlayers bool
+		want           string
+	}{
+		{[3]int{0, 60, 0}, [3]int{9, 64, 9}, true, "@e[type=!minecraft:player,x=0,y=60,z=0,dx=9,dy=4,dz=9]"},
+		{[3]int{9, 64, 9}, [3]int{0, 60, 0}, true, "@e[type=!minecraft:player,x=0,y=60,z=0,dx=9,dy=4,dz=9]"},
+		{[3]int{-3, 70, 5}, [3]int{-3, 70, 5}, false, "@e[x=-3,y=70,z=5,dx=0,dy=0,dz=0]"},
+	}
+	for _, tt := range tests {
+		if got := regionEntitySelector(tt.from, tt.to, tt.excludePlayers); got != tt.want {
+			t.Errorf("regionEntitySelector(%v, %v, %t) = %s, want %s", tt.from, tt.to, tt.excludePlayers, got, tt.want)
+		}
+	}
+}
+
+func TestFormatPosition(t *testing.T) {
+	tests := []struct {
+		x, y, z float64
+		want    string
+	}{
+		{0.5, 64.0, 0.5, "0.5 64 0.5"},
+		{10, -3, 7, "10 -3 7"},
+		{-12.25, 70, 30000000, "-12.25 70 30000000"},
+	}
+	for _, tt := range tests {
+		if got := FormatPosition(tt.x, tt.y, tt.z); got != tt.want {
+			t.Errorf("FormatPosition(%g, %g, %g) = %q, want %q", tt.x, tt.y, tt.z, got, tt.want)
+		}
+	}
+}
+
+func TestCreateDroppedItem(t *testing.T) {
+	tests := []struct {
+		name                    string
+		noDespawn, pickupLocked bool
+		useComponents           bool
+		want                    string
+	}{
+		{name: "plain", want: `summon minecraft:item 0.5 65 0.5 {Tags:["i-1"],CustomName:'{"text":"i-1"}',Item:{id:"minecraft:diamond",Count:3b}}`},
+		{name: "pickup locked", pickupLocked: true, useComponents: true,
+			want: `summon minecraft:item 0.5 65 0.5 {Tags:["i-1"],CustomName:'{"text":"i-1"}',Item:{id:"minecraft:diamond",count:3},PickupDelay:32767s}`},
+		{name: "locked and no despawn", noDespawn: true, pickupLocked: true, useComponents: true,
+			want: `summon minecraft:item 0.5 65 0.5 {Tags:["i-1"],CustomName:'{"text":"i-1"}',Item:{id:"minecraft:diamond",count:3},Age:-32768s,PickupDelay:32767s}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeRCON{}
+			err := newClient(fake).CreateDroppedItem(context.Background(), "0.5 65 0.5", "i-1", "minecraft:diamond", 3, tt.noDespawn, tt.pickupLocked, tt.useComponents)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := fake.sent(); len(got) != 1 || got[0] != tt.want {
+				t.Errorf("sent %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, count := range []int{0, 65} {
+		if err := newClient(&fakeRCON{}).CreateDroppedItem(context.Background(), "0 64 0", "i-1", "minecraft:diamond", count, false, false, true); err == nil {
+			t.Errorf("count %d accepted", count)
+		}
+	}
+}
+
+func TestPingMeasuresLatency(t *testing.T) {
+	const delay = 30 * time.Millisecond
+	fake := &fakeRCON{reply: func(string) (string, error) {
+		time.Sleep(delay)
+		return "There are 0 of a max of 20 players online:", nil
+	}}
+	latency, err := newClient(fake).Ping(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latency < delay {
+		t.Errorf("latency = %s, want at least %s", latency, delay)
+	}
+	if got, want := fake.sent(), []string{"list"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestPingDoesNotRetry(t *testing.T) {
+	fake := &fakeRCON{reply: func(string) (string, error) { return "", errors.New("connection refused") }}
+	c := newClient(fake)
+	c.SetCommandRetries(3)
+	if _, err := c.Ping(context.Background()); err == nil {
+		t.Fatal("Ping succeeded")
+	}
+	if got := len(fake.sent()); got != 1 {
+		t.Errorf("sent %d commands, want 1", got)
+	}
+}
+
+func TestSummonStack(t *testing.T) {
+	tests := []struct {
+		name  string
+		stack []StackEntry
+		want  string
+	}{
+		{
+			name:  "two deep",
+			stack: []StackEntry{{Entity: "minecraft:spider"}, {Entity: "minecraft:skeleton"}},
+			want:  `summon minecraft:spider 0 64 0 {Tags:["g"],Passengers:[{id:"minecraft:skeleton",Tags:["g"]}]}`,
+		},
+		{
+			name:  "three deep with a name",
+			stack: []StackEntry{{Entity: "minecraft:horse"}, {Entity: "minecraft:zombie", Name: "Rider"}, {Entity: "minecraft:chicken"}},
+			want: `summon minecraft:horse 0 64 0 {Tags:["g"],Passengers:[{id:"minecraft:zombie",Tags:["g"],` +
+				`CustomName:'{"text":"Rider"}',CustomNameVisible:1b,Passengers:[{id:"minecraft:chicken",Tags:["g"]}]}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeRCON{}
+			if err := newClient(fake).SummonStack(context.Background(), "0 64 0", "g", tt.stack); err != nil {
+				t.Fatal(err)
+			}
+			if got := fake.sent(); len(got) != 1 || got[0] != tt.want {
+				t.Errorf("sent %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, n := range []int{0, MaxStackDepth + 1} {
+		if err := newClient(&fakeRCON{}).SummonStack(context.Background(), "0 64 0", "g", make([]StackEntry, n)); err == nil {
+			t.Errorf("stack of %d accepted", n)
+		}
+	}
+}
+
+func TestNoGravity(t *testing.T) {
+	ctx := context.Background()
+	for _, noGravity := range []bool{false, true} {
+		fake := &fakeRCON{}
+		c := newClient(fake)
+		if err := c.CreateZombie(ctx, "0 64 0", "z-1", "", false, false, false, true, 30, noGravity, false, false, ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.CreateSheep(ctx, "0 64 0", "s-1", "", "white", false, 0, noGravity, false, false, ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.CreateEntity(ctx, "minecraft:pig", "0 64 0", "p-1", "", false, noGravity, false, false, nil, nil, ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.CreateArmoredEntity(ctx, "minecraft:skeleton", "0 64 0", "k-1", "", false, Equipment{}, true, noGravity, false, false, nil, nil, ""); err != nil {
+			t.Fatal(err)
+		}
+
+		sent := fake.sent()
+		for _, command := range sent {
+			if got := strings.Contains(command, "NoGravity:1b"); got != noGravity {
+				t.Errorf("noGravity %t: %q has NoGravity %t", noGravity, command, got)
+			}
+		}
+		if noGravity && !strings.HasSuffix(sent[0], "Health:30.000000f,NoGravity:1b}") {
+			t.Errorf("zombie: %q, want NoGravity after Health", sent[0])
+		}
+	}
+}
+
+func TestNamedMobs(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	if err := c.CreateZombie(ctx, "0 64 0", "z-1", "Grave Lord", false, false, false, true, 40, false, false, false, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateSheep(ctx, "0 64 0", "s-1", "Shaun", "white", false, 6, false, false, false, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateSheep(ctx, "0 64 0", "s-2", "", "white", false, 0, false, false, false, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`summon zombie 0 64 0 {Tags:["z-1"],CustomName:'{"text":"Grave Lord"}',CustomNameVisible:1b,IsBaby:0b,CanBreakDoors:0b,CanPickUpLoot:0b,PersistenceRequired:1b,Health:40.000000f}`,
+		`summon sheep 0 64 0 {Tags:["s-1"],CustomName:'{"text":"Shaun"}',CustomNameVisible:1b,Color:0,Sheared:0b,Health:6.000000f}`,
+		`summon sheep 0 64 0 {Tags:["s-2"],CustomName:'{"text":"s-2"}',Color:0,Sheared:0b}`,
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestCreateGuardian(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	for _, tt := range []struct{ elder, persistent bool }{{false, false}, {true, false}, {false, true}, {true, true}} {
+		if err := c.CreateGuardian(ctx, "0.5 40 0.5", "g-1", tt.elder, tt.persistent); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []string{
+		`summon minecraft:guardian 0.5 40 0.5 {Tags:["g-1"],CustomName:'{"text":"g-1"}'}`,
+		`summon minecraft:elder_guardian 0.5 40 0.5 {Tags:["g-1"],CustomName:'{"text":"g-1"}'}`,
+		`summon minecraft:guardian 0.5 40 0.5 {Tags:["g-1"],CustomName:'{"text":"g-1"}',PersistenceRequired:1b}`,
+		`summon minecraft:elder_guardian 0.5 40 0.5 {Tags:["g-1"],CustomName:'{"text":"g-1"}',PersistenceRequired:1b}`,
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+
+	failed := newClient(&fakeRCON{reply: func(string) (string, error) { return "Invalid position for summon", nil }})
+	if err := failed.CreateGuardian(ctx, "0 -100 0", "g-1", false, false); err == nil {
+		t.Error("CreateGuardian ignored a failed summon")
+	}
+}
+
+func TestCreateShulker(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		color, face string
+		peek        byte
+		want        string
+	}{
+		{"default", "down", 0, "Color:16b,AttachFace:0b,Peek:0b"},
+		{"white", "up", 30, "Color:0b,AttachFace:1b,Peek:30b"},
+		{"light_blue", "north", 100, "Color:3b,AttachFace:2b,Peek:100b"},
+		{"purple", "south", 1, "Color:10b,AttachFace:3b,Peek:1b"},
+		{"green", "west", 50, "Color:13b,AttachFace:4b,Peek:50b"},
+		{"black", "east", 0, "Color:15b,AttachFace:5b,Peek:0b"},
+	}
+	for _, tt := range tests {
+		fake := &fakeRCON{}
+		if err := newClient(fake).CreateShulker(ctx, "0 64 0", "sh-1", tt.color, tt.face, tt.peek); err != nil {
+			t.Fatalf("CreateShulker(%s, %s, %d): %v", tt.color, tt.face, tt.peek, err)
+		}
+		want := `summon minecraft:shulker 0 64 0 {Tags:["sh-1"],CustomName:'{"text":"sh-1"}',` + tt.want + "}"
+		if got := fake.sent(); len(got) != 1 || got[0] != want {
+			t.Errorf("CreateShulker(%s, %s, %d) sent %q, want %q", tt.color, tt.face, tt.peek, got, want)
+		}
+	}
+
+	for i, color := range DyeColors {
+		fields, err := shulkerNBT(color, "down", 0)
+		if err != nil || fields[0] != fmt.Sprintf("Color:%db", i) {
+			t.Errorf("shulkerNBT(%s) = %q, %v, want Color:%db", color, fields, err, i)
+		}
+	}
+
+	for _, tt := range []struct {
+		color, face string
+		peek        byte
+	}{
+		{"rainbow", "down", 0},
+		{"Default", "down", 0},
+		{"red", "sideways", 0},
+		{"red", "down", 101},
+	} {
+		fake := &fakeRCON{}
+		if err := newClient(fake).CreateShulker(ctx, "0 64 0", "sh-1", tt.color, tt.face, tt.peek); err == nil {
+			t.Errorf("CreateShulker(%s, %s, %d) succeeded", tt.color, tt.face, tt.peek)
+		}
+		if sent := fake.sent(); len(sent) != 0 {
+			t.Errorf("invalid shulker sent %q", sent)
+		}
+	}
+}
+
+func TestBuildMobNBT(t *testing.T) {
+	rotation := [2]float64{90, 0}
+	tests := []struct {
+		name string
+		opts MobNBTOptions
+		want string
+	}{
+		{
+			name: "identity only",
+			opts: MobNBTOptions{ID: "m-1"},
+			want: `{Tags:["m-1"],CustomName:'{"text":"m-1"}'}`,
+		},
+		{
+			name: "extra tags and visible name",
+			opts: MobNBTOptions{ID: "m-1", ExtraTags: []string{"farm"}, Name: "Bessie", NameVisible: true},
+			want: `{Tags:["m-1","farm"],CustomName:'{"text":"Bessie"}',CustomNameVisible:1b}`,
+		},
+		{
+			name: "no id",
+			opts: MobNBTOptions{ExtraTags: []string{"herd", "herd_0"}, Fields: []string{"Value:5s"}},
+			want: `{Tags:["herd","herd_0"],Value:5s}`,
+		},
+		{
+			name: "no id or tags",
+			opts: MobNBTOptions{Fields: []string{"Motion:[0d,1d,0d]"}},
+			want: `{Motion:[0d,1d,0d]}`,
+		},
+		{
+			name: "name without id",
+			opts: MobNBTOptions{Name: "d-1"},
+			want: `{CustomName:'{"text":"d-1"}'}`,
+		},
+		{
+			name: "passengers",
+			opts: MobNBTOptions{
+				ExtraTags:  []string{"g"},
+				Passengers: []MobNBTOptions{{Type: "minecraft:skeleton", ExtraTags: []string{"g"}, Name: "Rider", NameVisible: true}},
+			},
+			want: `{Tags:["g"],Passengers:[{id:"minecraft:skeleton",Tags:["g"],CustomName:'{"text":"Rider"}',CustomNameVisible:1b}]}`,
+		},
+		{
+			name: "fields keep their order",
+			opts: MobNBTOptions{ID: "m-1", Fields: []string{"Sheared:1b", "Color:4"}},
+			want: `{Tags:["m-1"],CustomName:'{"text":"m-1"}',Sheared:1b,Color:4}`,
+		},
+		{
+			name: "equipment with drop chances",
+			opts: MobNBTOptions{
+				ID:             "m-1",
+				Equipment:      &Equipment{Head: "minecraft:iron_helmet", ArmorDropChances: &[4]float64{0, 0, 0, 2}, HandDropChances: &[2]float64{0, 0}},
+				ItemComponents: true,
+			},
+			want: `{Tags:["m-1"],CustomName:'{"text":"m-1"}',ArmorItems:[{},{},{},{id:"minecraft:iron_helmet",count:1}],HandItems:[{},{}],ArmorDropChances:[0f,0f,0f,2f],HandDropChances:[0f,0f]}`,
+		},
+		{
+			name: "every option",
+			opts: MobNBTOptions{
+				ID:                  "m-1",
+				Name:                "Bessie",
+				Fields:              []string{"IsBaby:1b"},
+				PersistenceRequired: true,
+				Health:              12.5,
+				Equipment:           &Equipment{MainHand: "minecraft:stick"},
+				NoGravity:           true,
+				Invulnerable:        true,
+				Silent:              true,
+				NoAI:                true,
+				Rotation:            &rotation,
+				DeathLootTable:      "minecraft:empty",
+			},
+			want: `{Tags:["m-1"],CustomName:'{"text":"Bessie"}',IsBaby:1b,PersistenceRequired:1b,Health:12.500000f,ArmorItems:[{},{},{},{}],HandItems:[{id:"minecraft:stick",Count:1b},{}],NoGravity:1b,Invulnerable:1b,Silent:1b,NoAI:1b,Rotation:[90f,0f],DeathLootTable:"minecraft:empty"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BuildMobNBT(tt.opts); got != tt.want {
+				t.Errorf("BuildMobNBT() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestBuildMobNBTCombinations sets every subset of the optional fields and
+// checks each tag is present exactly when set, in the documented order.
+func TestBuildMobNBTCombinations(t *testing.T) {
+	rotation := [2]float64{45, -10}
+	options := []struct {
+		tag string
+		set func(*MobNBTOptions)
+	}{
+		{`CustomNameVisible:1b`, func(o *MobNBTOptions) { o.NameVisible = true }},
+		{`IsBaby:1b`, func(o *MobNBTOptions) { o.Fields = []string{"IsBaby:1b"} }},
+		{`PersistenceRequired:1b`, func(o *MobNBTOptions) { o.PersistenceRequired = true }},
+		{`Health:4.000000f`, func(o *MobNBTOptions) { o.Health = 4 }},
+		{`ArmorItems:[{},{},{},{}],HandItems:[{},{}]`, func(o *MobNBTOptions) { o.Equipment = &Equipment{} }},
+		{`NoGravity:1b`, func(o *MobNBTOptions) { o.NoGravity = true }},
+		{`Invulnerable:1b`, func(o *MobNBTOptions) { o.Invulnerable = true }},
+		{`Silent:1b`, func(o *MobNBTOptions) { o.Silent = true }},
+		{`NoAI:1b`, func(o *MobNBTOptions) { o.NoAI = true }},
+		{`Rotation:[45f,-10f]`, func(o *MobNBTOptions) { o.Rotation = &rotation }},
+		{`DeathLootTable:"minecraft:empty"`, func(o *MobNBTOptions) { o.DeathLootTable = "minecraft:empty" }},
+	}
+
+	for mask := 0; mask < 1<<len(options); mask++ {
+		opts := MobNBTOptions{ID: "m-1"}
+		want := []string{`Tags:["m-1"]`, `CustomName:'{"text":"m-1"}'`}
+		for i, option := range options {
+			if mask&(1<<i) != 0 {
+				option.set(&opts)
+				want = append(want, option.tag)
+			}
+		}
+		if got, want := BuildMobNBT(opts), "{"+strings.Join(want, ",")+"}"; got != want {
+			t.Errorf("mask %011b: BuildMobNBT() = %s, want %s", mask, got, want)
+		}
+	}
+}
+
+func TestParseBanList(t *testing.T) {
+	tests := []struct {
+		name string
+		out  string
+		want []string
+	}{
+		{name: "no bans", out: "There are no bans", want: []string{}},
+		{
+			name: "players with reasons",
+			out:  "There are 2 ban(s):\nSteve was banned by Server: Banned by an operator.\nAlex was banned by Rcon: griefing: was banned by mods before",
+			want: []string{"Steve", "Alex"},
+		},
+		{
+			name: "ips",
+			out:  "There are 1 ban(s):\n10.0.0.1 was banned by Rcon: spam",
+			want: []string{"10.0.0.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseBanList(tt.out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseBanList = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, out := range []string{
+		"Unknown command",
+		// Lines joined without a separator can't be split.
+		"There are 2 ban(s):Steve was banned by Server: a.Alex was banned by Server: b.",
+	} {
+		if got, err := parseBanList(out); err == nil {
+			t.Errorf("parseBanList(%q) = %q, want an error", out, got)
+		}
+	}
+}
+
+func TestParseSpawnProtection(t *testing.T) {
+	tests := []struct {
+		props string
+		want  int
+	}{
+		{"motd=A Minecraft Server\nspawn-protection=0\n", 0},
+		{"#comment\r\nspawn-protection = 32\r\n", 32},
+		{"motd=no radius here\n", DefaultSpawnProtection},
+	}
+	for _, tt := range tests {
+		if got, err := parseSpawnProtection(tt.props); err != nil || got != tt.want {
+			t.Errorf("parseSpawnProtection(%q) = %d, %v; want %d", tt.props, got, err, tt.want)
+		}
+	}
+	if _, err := parseSpawnProtection("spawn-protection=lots\n"); err == nil {
+		t.Error("non-numeric radius accepted")
+	}
+}
+
+func TestGetWorldSpawn(t *testing.T) {
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		if strings.HasPrefix(command, "data get entity ") {
+			return "Marker has the following entity data: [12.5d, 64.0d, -7.5d]", nil
+		}
+		return "", nil
+	}}
+	spawn, err := newClient(fake).GetWorldSpawn(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [3]int{12, 64, -8}; spawn != want {
+		t.Errorf("spawn = %v, want %v", spawn, want)
+	}
+	want := []string{
+		`summon minecraft:marker ~ ~ ~ {Tags:["tf_spawn_probe"]}`,
+		"data get entity @e[type=minecraft:marker,tag=tf_spawn_probe,limit=1] Pos",
+		"kill @e[type=minecraft:marker,tag=tf_spawn_probe]",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestSilent(t *testing.T) {
+	ctx := context.Background()
+	for _, silent := range []bool{false, true} {
+		fake := &fakeRCON{}
+		c := newClient(fake)
+		if err := c.CreateZombie(ctx, "0 64 0", "z-1", "", false, false, false, true, 20, true, true, silent, "minecraft:empty"); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.CreateSheep(ctx, "0 64 0", "s-1", "", "white", false, 0, false, false, silent, ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.CreateEntity(ctx, "minecraft:pig", "0 64 0", "p-1", "", false, false, true, silent, nil, []string{"farm"}, ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.CreateArmoredEntity(ctx, "minecraft:skeleton", "0 64 0", "k-1", "", false, Equipment{}, true, true, false, silent, nil, nil, ""); err != nil {
+			t.Fatal(err)
+		}
+
+		sent := fake.sent()
+		for _, command := range sent {
+			if got := strings.Contains(command, "Silent:1b"); got != silent {
+				t.Errorf("silent %t: %q has Silent %t", silent, command, got)
+			}
+		}
+		if !silent {
+			continue
+		}
+		for i, suffix := range []string{
+			`NoGravity:1b,Invulnerable:1b,Silent:1b,DeathLootTable:"minecraft:empty"}`,
+			`Sheared:0b,Silent:1b}`,
+			`Invulnerable:1b,Silent:1b}`,
+			`HandItems:[{},{}],NoGravity:1b,Silent:1b}`,
+		} {
+			if !strings.HasSuffix(sent[i], suffix) {
+				t.Errorf("%q, want suffix %q", sent[i], suffix)
+			}
+		}
+	}
+}
+
+func TestLocate(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		kind, target, reply string
+		want                Location
+	}{
+		{"structure", "#minecraft:village", "The nearest #minecraft:village (minecraft:village_plains) is at [-1120, ~, 704] (1247 blocks away)", Location{Pos: [3]int{-1120, 0, 704}, Distance: 1247}},
+		{"biome", "minecraft:plains", "The nearest minecraft:plains is at [96, 63, -32] (101 blocks away)", Location{Pos: [3]int{96, 63, -32}, HasY: true, Distance: 101}},
+		{"poi", "minecraft:meeting", "The nearest minecraft:meeting is at [8, 70, 8] (0 blocks away)", Location{Pos: [3]int{8, 70, 8}, HasY: true}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		fake := &fakeRCON{reply: func(string) (string, error) { return tt.reply, nil }}
+		got, err := newClient(fake).Locate(ctx, tt.kind, tt.target)
+		if err != nil {
+			t.Fatalf("Locate(%s, %s): %v", tt.kind, tt.target, err)
+		}
+		if got != tt.want {
+			t.Errorf("Locate(%s, %s) = %+v, want %+v", tt.kind, tt.target, got, tt.want)
+		}
+		if want := fmt.Sprintf("locate %s %s", tt.kind, tt.target); fake.sent()[0] != want {
+			t.Errorf("sent %q, want %q", fake.sent()[0], want)
+		}
+	}
+
+	notFound := newClient(&fakeRCON{reply: func(string) (string, error) {
+		return `Could not find a structure of type "minecraft:stronghold" nearby`, nil
+	}})
+	if _, err := notFound.Locate(ctx, "structure", "minecraft:stronghold"); err == nil || !strings.Contains(err.Error(), "Could not find") {
+		t.Errorf("err = %v, want the server's message", err)
+	}
+
+	legacy := newClient(&fakeRCON{reply: func(string) (string, error) {
+		return "Incorrect argument for command\nlocate structure<--[HERE]", nil
+	}})
+	if _, err := legacy.Locate(ctx, "structure", "minecraft:stronghold"); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("err = %v, want ErrUnsupported", err)
+	}
+}
+
+func TestInvulnerable(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	if err := c.CreateZombie(ctx, "0 64 0", "z-1", "", false, false, false, true, 20, true, true, false, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateSheep(ctx, "0 64 0", "s-1", "", "white", false, 0, false, true, false, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateEntity(ctx, "minecraft:pig", "0 64 0", "p-1", "", false, false, false, false, nil, nil, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	sent := fake.sent()
+	if !strings.HasSuffix(sent[0], "Health:20.000000f,NoGravity:1b,Invulnerable:1b}") {
+		t.Errorf("zombie: %q, want NoGravity and Invulnerable after Health", sent[0])
+	}
+	if !strings.HasSuffix(sent[1], "Sheared:0b,Invulnerable:1b}") {
+		t.Errorf("sheep: %q, want Invulnerable", sent[1])
+	}
+	if strings.Contains(sent[2], "Invulnerable") {
+		t.Errorf("pig: %q has Invulnerable without the flag", sent[2])
+	}
+}
+
+func TestValidateObjectiveCriterion(t *testing.T) {
+	tests := []struct {
+		criterion string
+		wantErr   string
+	}{
+		{criterion: "dummy"},
+		{criterion: "trigger"},
+		{criterion: "deathCount"},
+		{criterion: "teamkill.red"},
+		{criterion: "killedByTeam.dark_aqua"},
+		{criterion: "minecraft.killed:minecraft.zombie"},
+		{criterion: "minecraft.custom:minecraft.jump"},
+		{criterion: "minecraft.mined:minecraft.oak_log"},
+		{criterion: "mymod.widgets:mymod.gear"},
+		{criterion: "dummmy", wantErr: `did you mean "dummy"`},
+		{criterion: "Dummy", wantErr: "case-sensitive"},
+		{criterion: "teamkill.reset", wantErr: "needs a chat color"},
+		{criterion: "stat.killEntity.Zombie", wantErr: "pre-1.13"},
+		{criterion: "minecraft.slain:minecraft.zombie", wantErr: `unknown stat type "slain"`},
+		{criterion: "minecraft:zombie", wantErr: "is not a stat criterion"},
+		{criterion: "banana", wantErr: "unknown criterion"},
+	}
+	for _, tt := range tests {
+		err := ValidateObjectiveCriterion(tt.criterion)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("ValidateObjectiveCriterion(%q) = %v", tt.criterion, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("ValidateObjectiveCriterion(%q) = %v, want error containing %q", tt.criterion, err, tt.wantErr)
+		}
+	}
+}
+
+func TestTeamExists(t *testing.T) {
+	tests := []struct {
+		reply   string
+		exists  bool
+		wantErr bool
+	}{
+		{reply: "Team [Blue] has 2 members: Steve, Alex", exists: true},
+		{reply: "There are no members on team [Blue]", exists: true},
+		{reply: "Unknown team 'Blue'"},
+		{reply: "Incorrect argument for command", wantErr: true},
+	}
+	for _, tt := range tests {
+		fake := &fakeRCON{reply: func(string) (string, error) { return tt.reply, nil }}
+		exists, err := newClient(fake).TeamExists(context.Background(), "Blue")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q: err = %v, wantErr %t", tt.reply, err, tt.wantErr)
+			continue
+		}
+		if exists != tt.exists {
+			t.Errorf("%q: exists = %t, want %t", tt.reply, exists, tt.exists)
+		}
+		if sent := fake.sent(); !reflect.DeepEqual(sent, []string{"team list Blue"}) {
+			t.Errorf("sent %q", sent)
+		}
+	}
+}
+
+func TestRotationNBT(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	rotation := &[2]float64{-90, 22.5}
+	if err := c.CreateEntity(ctx, "minecraft:armor_stand", "0 64 0", "a-1", "", false, false, false, false, rotation, nil, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateBlockDisplay(ctx, "0 64 0", "d-1", "minecraft:glass", [3]float64{1, 1, 1}, [3]float64{}, "", rotation); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateEntity(ctx, "minecraft:armor_stand", "0 64 0", "a-2", "", false, false, false, false, nil, nil, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	sent := fake.sent()
+	for _, command := range sent[:2] {
+		if !strings.HasSuffix(command, ",Rotation:[-90f,22.5f]}") {
+			t.Errorf("%q, want a trailing Rotation tag", command)
+		}
+	}
+	if strings.Contains(sent[2], "Rotation") {
+		t.Errorf("%q has Rotation without one being set", sent[2])
+	}
+}
+
+func TestSetBlockWithNBT(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{}
+	states := map[string]string{"rotation": "8", "waterlogged": "false"}
+	if err := newClient(fake).SetBlockWithNBT(ctx, "minecraft:oak_sign", 1, 64, 2, states, "{is_waxed:1b}"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"setblock 1 64 2 minecraft:oak_sign[rotation=8,waterlogged=false] replace",
+		"data merge block 1 64 2 {is_waxed:1b}",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+
+	fake = &fakeRCON{}
+	if err := newClient(fake).SetBlockWithNBT(ctx, "minecraft:chest[facing=north]", 1, 64, 2, nil, ""); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fake.sent(), []string{"setblock 1 64 2 minecraft:chest[facing=north] replace"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("empty nbt: sent %q, want %q", got, want)
+	}
+
+	if err := newClient(&fakeRCON{}).SetBlockWithNBT(ctx, "minecraft:chest[facing=north]", 1, 64, 2, states, ""); err == nil {
+		t.Error("states in both places: want an error")
+	}
+}
+
+func TestSetBlockWithNBTRollsBackFailedMerge(t *testing.T) {
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		if strings.HasPrefix(command, "data merge ") {
+			return "", errors.New("connection reset")
+		}
+		return "", nil
+	}}
+	err := newClient(fake).SetBlockWithNBT(context.Background(), "minecraft:spawner", 0, 70, 0, nil, "{Delay:20s}")
+	if err == nil {
+		t.Fatal("want the merge error")
+	}
+	want := []string{
+		"setblock 0 70 0 minecraft:spawner replace",
+		"data merge block 0 70 0 {Delay:20s}",
+		"setblock 0 70 0 minecraft:air replace",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestCreateEffectCloud(t *testing.T) {
+	effects := []CloudEffect{
+		{ID: "minecraft:poison", Amplifier: 1, Duration: 200},
+		{ID: "minecraft:slowness", Amplifier: 200, Duration: 100},
+	}
+	color := 0x4e9331
+	tests := []struct {
+		name          string
+		useComponents bool
+		want          string
+	}{
+		{
+			name: "legacy",
+			want: `Radius:2.5f,Duration:600,` +
+				`Effects:[{Id:"minecraft:poison",Amplifier:1b,Duration:200},{Id:"minecraft:slowness",Amplifier:-56b,Duration:100}],` +
+				`Color:5149489,Particle:"minecraft:witch"}`,
+		},
+		{
+			name:          "components",
+			useComponents: true,
+			want: `Radius:2.5f,Duration:600,` +
+				`potion_contents:{custom_effects:[{id:"minecraft:poison",amplifier:1b,duration:200},{id:"minecraft:slowness",amplifier:-56b,duration:100}],custom_color:5149489},` +
+				`Particle:{type:"minecraft:witch"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeRCON{}
+			err := newClient(fake).CreateEffectCloud(context.Background(), "0 64 0", "c-1", 2.5, 600, effects, "minecraft:witch", &color, tt.useComponents)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sent := fake.sent()
+			prefix := `summon minecraft:area_effect_cloud 0 64 0 {Tags:["c-1"],CustomName:'{"text":"c-1"}',`
+			if len(sent) != 1 || sent[0] != prefix+tt.want {
+				t.Errorf("sent %q, want %q", sent, prefix+tt.want)
+			}
+		})
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    [3]int
+		wantErr bool
+	}{
+		{in: "1.20.4", want: [3]int{1, 20, 4}},
+		{in: "1.21", want: [3]int{1, 21, 0}},
+		{in: " 1.8.9 ", want: [3]int{1, 8, 9}},
+		{in: "1", wantErr: true},
+		{in: "1.20.4.1", wantErr: true},
+		{in: "1.x", wantErr: true},
+		{in: "1.-2", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseVersion(tt.in)
+		if (err != nil) != tt.wantErr || (!tt.wantErr && got != tt.want) {
+			t.Errorf("ParseVersion(%q) = %v, %v; want %v, wantErr %t", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b [3]int
+		want int
+	}{
+		{a: [3]int{1, 20, 4}, b: [3]int{1, 20, 4}, want: 0},
+		{a: [3]int{1, 20, 4}, b: [3]int{1, 20, 5}, want: -1},
+		{a: [3]int{1, 21, 0}, b: [3]int{1, 20, 5}, want: 1},
+		{a: [3]int{1, 9, 0}, b: [3]int{1, 13, 0}, want: -1},
+	}
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersions(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestParseVersionReply(t *testing.T) {
+	tests := []struct {
+		reply   string
+		want    string
+		wantErr bool
+	}{
+		{reply: "This server is running Paper version git-Paper-496 (MC: 1.20.4) (Implementing API version 1.20.4-R0.1-SNAPSHOT)", want: "1.20.4"},
+		{reply: "This server is running CraftBukkit version 4012-Spigot-1d4f3a2 (MC: 1.21) (Implementing API version 1.21-R0.1-SNAPSHOT)", want: "1.21"},
+		{reply: "Server version info:\nid = 1.21.6\nname = 1.21.6", want: "1.21.6"},
+		{reply: "Unknown or incomplete command, see below for error", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseVersionReply(tt.reply)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseVersionReply(%q) = %q, %v; want %q, wantErr %t", tt.reply, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestGameModeArgFollowsVersion(t *testing.T) {
+	for _, tt := range []struct {
+		version [3]int
+		want    string
+	}{
+		{version: [3]int{}, want: "gamemode creative Steve"},
+		{version: [3]int{1, 13, 0}, want: "gamemode creative Steve"},
+		{version: [3]int{1, 12, 2}, want: "gamemode 1 Steve"},
+	} {
+		fake := &fakeRCON{}
+		c := newClient(fake)
+		c.SetVersion(tt.version)
+		if err := c.SetUserGameMode(context.Background(), "creative", "Steve"); err != nil {
+			t.Fatal(err)
+		}
+		if got := fake.sent(); !reflect.DeepEqual(got, []string{tt.want}) {
+			t.Errorf("version %v: sent %q, want %q", tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestKillByTypeSelector(t *testing.T) {
+	center := &[3]int{0, 64, -5}
+	radius, zero := 16, 0
+	tests := []struct {
+		name       string
+		entityType string
+		center     *[3]int
+		radius     *int
+		want       string
+		wantErr    bool
+	}{
+		{name: "unscoped", entityType: "minecraft:zombie", want: "@e[type=minecraft:zombie]"},
+		{name: "scoped", entityType: "minecraft:item", center: center, radius: &radius, want: "@e[type=minecraft:item,x=0,y=64,z=-5,distance=..16]"},
+		{name: "center without radius", entityType: "minecraft:zombie", center: center, wantErr: true},
+		{name: "zero radius", entityType: "minecraft:zombie", center: center, radius: &zero, wantErr: true},
+		{name: "player", entityType: "minecraft:player", wantErr: true},
+		{name: "bare player", entityType: "player", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := killByTypeSelector(tt.entityType, tt.center, tt.radius)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("%s: killByTypeSelector = %q, %v; want %q, wantErr %t", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestKillByTypeCount(t *testing.T) {
+	for _, tt := range []struct {
+		reply string
+		n     int
+		ok    bool
+	}{
+		{reply: "Killed 3 entities", n: 3, ok: true},
+		{reply: "No entity was found", n: 0, ok: true},
+		{reply: "Unknown or incomplete command", ok: false},
+	} {
+		fake := &fakeRCON{reply: func(string) (string, error) { return tt.reply, nil }}
+		n, ok, err := newClient(fake).KillByType(context.Background(), "minecraft:zombie", nil, nil)
+		if err != nil || n != tt.n || ok != tt.ok {
+			t.Errorf("%q: KillByType = %d, %t, %v; want %d, %t", tt.reply, n, ok, err, tt.n, tt.ok)
+		}
+		if got := fake.sent(); !reflect.DeepEqual(got, []string{"kill @e[type=minecraft:zombie]"}) {
+			t.Errorf("sent %q", got)
+		}
+	}
+}
+
+func TestSaveAll(t *testing.T) {
+	tests := []struct {
+		flush   bool
+		reply   string
+		want    string
+		wantErr bool
+	}{
+		{flush: true, reply: "Saving the game (this may take a moment!)Saved the game", want: "save-all flush"},
+		{flush: false, reply: "Saved the game", want: "save-all"},
+		{flush: true, reply: "Saving failed: No space left on device", want: "save-all flush", wantErr: true},
+		{flush: true, reply: "", want: "save-all flush", wantErr: true},
+	}
+	for _, tt := range tests {
+		fake := &fakeRCON{reply: func(string) (string, error) { return tt.reply, nil }}
+		err := newClient(fake).SaveAll(context.Background(), tt.flush)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q: err = %v, wantErr %t", tt.reply, err, tt.wantErr)
+		}
+		if got := fake.sent(); !reflect.DeepEqual(got, []string{tt.want}) {
+			t.Errorf("flush=%t: sent %q, want %q", tt.flush, got, tt.want)
+		}
+	}
+}
+
+func TestReload(t *testing.T) {
+	tests := []struct {
+		reply   string
+		wantErr bool
+	}{
+		{reply: "Reloading!"},
+		{reply: ""},
+		{reply: "Reloading!\nFailed to reload data, keeping old data", wantErr: true},
+	}
+	for _, tt := range tests {
+		fake := &fakeRCON{reply: func(string) (string, error) { return tt.reply, nil }}
+		if err := newClient(fake).Reload(context.Background()); (err != nil) != tt.wantErr {
+			t.Errorf("%q: err = %v, wantErr %t", tt.reply, err, tt.wantErr)
+		}
+		if got := fake.sent(); !reflect.DeepEqual(got, []string{"reload"}) {
+			t.Errorf("sent %q, want reload", got)
+		}
+	}
+}
+
+func TestDatapackCommands(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		if strings.Contains(command, "missing") {
+			return "Unknown data pack 'file/missing'", nil
+		}
+		return "Enabled pack [file/my pack (world)]", nil
+	}}
+	c := newClient(fake)
+	if err := c.EnableDatapack(ctx, "file/my pack"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DisableDatapack(ctx, "file/my pack"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.EnableDatapack(ctx, "file/missing"); err == nil {
+		t.Error("unknown pack: want an error")
+	}
+	want := []string{`datapack enable "file/my pack"`, `datapack disable "file/my pack"`, `datapack enable "file/missing"`}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestParseDatapackList(t *testing.T) {
+	tests := []struct {
+		reply              string
+		enabled, available []string
+		wantErr            bool
+	}{
+		{
+			reply:     "There are 2 data pack(s) enabled: [vanilla (built-in)], [file/mypack (world)]\nThere are 1 data pack(s) available: [bundle (feature)]",
+			enabled:   []string{"vanilla", "file/mypack"},
+			available: []string{"bundle"},
+		},
+		{
+			reply:     "There are 1 data pack(s) enabled: [file/my pack]There are no more data packs available",
+			enabled:   []string{"file/my pack"},
+			available: []string{},
+		},
+		{
+			reply:     "There are no data packs enabledThere are 1 data pack(s) available: [file/off.zip (world)]",
+			enabled:   []string{},
+			available: []string{"file/off.zip"},
+		},
+		{reply: "Unknown or incomplete command", wantErr: true},
+	}
+	for _, tt := range tests {
+		enabled, available, err := parseDatapackList(tt.reply)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseDatapackList(%q) err = %v, wantErr %t", tt.reply, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && (!reflect.DeepEqual(enabled, tt.enabled) || !reflect.DeepEqual(available, tt.available)) {
+			t.Errorf("parseDatapackList(%q) = %q, %q; want %q, %q", tt.reply, enabled, available, tt.enabled, tt.available)
+		}
+	}
+}
+
+func TestParseTPS(t *testing.T) {
+	tests := []struct {
+		name            string
+		reply           string
+		tps1, tps5, t15 float64
+		unsupported     bool
+		wantErr         bool
+	}{
+		{name: "paper", reply: "§6TPS from last 1m, 5m, 15m: §a20.0, §a*20.0, §a19.87", tps1: 20, tps5: 20, t15: 19.87},
+		{name: "multi-line", reply: "TPS from last 1m, 5m, 15m: 18.5, 19.2, 19.9\nCurrent Memory Usage: 1024/4096 mb (Max: 4096 mb)", tps1: 18.5, tps5: 19.2, t15: 19.9},
+		{name: "five windows", reply: "TPS from last 5s, 1m, 5m, 15m, 30m: 17.0, 18.0, 19.0, 19.5, 20.0", tps1: 18, tps5: 19, t15: 19.5},
+		{name: "vanilla", reply: "Unknown or incomplete command, see below for error", unsupported: true},
+		{name: "spigot", reply: `Unknown command. Type "/help" for help.`, unsupported: true},
+		{name: "malformed", reply: "TPS from last 1m, 5m, 15m: 20.0, lots, 19.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		tps1, tps5, tps15, err := parseTPS(tt.reply)
+		switch {
+		case tt.unsupported:
+			if !errors.Is(err, ErrUnsupported) {
+				t.Errorf("%s: err = %v, want ErrUnsupported", tt.name, err)
+			}
+		case tt.wantErr:
+			if err == nil || errors.Is(err, ErrUnsupported) {
+				t.Errorf("%s: err = %v, want a parse error", tt.name, err)
+			}
+		case err != nil:
+			t.Errorf("%s: %v", tt.name, err)
+		case tps1 != tt.tps1 || tps5 != tt.tps5 || tps15 != tt.t15:
+			t.Errorf("%s: parseTPS = %g, %g, %g; want %g, %g, %g", tt.name, tps1, tps5, tps15, tt.tps1, tt.tps5, tt.t15)
+		}
+	}
+}
+
+func TestParsePos(t *testing.T) {
+	tests := []struct {
+		reply   string
+		want    [3]float64
+		wantErr bool
+	}{
+		{reply: "Marker has the following entity data: [12.0d, 64.0d, -8.0d]", want: [3]float64{12, 64, -8}},
+		{reply: "Marker has the following entity data: [-0.5d, 70.25d, 3.75d]", want: [3]float64{-0.5, 70.25, 3.75}},
+		{reply: "No entity was found", wantErr: true},
+		{reply: "Marker has the following entity data: [1.0d, 2.0d]", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parsePos(tt.reply)
+		if (err != nil) != tt.wantErr || (!tt.wantErr && got != tt.want) {
+			t.Errorf("parsePos(%q) = %v, %v; want %v, wantErr %t", tt.reply, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestOffsetBlockPos(t *testing.T) {
+	tests := []struct {
+		pos    [3]float64
+		offset [3]int
+		want   [3]int
+	}{
+		{pos: [3]float64{12.7, 64, -8}, offset: [3]int{0, 1, 0}, want: [3]int{12, 65, -8}},
+		{pos: [3]float64{-0.5, 63.9, -0.01}, offset: [3]int{0, 0, 0}, want: [3]int{-1, 63, -1}},
+		{pos: [3]float64{3, 70, 3}, offset: [3]int{-4, -2, 64}, want: [3]int{-1, 68, 67}},
+	}
+	for _, tt := range tests {
+		if got := OffsetBlockPos(tt.pos, tt.offset); got != tt.want {
+			t.Errorf("OffsetBlockPos(%v, %v) = %v, want %v", tt.pos, tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestGetEntityPos(t *testing.T) {
+	fake := &fakeRCON{reply: func(string) (string, error) { return "No entity was found", nil }}
+	if _, err := newClient(fake).GetEntityPos(context.Background(), "anchor"); err == nil {
+		t.Error("missing entity: want an error")
+	}
+	want := `data get entity @e[nbt={CustomName:'{"text":"anchor"}'},limit=1] Pos`
+	if got := fake.sent(); !reflect.DeepEqual(got, []string{want}) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestEntityTagsKeepTrackingTag(t *testing.T) {
+	tests := []struct {
+		extra []string
+		want  string
+	}{
+		{extra: nil, want: `Tags:["id-1"]`},
+		{extra: []string{"boss", "arena.red"}, want: `Tags:["id-1","boss","arena.red"]`},
+		{extra: []string{"id-1", "boss"}, want: `Tags:["id-1","boss"]`},
+	}
+	for _, tt := range tests {
+		fake := &fakeRCON{}
+		if err := newClient(fake).CreateEntity(context.Background(), "minecraft:pig", "0 64 0", "id-1", "", false, false, false, false, nil, tt.extra, ""); err != nil {
+			t.Fatal(err)
+		}
+		if sent := fake.sent(); !strings.Contains(sent[0], "{"+tt.want+",") {
+			t.Errorf("extra %q: sent %q, want %s", tt.extra, sent[0], tt.want)
+		}
+	}
+}
+
+func TestSetWorldBorderSize(t *testing.T) {
+	seconds := 120
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	if err := c.SetWorldBorderSize(context.Background(), 500, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetWorldBorderSize(context.Background(), 250.5, &seconds); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetWorldBorderCenter(context.Background(), -10.5, 20); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"worldborder set 500", "worldborder set 250.5 120", "worldborder center -10.5 20"}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestXPOrbCommand(t *testing.T) {
+	for _, value := range []int{1, 10, MaxXPOrbValue} {
+		got, err := xpOrbCommand("0 64 0", value)
+		if want := fmt.Sprintf("summon minecraft:experience_orb 0 64 0 {Value:%ds}", value); err != nil || got != want {
+			t.Errorf("xpOrbCommand(%d) = %q, %v; want %q", value, got, err, want)
+		}
+	}
+	for _, value := range []int{0, -5, MaxXPOrbValue + 1} {
+		if _, err := xpOrbCommand("0 64 0", value); err == nil {
+			t.Errorf("xpOrbCommand(%d) accepted", value)
+		}
+	}
+}
+
+func TestExecuteStoreResult(t *testing.T) {
+	fake := &fakeRCON{reply: func(command string) (string, error) {
+		if strings.HasPrefix(command, "scoreboard players get ") {
+			return "#cows has -3 [counts]", nil
+		}
+		return "Test passed, count: 3", nil
+	}}
+	n, err := newClient(fake).ExecuteStoreResult(context.Background(), "#cows", "counts", " /execute if entity @e[type=minecraft:cow]")
+	if err != nil || n != -3 {
+		t.Errorf("ExecuteStoreResult = %d, %v; want -3", n, err)
+	}
+	want := []string{
+		"execute store result score #cows counts run execute if entity @e[type=minecraft:cow]",
+		"scoreboard players get #cows counts",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestExecuteStoreResultRejectsParseErrors(t *testing.T) {
+	for _, reply := range []string{
+		"Unknown scoreboard objective 'counts'",
+		"Unknown or incomplete command, see below for error",
+		"Incorrect argument for command",
+	} {
+		fake := &fakeRCON{reply: func(string) (string, error) { return reply, nil }}
+		if _, err := newClient(fake).ExecuteStoreResult(context.Background(), "#t", "counts", "time query daytime"); err == nil {
+			t.Errorf("%q: want an error", reply)
+		}
+		if sent := fake.sent(); len(sent) != 1 {
+			t.Errorf("%q: sent %q, want no score read-back", reply, sent)
+		}
+	}
+}
+
+func TestParseScore(t *testing.T) {
+	for reply, want := range map[string]int{"Steve has 5 [menu]": 5, "#terraform has -12 [counts]": -12} {
+		if got, err := parseScore(reply); err != nil || got != want {
+			t.Errorf("parseScore(%q) = %d, %v; want %d", reply, got, err, want)
+		}
+	}
+	if _, err := parseScore("Can't get value of menu for Steve; none is set"); err == nil {
+		t.Error("unset score: want an error")
+	}
+}
+
+func TestTitleCommands(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	if err := c.ShowTitle(ctx, "@a", "actionbar", `Say "hi" \o/`); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.SetTitleTimes(ctx, "Steve", 5, 40, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ClearTitle(ctx, "@a[team=red]"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ResetTitleTimes(ctx, "@a[team=red]"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ShowTitle(ctx, "@a", "bossbar", "nope"); err == nil {
+		t.Error("unknown title type: want an error")
+	}
+	want := []string{
+		`title @a actionbar {"text":"Say \"hi\" \\o/"}`,
+		"title Steve times 5 40 10",
+		"title @a[team=red] clear",
+		"title @a[team=red] reset",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestEntityAppearanceCommands(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		version [3]int
+		give    string
+	}{
+		{version: [3]int{}, give: "effect give @e[tag=e-1] minecraft:invisibility infinite 0 true"},
+		{version: [3]int{1, 19, 3}, give: "effect give @e[tag=e-1] minecraft:invisibility 1000000 0 true"},
+	} {
+		fake := &fakeRCON{}
+		c := newClient(fake)
+		c.SetVersion(tt.version)
+		for _, on := range []bool{true, false} {
+			if err := c.SetEntityInvisible(ctx, "e-1", on); err != nil {
+				t.Fatal(err)
+			}
+			if err := c.SetEntityGlowing(ctx, "e-1", on); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want := []string{
+			tt.give,
+			"data merge entity @e[tag=e-1,limit=1] {Glowing:1b}",
+			"effect clear @e[tag=e-1] minecraft:invisibility",
+			"data merge entity @e[tag=e-1,limit=1] {Glowing:0b}",
+		}
+		if got := fake.sent(); !reflect.DeepEqual(got, want) {
+			t.Errorf("version %v: sent %q, want %q", tt.version, got, want)
+		}
+	}
+
+	fake := &fakeRCON{reply: func(string) (string, error) { return "No entity was found", nil }}
+	if err := newClient(fake).SetEntityGlowing(ctx, "gone", true); err == nil {
+		t.Error("missing entity: want an error")
+	}
+}
+
+func TestDeathLootTable(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	const table = "farm:mobs/zombie_drops"
+	if err := c.CreateZombie(ctx, "0 64 0", "z-1", "", false, false, false, true, 20, true, true, false, table); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateSheep(ctx, "0 64 0", "s-1", "", "white", false, 0, false, false, false, table); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateEntity(ctx, "minecraft:pig", "0 64 0", "p-1", "", false, false, false, false, nil, nil, table); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.CreateEntity(ctx, "minecraft:pig", "0 64 0", "p-2", "", false, false, false, false, nil, nil, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	sent := fake.sent()
+	if !strings.HasSuffix(sent[0], `,NoGravity:1b,Invulnerable:1b,DeathLootTable:"farm:mobs/zombie_drops"}`) {
+		t.Errorf("zombie: %q, want DeathLootTable after NoGravity and Invulnerable", sent[0])
+	}
+	for _, command := range sent[1:3] {
+		if !strings.HasSuffix(command, `,DeathLootTable:"farm:mobs/zombie_drops"}`) {
+			t.Errorf("%q, want a trailing DeathLootTable", command)
+		}
+	}
+	if strings.Contains(sent[3], "DeathLootTable") {
+		t.Errorf("%q has DeathLootTable without a table", sent[3])
+	}
+}
+
+func TestClaimStagingSlot(t *testing.T) {
+	claimed := false
+	fake := &fakeRCON{reply: func(cmd string) (string, error) {
+		if strings.HasPrefix(cmd, "execute if entity") {
+			if claimed {
+				return "Test passed, count: 1", nil
+			}
+			return "Test failed", nil
+		}
+		if strings.HasPrefix(cmd, "summon") {
+			claimed = true
+		}
+		return "", nil
+	}}
+	c := newClient(fake)
+	ctx := context.Background()
+	corner := [3]int{100256, -10, 100000}
+
+	if ok, err := c.ClaimStagingSlot(ctx, corner); err != nil || !ok {
+		t.Fatalf("first claim = %t, %v; want true", ok, err)
+	}
+	if ok, err := c.ClaimStagingSlot(ctx, corner); err != nil || ok {
+		t.Fatalf("second claim = %t, %v; want false", ok, err)
+	}
+	if err := c.ReleaseStagingSlot(ctx, corner); err != nil {
+		t.Fatal(err)
+	}
+	selector := "@e[type=minecraft:marker,tag=tf_staging_claim,x=100256,y=-10,z=100000,dx=0,dy=0,dz=0]"
+	want := []string{
+		"execute if entity " + selector,
+		`summon minecraft:marker 100256 -10 100000 {Tags:["tf_staging_claim"]}`,
+		"execute if entity " + selector,
+		"kill " + selector,
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q\nwant %q", got, want)
+	}
+}
+
+func TestJoinTeamEntitiesByTag(t *testing.T) {
+	tests := []struct {
+		reply   string
+		wantErr bool
+	}{
+		{reply: "Added 1 entity to team red"},
+		{reply: "No entity was found", wantErr: true},
+		{reply: "Added 0 entities to team red", wantErr: true},
+		// Unrecognized replies, e.g. from modded servers, aren't second-guessed.
+		{reply: ""},
+	}
+	for _, tt := range tests {
+		fake := &fakeRCON{reply: func(string) (string, error) { return tt.reply, nil }}
+		err := newClient(fake).JoinTeamEntitiesByTag(context.Background(), "red", "sheep-1")
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q: err = %v, wantErr %t", tt.reply, err, tt.wantErr)
+		}
+		if got, want := fake.sent(), []string{"team join red @e[tag=sheep-1]"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("sent %q, want %q", got, want)
+		}
+	}
+}
+
+func TestReplaceBlockItem(t *testing.T) {
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	ctx := context.Background()
+	if err := c.ReplaceBlockItem(ctx, 1, 64, -2, 4, "minecraft:diamond", 12); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ReplaceBlockItem(ctx, 1, 64, -2, 4, "minecraft:diamond", 65); err == nil {
+		t.Error("count 65 accepted")
+	}
+	if err := c.ReplaceBlockItem(ctx, 1, 64, -2, -1, "minecraft:diamond", 1); err == nil {
+		t.Error("slot -1 accepted")
+	}
+	if got, want := fake.sent(), []string{"item replace block 1 64 -2 container.4 with minecraft:diamond 12"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+
+	for _, reply := range []string{"Target position 1, 64, -2 is not a container", "The target does not have slot container.30"} {
+		fake := &fakeRCON{reply: func(string) (string, error) { return reply, nil }}
+		if err := newClient(fake).ReplaceBlockItem(ctx, 1, 64, -2, 30, "minecraft:diamond", 1); err == nil {
+			t.Errorf("reply %q: got no error", reply)
+		}
+	}
+}
+
+func TestEquipFromLoot(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{}
+	c := newClient(fake)
+	sel := SelectorByTag("minecraft:zombie", "z-1")
+	for _, slot := range []string{"armor.head", "armor.feet", "weapon.mainhand"} {
+		if err := c.EquipFromLoot(ctx, sel, slot, "gear:zombie/"+slot); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []string{
+		"loot replace entity @e[type=minecraft:zombie,tag=z-1,limit=1] armor.head loot gear:zombie/armor.head",
+		"loot replace entity @e[type=minecraft:zombie,tag=z-1,limit=1] armor.feet loot gear:zombie/armor.feet",
+		"loot replace entity @e[type=minecraft:zombie,tag=z-1,limit=1] weapon.mainhand loot gear:zombie/weapon.mainhand",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+
+	if err := c.EquipFromLoot(ctx, sel, "container.0", "gear:zombie"); err == nil {
+		t.Error("container.0 accepted as an equipment slot")
+	}
+	for _, reply := range []string{"No entity was found", "Unknown loot table: gear:nope"} {
+		reply := reply
+		c := newClient(&fakeRCON{reply: func(string) (string, error) { return reply, nil }})
+		if err := c.EquipFromLoot(ctx, sel, "armor.head", "gear:nope"); err == nil {
+			t.Errorf("reply %q: no error", reply)
+		}
+	}
+}
+
+func TestFillFrame(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRCON{}
+	// Corners in any order give the same edges.
+	if err := newClient(fake).FillFrame(ctx, "minecraft:gold_block", [3]int{10, 70, -5}, [3]int{0, 64, 5}); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		// along X
+		"fill 0 64 -5 10 64 -5 minecraft:gold_block",
+		"fill 0 64 5 10 64 5 minecraft:gold_block",
+		"fill 0 70 -5 10 70 -5 minecraft:gold_block",
+		"fill 0 70 5 10 70 5 minecraft:gold_block",
+		// along Y
+		"fill 0 64 -5 0 70 -5 minecraft:gold_block",
+		"fill 10 64 -5 10 70 -5 minecraft:gold_block",
+		"fill 0 64 5 0 70 5 minecraft:gold_block",
+		"fill 10 64 5 10 70 5 minecraft:gold_block",
+		// along Z
+		"fill 0 64 -5 0 64 5 minecraft:gold_block",
+		"fill 0 70 -5 0 70 5 minecraft:gold_block",
+		"fill 10 64 -5 10 64 5 minecraft:gold_block",
+		"fill 10 70 -5 10 70 5 minecraft:gold_block",
+	}
+	if got := fake.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+
+	// A flat cuboid's top and bottom edges coincide.
+	if got := frameCommands("minecraft:air", [3]int{0, 64, 0}, [3]int{3, 64, 3}); len(got) != 4+4 {
+		t.Errorf("flat frame: %q, want 8 distinct fills", got)
+	}
+	if got := frameCommands("minecraft:air", [3]int{1, 2, 3}, [3]int{1, 2, 3}); !reflect.DeepEqual(got, []string{"fill 1 2 3 1 2 3 minecraft:air"}) {
+		t.Errorf("single block frame: %q", got)
+	}
+
+	tooBig := newClient(&fakeRCON{reply: func(string) (string, error) {
+		return "Too many blocks in the specified area (maximum 32768, specified 40001)", nil
+	}})
+	if err := tooBig.FillFrame(ctx, "minecraft:stone", [3]int{0, 0, 0}, [3]int{40000, 0, 0}); err == nil {
+		t.Error("FillFrame ignored a too-large edge")
+	}
+}
+
+func TestRegenerateChunks(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		from, to [2]int
+		want     string
+	}{
+		{[2]int{0, 0}, [2]int{15, 15}, "function terraform:regenerate_chunks {from_x:0,from_z:0,to_x:0,to_z:0}"},
+		{[2]int{40, -1}, [2]int{-16, 16}, "function terraform:regenerate_chunks {from_x:-1,from_z:-1,to_x:2,to_z:1}"},
+		{[2]int{-17, -32}, [2]int{-17, -33}, "function terraform:regenerate_chunks {from_x:-2,from_z:-3,to_x:-2,to_z:-2}"},
+	}
+	for _, tt := range tests {
+		fake := &fakeRCON{}
+		c := newClient(fake)
+		if err := c.RegenerateChunks(ctx, tt.from, tt.to); err != nil {
+			t.Fatal(err)
+		}
+		if got := fake.sent(); len(got) != 1 || got[0] != tt.want {
+			t.Errorf("RegenerateChunks(%v, %v) sent %q, want %q", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	for _, reply := range []string{
+		"Unknown function terraform:regenerate_chunks",
+		"Incorrect argument for command\n...ate_chunks <--[HERE]",
+		"Unknown or incomplete command, see below for error",
+	} {
+		reply := reply
+		c := newClient(&fakeRCON{reply: func(string) (string, error) { return reply, nil }})
+		if err := c.RegenerateChunks(ctx, [2]int{0, 0}, [2]int{0, 0}); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("reply %q: err = %v, want ErrUnsupported", reply, err)
+		}
+	}
+}
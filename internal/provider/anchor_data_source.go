@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = anchorDataSourceType{}
+var _ tfsdk.DataSource = anchorDataSource{}
+
+// anchorSpawn is the anchor kind for the world spawn; the other kinds are
+// minecraft.LocateKinds.
+const anchorSpawn = "spawn"
+
+func anchorKinds() []string {
+	return append([]string{anchorSpawn}, minecraft.LocateKinds...)
+}
+
+type anchorDataSourceType struct{}
+
+func (t anchorDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Named reference points to build against, such as the world spawn or the nearest village. Structures, biomes and points of interest are found with `locate` (1.19+), which searches from the world spawn, like every RCON command.",
+		Attributes: map[string]tfsdk.Attribute{
+			"anchors": {
+				MarkdownDescription: "Points to look up, keyed by the name they get in `points`.",
+				Required:            true,
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"kind": {
+						MarkdownDescription: "What to look up: `spawn` for the world spawn, or `structure`, `biome` or `poi` for the nearest match of `target`.",
+						Required:            true,
+						Type:                types.StringType,
+						Validators: []tfsdk.AttributeValidator{
+							stringOneOf(anchorKinds()...),
+						},
+					},
+					"target": {
+						MarkdownDescription: "ID or tag to locate, e.g. `#minecraft:village`, `minecraft:plains` or `minecraft:meeting`. Required unless `kind` is `spawn`.",
+						Optional:            true,
+						Type:                types.StringType,
+					},
+				}),
+			},
+			"points": {
+				MarkdownDescription: "The coordinates of each anchor, keyed like `anchors`.",
+				Computed:            true,
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate.",
+						Computed:            true,
+						Type:                types.Int64Type,
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate. Null for structures, which `locate` only reports by column.",
+						Computed:            true,
+						Type:                types.Int64Type,
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate.",
+						Computed:            true,
+						Type:                types.Int64Type,
+					},
+					"distance": {
+						MarkdownDescription: "Distance from the world spawn in blocks, as `locate` reports it. 0 for `spawn`.",
+						Computed:            true,
+						Type:                types.Int64Type,
+					},
+				}),
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "The anchor names, comma separated.",
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t anchorDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return anchorDataSource{provider: provider}, diags
+}
+
+type anchorSpec struct {
+	Kind   string       `tfsdk:"kind"`
+	Target types.String `tfsdk:"target"`
+}
+
+type anchorPoint struct {
+	X        types.Int64 `tfsdk:"x"`
+	Y        types.Int64 `tfsdk:"y"`
+	Z        types.Int64 `tfsdk:"z"`
+	Distance types.Int64 `tfsdk:"distance"`
+}
+
+type anchorDataSourceData struct {
+	Id      types.String           `tfsdk:"id"`
+	Anchors map[string]anchorSpec  `tfsdk:"anchors"`
+	Points  map[string]anchorPoint `tfsdk:"points"`
+}
+
+// validateAnchor checks that spec names a known kind and, for everything
+// but spawn, a target that locate accepts.
+func validateAnchor(name string, spec anchorSpec) error {
+	hasTarget := !spec.Target.Null && spec.Target.Value != ""
+	switch {
+	case spec.Kind == anchorSpawn:
+		if hasTarget {
+			return fmt.Errorf("anchor %q: target can't be set for kind spawn", name)
+		}
+		return nil
+	case !stringIn(spec.Kind, minecraft.LocateKinds):
+		return fmt.Errorf("anchor %q: kind must be one of %s (got %q)", name, strings.Join(anchorKinds(), ", "), spec.Kind)
+	case !hasTarget:
+		return fmt.Errorf("anchor %q: target is required for kind %s", name, spec.Kind)
+	case !namespacedIDPattern.MatchString(strings.TrimPrefix(spec.Target.Value, "#")):
+		return fmt.Errorf("anchor %q: target must be an ID or tag such as minecraft:plains or #minecraft:village (got %q)", name, spec.Target.Value)
+	}
+	return nil
+}
+
+func stringIn(s string, values []string) bool {
+	for _, v := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+type anchorDataSource struct {
+	provider provider
+}
+
+func (d anchorDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data anchorDataSourceData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make([]string, 0, len(data.Anchors))
+	for name, spec := range data.Anchors {
+		if err := validateAnchor(name, spec); err != nil {
+			resp.Diagnostics.AddError("Validation Error", err.Error())
+		}
+		names = append(names, name)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Strings(names)
+
+	client, err := d.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	// The spawn is probed at most once, however many anchors ask for it.
+	var spawn *[3]int
+	data.Points = make(map[string]anchorPoint, len(names))
+	for _, name := range names {
+		spec := data.Anchors[name]
+		if spec.Kind == anchorSpawn {
+			if spawn == nil {
+				pos, err := client.GetWorldSpawn(ctx)
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the world spawn for anchor %q: %s", name, err))
+					return
+				}
+				spawn = &pos
+			}
+			data.Points[name] = anchorPoint{
+				X:        types.Int64{Value: int64(spawn[0])},
+				Y:        types.Int64{Value: int64(spawn[1])},
+				Z:        types.Int64{Value: int64(spawn[2])},
+				Distance: types.Int64{Value: 0},
+			}
+			continue
+		}
+
+		loc, err := client.Locate(ctx, spec.Kind, spec.Target.Value)
+		if err != nil {
+			if errors.Is(err, minecraft.ErrUnsupported) {
+				resp.Diagnostics.AddError("Unsupported", fmt.Sprintf("Unable to locate anchor %q: %s. Only spawn anchors work before 1.19.", name, err))
+				return
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to locate anchor %q (%s %s): %s", name, spec.Kind, spec.Target.Value, err))
+			return
+		}
+		point := anchorPoint{
+			X:        types.Int64{Value: int64(loc.Pos[0])},
+			Y:        types.Int64{Null: true},
+			Z:        types.Int64{Value: int64(loc.Pos[2])},
+			Distance: types.Int64{Value: int64(loc.Distance)},
+		}
+		if loc.HasY {
+			point.Y = types.Int64{Value: int64(loc.Pos[1])}
+		}
+		data.Points[name] = point
+	}
+	data.Id = types.String{Value: strings.Join(names, ",")}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// readAnchors reads minecraft_anchor with anchors mapping names to
+// {kind, target}; an empty target is left null.
+func readAnchors(t *testing.T, p *provider, anchors map[string][2]string) (anchorDataSourceData, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	schema, _ := anchorDataSourceType{}.GetSchema(ctx)
+	anchorsType := schema.TerraformType(ctx).(tftypes.Object).AttributeTypes["anchors"].(tftypes.Map)
+	specType := anchorsType.ElementType.(tftypes.Object)
+
+	elems := map[string]tftypes.Value{}
+	for name, spec := range anchors {
+		target := tftypes.NewValue(tftypes.String, nil)
+		if spec[1] != "" {
+			target = tftypes.NewValue(tftypes.String, spec[1])
+		}
+		elems[name] = tftypes.NewValue(specType, map[string]tftypes.Value{
+			"kind":   tftypes.NewValue(tftypes.String, spec[0]),
+			"target": target,
+		})
+	}
+	config := objectValue(ctx, schema, map[string]tftypes.Value{"anchors": tftypes.NewValue(anchorsType, elems)})
+
+	ds, diags := anchorDataSourceType{}.NewDataSource(ctx, p)
+	if diags.HasError() {
+		t.Fatalf("NewDataSource: %v", diags)
+	}
+	resp := tfsdk.ReadDataSourceResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)}}
+	ds.Read(ctx, tfsdk.ReadDataSourceRequest{Config: tfsdk.Config{Schema: schema, Raw: config}}, &resp)
+
+	var data anchorDataSourceData
+	if !resp.Diagnostics.HasError() {
+		if diags := resp.State.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("reading state: %v", diags)
+		}
+	}
+	return data, resp.Diagnostics
+}
+
+func TestAnchorPopulatesPoints(t *testing.T) {
+	server := newFakeServer(t, func(command string) string {
+		switch {
+		case command == "locate structure #minecraft:village":
+			return "The nearest #minecraft:village (minecraft:village_plains) is at [-1120, ~, 704] (1247 blocks away)"
+		case command == "locate biome minecraft:plains":
+			return "The nearest minecraft:plains is at [96, 63, -32] (101 blocks away)"
+		case strings.HasPrefix(command, "data get entity "):
+			return "Marker has the following entity data: [8.5d, 70.0d, -3.5d]"
+		}
+		return ""
+	})
+	p := configureProvider(t, server.address, nil)
+
+	data, diags := readAnchors(t, p, map[string][2]string{
+		"spawn":   {"spawn", ""},
+		"home":    {"spawn", ""},
+		"village": {"structure", "#minecraft:village"},
+		"plains":  {"biome", "minecraft:plains"},
+	})
+	if diags.HasError() {
+		t.Fatalf("Read: %v", diags)
+	}
+
+	want := map[string][4]int64{
+		"spawn":   {8, 70, -4, 0},
+		"home":    {8, 70, -4, 0},
+		"village": {-1120, 0, 704, 1247},
+		"plains":  {96, 63, -32, 101},
+	}
+	for name, w := range want {
+		got, ok := data.Points[name]
+		if !ok {
+			t.Errorf("no point %q in %v", name, data.Points)
+			continue
+		}
+		if got.X.Value != w[0] || got.Y.Value != w[1] || got.Z.Value != w[2] || got.Distance.Value != w[3] {
+			t.Errorf("points[%q] = %+v, want %v", name, got, w)
+		}
+	}
+	if !data.Points["village"].Y.Null {
+		t.Errorf("village y = %v, want null for a structure", data.Points["village"].Y)
+	}
+	if data.Id.Value != "home,plains,spawn,village" {
+		t.Errorf("id = %q", data.Id.Value)
+	}
+
+	var probes int
+	for _, command := range server.sent() {
+		if strings.HasPrefix(command, "summon minecraft:marker") {
+			probes++
+		}
+	}
+	if probes != 1 {
+		t.Errorf("world spawn probed %d times, want once", probes)
+	}
+}
+
+func TestAnchorLocateNotFound(t *testing.T) {
+	server := newFakeServer(t, func(command string) string {
+		return `Could not find a structure of type "minecraft:stronghold" nearby`
+	})
+	p := configureProvider(t, server.address, nil)
+
+	_, diags := readAnchors(t, p, map[string][2]string{"stronghold": {"structure", "minecraft:stronghold"}})
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "Could not find") {
+		t.Errorf("diags = %v, want the server's message", diags)
+	}
+}
+
+func TestAnchorValidation(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		anchors map[string][2]string
+		want    string
+	}{
+		{"unknown kind", map[string][2]string{"a": {"village", "minecraft:village"}}, "kind must be one of spawn, structure, biome, poi"},
+		{"missing target", map[string][2]string{"a": {"biome", ""}}, "target is required"},
+		{"target on spawn", map[string][2]string{"a": {"spawn", "minecraft:plains"}}, "can't be set"},
+		{"bad target", map[string][2]string{"a": {"structure", "Village"}}, "ID or tag"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeServer(t, func(string) string { return "" })
+			p := configureProvider(t, server.address, nil)
+
+			_, diags := readAnchors(t, p, tt.anchors)
+			if !diags.HasError() || !strings.Contains(diags[0].Detail(), tt.want) {
+				t.Errorf("diags = %v, want %q", diags, tt.want)
+			}
+			for _, command := range server.sent() {
+				if strings.HasPrefix(command, "locate") || strings.HasPrefix(command, "summon") {
+					t.Errorf("sent %q for an invalid anchor", command)
+				}
+			}
+		})
+	}
+}
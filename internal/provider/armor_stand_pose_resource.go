@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = armorStandPoseResourceType{}
+var _ tfsdk.Resource = armorStandPoseResource{}
+var _ tfsdk.ResourceWithImportState = armorStandPoseResource{}
+
+// ---------- Resource Type ----------
+
+type armorStandPoseResourceType struct{}
+
+func (t armorStandPoseResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Poses an existing armor stand (e.g. a `minecraft_entity` of type `minecraft:armor_stand`). Rotations are in degrees and update in place; limbs left unset keep the default stance, and destroying the resource returns the stand to its default pose.",
+		Attributes: map[string]tfsdk.Attribute{
+			"armor_stand_id": {
+				MarkdownDescription: "ID of the armor stand to pose (the `id` of its `minecraft_entity`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"head": {
+				MarkdownDescription: "Head rotation.",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Rotation")),
+			},
+			"body": {
+				MarkdownDescription: "Body rotation.",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Rotation")),
+			},
+			"left_arm": {
+				MarkdownDescription: "Left arm rotation.",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Rotation")),
+			},
+			"right_arm": {
+				MarkdownDescription: "Right arm rotation.",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Rotation")),
+			},
+			"left_leg": {
+				MarkdownDescription: "Left leg rotation.",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Rotation")),
+			},
+			"right_leg": {
+				MarkdownDescription: "Right leg rotation.",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Rotation")),
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Same as `armor_stand_id`.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t armorStandPoseResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return armorStandPoseResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type armorStandPoseResourceData struct {
+	Id           types.String `tfsdk:"id"`
+	ArmorStandId string       `tfsdk:"armor_stand_id"`
+	Head         *vec3        `tfsdk:"head"`      // optional
+	Body         *vec3        `tfsdk:"body"`      // optional
+	LeftArm      *vec3        `tfsdk:"left_arm"`  // optional
+	RightArm     *vec3        `tfsdk:"right_arm"` // optional
+	LeftLeg      *vec3        `tfsdk:"left_leg"`  // optional
+	RightLeg     *vec3        `tfsdk:"right_leg"` // optional
+}
+
+// pose validates each rotation and converts the set parts to the client's PoseNBT.
+func (d armorStandPoseResourceData) pose() (minecraft.PoseNBT, error) {
+	var pose minecraft.PoseNBT
+	parts := []struct {
+		name string
+		in   *vec3
+		out  **[3]float64
+	}{
+		{"head", d.Head, &pose.Head},
+		{"body", d.Body, &pose.Body},
+		{"left_arm", d.LeftArm, &pose.LeftArm},
+		{"right_arm", d.RightArm, &pose.RightArm},
+		{"left_leg", d.LeftLeg, &pose.LeftLeg},
+		{"right_leg", d.RightLeg, &pose.RightLeg},
+	}
+
+	for _, part := range parts {
+		if part.in == nil {
+			continue
+		}
+		rot := [3]float64{part.in.X, part.in.Y, part.in.Z}
+		for _, v := range rot {
+			if math.IsNaN(v) || math.IsInf(v, 0) || v < -360 || v > 360 {
+				return pose, fmt.Errorf("%s rotation must be between -360 and 360 degrees (got %v, %v, %v)", part.name, rot[0], rot[1], rot[2])
+			}
+		}
+		*part.out = &rot
+	}
+	return pose, nil
+}
+
+// ---------- Resource Impl ----------
+
+type armorStandPoseResource struct {
+	provider provider
+}
+
+func (r armorStandPoseResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data armorStandPoseResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: data.ArmorStandId}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r armorStandPoseResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data armorStandPoseResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r armorStandPoseResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// armor_stand_id is ForceNew; every rotation is merged in place.
+	var data armorStandPoseResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: data.ArmorStandId}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r armorStandPoseResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data armorStandPoseResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	// The stand may already be gone (e.g. destroyed in the same apply); that's fine.
+	if err := client.ResetArmorStandPose(ctx, data.ArmorStandId); err != nil {
+		resp.Diagnostics.AddWarning("Delete Warning", fmt.Sprintf("Unable to reset pose of armor stand %s: %s", data.ArmorStandId, err))
+	}
+}
+
+func (r armorStandPoseResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// Import by armor stand UUID; rotations come from config on the next apply.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("armor_stand_id"), req.ID)...)
+}
+
+func (r armorStandPoseResource) apply(ctx context.Context, data *armorStandPoseResourceData, diags *diag.Diagnostics) {
+	pose, err := data.pose()
+	if err != nil {
+		diags.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	// Start from the default stance so limbs dropped from config don't keep an old rotation.
+	if err := client.ResetArmorStandPose(ctx, data.ArmorStandId); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to reset pose of armor stand %s: %s", data.ArmorStandId, err))
+		return
+	}
+	if err := client.SetArmorStandPose(ctx, data.ArmorStandId, pose); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to pose armor stand %s: %s", data.ArmorStandId, err))
+	}
+}
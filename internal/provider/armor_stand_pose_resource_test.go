@@ -0,0 +1,22 @@
+package provider
+
+import "testing"
+
+func TestArmorStandPoseValidatesRotations(t *testing.T) {
+	data := armorStandPoseResourceData{
+		Head:    &vec3{X: 10, Y: -45, Z: 0},
+		LeftLeg: &vec3{X: 360, Y: 0, Z: -360},
+	}
+	pose, err := data.pose()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pose.Head == nil || pose.LeftLeg == nil || pose.Body != nil {
+		t.Errorf("pose = %s, want only Head and LeftLeg", pose)
+	}
+
+	data.RightArm = &vec3{X: 0, Y: 400, Z: 0}
+	if _, err := data.pose(); err == nil {
+		t.Error("a 400 degree rotation was accepted")
+	}
+}
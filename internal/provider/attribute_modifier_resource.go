@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = attributeModifierResourceType{}
+var _ tfsdk.Resource = attributeModifierResource{}
+
+// ---------- Resource Type ----------
+
+type attributeModifierResourceType struct{}
+
+func (t attributeModifierResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Adds an attribute modifier to a single entity or player (wraps `/attribute ... modifier add`) and removes it on destroy. Modifiers persist on the entity, unlike base values. Any change replaces the modifier.",
+		Attributes: map[string]tfsdk.Attribute{
+			"target": {
+				MarkdownDescription: "Player name or selector matching exactly one entity (e.g. `@e[tag=boss,limit=1]`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"attribute": {
+				MarkdownDescription: "Attribute to modify (e.g. `minecraft:generic.movement_speed`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Modifier name. Shown on servers before 1.21; newer servers identify modifiers by `id` only.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"value": {
+				MarkdownDescription: "Modifier amount.",
+				Required:            true,
+				Type:                types.Float64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"operation": {
+				MarkdownDescription: "How the value applies: `add`, `multiply_base` or `multiply`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "UUID identifying the modifier. On 1.21+ the in-game modifier ID is `terraform:<id>`.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t attributeModifierResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return attributeModifierResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type attributeModifierResourceData struct {
+	Id        types.String `tfsdk:"id"`
+	Target    string       `tfsdk:"target"`
+	Attribute string       `tfsdk:"attribute"`
+	Name      string       `tfsdk:"name"`
+	Value     float64      `tfsdk:"value"`
+	Operation string       `tfsdk:"operation"`
+}
+
+// ---------- Resource Impl ----------
+
+type attributeModifierResource struct {
+	provider provider
+}
+
+func (r attributeModifierResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data attributeModifierResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !minecraft.IsModifierOperation(data.Operation) {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("operation must be one of add|multiply_base|multiply (got %q)", data.Operation))
+		return
+	}
+	if math.IsNaN(data.Value) || math.IsInf(data.Value, 0) {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("value must be a finite number (got %v)", data.Value))
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	id := uuid.NewString()
+	if err := client.AddAttributeModifier(ctx, data.Target, data.Attribute, id, data.Name, data.Value, data.Operation, r.provider.useResourceLocationModifiers()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add %s modifier to %s: %s", data.Attribute, data.Target, err))
+		return
+	}
+
+	data.Id = types.String{Value: id}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r attributeModifierResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data attributeModifierResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r attributeModifierResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data attributeModifierResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r attributeModifierResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data attributeModifierResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.RemoveAttributeModifier(ctx, data.Target, data.Attribute, data.Id.Value, r.provider.useResourceLocationModifiers()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s modifier from %s: %s", data.Attribute, data.Target, err))
+		return
+	}
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure types satisfy framework interfaces
+var _ tfsdk.ResourceType = banResourceType{}
+var _ tfsdk.Resource = banResource{}
+
+// -------- Resource Type --------
+
+type banResourceType struct{}
+
+func (t banResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Bans a player from the server. Setting `duration` issues a temporary ban via `tempban` (requires a plugin such as EssentialsX); destroying the resource pardons the player.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Resource ID (same as `player`).",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"player": {
+				Type:                types.StringType,
+				Required:            true,
+				MarkdownDescription: "Minecraft player username to ban.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"reason": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Reason shown to the player.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"duration": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Length of a temporary ban, e.g. `1d`, `3h` or `1w2d`. Units are `s`, `m`, `h`, `d`, `w`, `mo` and `y`. Leave unset for a permanent ban.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t banResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return banResource{provider: p}, diags
+}
+
+// -------- Data & Resource --------
+
+type banResourceData struct {
+	ID       types.String `tfsdk:"id"`
+	Player   types.String `tfsdk:"player"`
+	Reason   types.String `tfsdk:"reason"`
+	Duration types.String `tfsdk:"duration"`
+}
+
+type banResource struct {
+	provider provider
+}
+
+// banDurationPattern matches EssentialsX-style durations such as "3h" or "1w2d".
+var banDurationPattern = regexp.MustCompile(`^([0-9]+(mo|[smhdwy]))+$`)
+
+func validateBanDuration(duration string) error {
+	if duration == "" || banDurationPattern.MatchString(duration) {
+		return nil
+	}
+	return fmt.Errorf("`duration` must be a number followed by a unit (s, m, h, d, w, mo, y), e.g. \"1d\" or \"3h\"; got %q", duration)
+}
+
+// -------- CRUD --------
+
+func (r banResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan banResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	player := strings.TrimSpace(plan.Player.Value)
+	if player == "" {
+		resp.Diagnostics.AddError("Validation Error", "Attribute `player` cannot be empty or whitespace.")
+		return
+	}
+	duration := strings.TrimSpace(plan.Duration.Value)
+	if err := validateBanDuration(duration); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.TempBanPlayer(ctx, player, duration, plan.Reason.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to ban %q: %s", player, err))
+		return
+	}
+
+	plan.ID = types.String{Value: player}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r banResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// A temporary ban expires on its own; the server offers no portable way to
+	// tell, so state is kept as-is.
+	var state banResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r banResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// All attributes are ForceNew. Just keep plan as state.
+	var plan banResourceData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r banResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state banResourceData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	player := strings.TrimSpace(state.Player.Value)
+	if player == "" {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.PardonPlayer(ctx, player); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pardon %q: %s", player, err))
+		return
+	}
+}
@@ -0,0 +1,16 @@
+package provider
+
+import "testing"
+
+func TestValidateBanDuration(t *testing.T) {
+	for _, d := range []string{"", "30s", "3h", "1d", "1w2d", "6mo", "1y"} {
+		if err := validateBanDuration(d); err != nil {
+			t.Errorf("validateBanDuration(%q): %v", d, err)
+		}
+	}
+	for _, d := range []string{"3", "h", "1 d", "-1d", "1x"} {
+		if err := validateBanDuration(d); err == nil {
+			t.Errorf("validateBanDuration(%q) succeeded, want an error", d)
+		}
+	}
+}
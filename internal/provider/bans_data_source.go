@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = bansDataSourceType{}
+var _ tfsdk.DataSource = bansDataSource{}
+
+type bansDataSourceType struct{}
+
+func (t bansDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Lists the banned players and IP addresses from `banlist players` and `banlist ips`, e.g. to audit bans managed outside Terraform.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Always `bans`.",
+				Type:                types.StringType,
+			},
+			"players": {
+				Computed:            true,
+				MarkdownDescription: "Names of the banned players.",
+				Type:                types.ListType{ElemType: types.StringType},
+			},
+			"ips": {
+				Computed:            true,
+				MarkdownDescription: "Banned IP addresses.",
+				Type:                types.ListType{ElemType: types.StringType},
+			},
+		},
+	}, nil
+}
+
+func (t bansDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return bansDataSource{provider: provider}, diags
+}
+
+type bansDataSourceData struct {
+	Id      types.String `tfsdk:"id"`
+	Players []string     `tfsdk:"players"`
+	Ips     []string     `tfsdk:"ips"`
+}
+
+type bansDataSource struct {
+	provider provider
+}
+
+func (d bansDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	client, err := d.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	players, ips, err := client.ListBans(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list bans: %s", err))
+		return
+	}
+
+	data := bansDataSourceData{
+		Id:      types.String{Value: "bans"},
+		Players: players,
+		Ips:     ips,
+	}
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -67,6 +67,11 @@ func (t bedResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diag
 				Optional:            true,
 				Type:                types.BoolType,
 			},
+			"mode": {
+				MarkdownDescription: "Placement mode: `replace` (default) overwrites the existing block, `keep` only places where there is air, `destroy` breaks the existing block first (dropping it as an item). With `keep`, creation fails when the position isn't air, so the resource never owns (or later clears) a block it didn't place.",
+				Optional:            true,
+				Type:                types.StringType,
+			},
 			"id": {
 				Computed:            true,
 				MarkdownDescription: "ID of the bed resource.",
@@ -92,8 +97,9 @@ type bedResourceData struct {
 		Y int `tfsdk:"y"`
 		Z int `tfsdk:"z"`
 	} `tfsdk:"position"`
-	Direction string `tfsdk:"direction"` // north|south|east|west
-	Occupied  *bool  `tfsdk:"occupied"`  // optional
+	Direction string  `tfsdk:"direction"` // north|south|east|west
+	Occupied  *bool   `tfsdk:"occupied"`  // optional
+	Mode      *string `tfsdk:"mode"`      // optional: replace|keep|destroy
 }
 
 type bedResource struct {
@@ -141,9 +147,15 @@ func (r bedResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest
 		occupied = *data.Occupied
 	}
 
+	mode, err := setblockModeFromConfig(data.Mode)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
 	// Place FOOT at start position
 	footMat := fmt.Sprintf(`%s[facing=%s,part=foot,occupied=%t]`, data.Material, data.Direction, occupied)
-	if err := client.CreateBlock(ctx, footMat, data.Position.X, data.Position.Y, data.Position.Z); err != nil {
+	if err := client.CreateBlock(ctx, footMat, data.Position.X, data.Position.Y, data.Position.Z, mode); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to place bed foot: %s", err))
 		return
 	}
@@ -152,7 +164,7 @@ func (r bedResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest
 	headX := data.Position.X + dx
 	headZ := data.Position.Z + dz
 	headMat := fmt.Sprintf(`%s[facing=%s,part=head,occupied=%t]`, data.Material, data.Direction, occupied)
-	if err := client.CreateBlock(ctx, headMat, headX, data.Position.Y, headZ); err != nil {
+	if err := client.CreateBlock(ctx, headMat, headX, data.Position.Y, headZ, mode); err != nil {
 		// Roll back foot on failure
 		_ = client.DeleteBlock(ctx, data.Position.X, data.Position.Y, data.Position.Z)
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to place bed head: %s", err))
@@ -201,9 +213,16 @@ func (r bedResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest
 		occupied = *data.Occupied
 	}
 
+	mode, err := setblockModeFromConfig(data.Mode)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	mode = ownedSetblockMode(mode)
+
 	// Re-place both parts
 	footMat := fmt.Sprintf(`%s[facing=%s,part=foot,occupied=%t]`, data.Material, data.Direction, occupied)
-	if err := client.CreateBlock(ctx, footMat, data.Position.X, data.Position.Y, data.Position.Z); err != nil {
+	if err := client.CreateBlock(ctx, footMat, data.Position.X, data.Position.Y, data.Position.Z, mode); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update bed foot: %s", err))
 		return
 	}
@@ -211,7 +230,7 @@ func (r bedResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest
 	headX := data.Position.X + dx
 	headZ := data.Position.Z + dz
 	headMat := fmt.Sprintf(`%s[facing=%s,part=head,occupied=%t]`, data.Material, data.Direction, occupied)
-	if err := client.CreateBlock(ctx, headMat, headX, data.Position.Y, headZ); err != nil {
+	if err := client.CreateBlock(ctx, headMat, headX, data.Position.Y, headZ, mode); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update bed head: %s", err))
 		return
 	}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = biomeResourceType{}
+var _ tfsdk.Resource = biomeResource{}
+var _ tfsdk.ResourceWithImportState = biomeResource{}
+
+type biomeResourceType struct{}
+
+func (t biomeResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Set the biome of a **cuboid region** (wraps `/fillbiome`, 1.19.3+). The game keeps no record of the previous biome, so destroying this resource only changes the world if `restore_biome` is set.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"biome": {
+				MarkdownDescription: "Namespaced biome ID (e.g. `minecraft:cherry_grove`).",
+				Required:            true,
+				Type:                types.StringType,
+				// Biome can be changed in-place via /fillbiome on Update, so no ForceNew.
+			},
+			"start": {
+				MarkdownDescription: "Inclusive start corner of the cuboid.",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(cornerAttributes()),
+			},
+			"end": {
+				MarkdownDescription: "Inclusive end corner of the cuboid.",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(cornerAttributes()),
+			},
+			"restore_biome": {
+				MarkdownDescription: "Biome to set on destroy (e.g. `minecraft:plains`). If unset, destroy leaves the biome as-is and warns.",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"id": {
+				Computed:            true,
+				Type:                types.StringType,
+				MarkdownDescription: "Terraform ID for this region.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t biomeResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return biomeResource{provider: provider}, diags
+}
+
+type biomeResourceData struct {
+	Id           types.String `tfsdk:"id"`
+	Biome        string       `tfsdk:"biome"`
+	Start        cornerModel  `tfsdk:"start"`
+	End          cornerModel  `tfsdk:"end"`
+	RestoreBiome *string      `tfsdk:"restore_biome"` // optional
+}
+
+type biomeResource struct {
+	provider provider
+}
+
+// Biomes must be namespaced, e.g. minecraft:plains or mypack:ash_fields.
+var biomeIDPattern = regexp.MustCompile(`^[a-z0-9_.-]+:[a-z0-9_./-]+$`)
+
+func validateBiome(attr, biome string) error {
+	if !biomeIDPattern.MatchString(biome) {
+		return fmt.Errorf("%s must be a namespaced biome ID such as minecraft:plains (got %q)", attr, biome)
+	}
+	return nil
+}
+
+func (d biomeResourceData) validate() error {
+	if err := validateBiome("biome", d.Biome); err != nil {
+		return err
+	}
+	if d.RestoreBiome != nil {
+		return validateBiome("restore_biome", *d.RestoreBiome)
+	}
+	return nil
+}
+
+func (r biomeResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data biomeResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.FillBiome(ctx, data.Biome, data.Start.coords(), data.End.coords()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set biome: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf(
+		"%d,%d,%d->%d,%d,%d",
+		data.Start.X, data.Start.Y, data.Start.Z,
+		data.End.X, data.End.Y, data.End.Z,
+	)}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r biomeResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data biomeResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Biomes can't be read back over RCON; keep state as-is.
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r biomeResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data biomeResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.FillBiome(ctx, data.Biome, data.Start.coords(), data.End.coords()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set biome: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r biomeResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data biomeResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RestoreBiome == nil {
+		resp.Diagnostics.AddWarning(
+			"Biome Left In Place",
+			fmt.Sprintf("No restore_biome is set, so the region keeps %s. Set restore_biome to reset it on destroy.", data.Biome),
+		)
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.FillBiome(ctx, *data.RestoreBiome, data.Start.coords(), data.End.coords()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore biome: %s", err))
+		return
+	}
+}
+
+func (r biomeResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
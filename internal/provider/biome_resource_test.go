@@ -0,0 +1,16 @@
+package provider
+
+import "testing"
+
+func TestValidateBiome(t *testing.T) {
+	for _, biome := range []string{"minecraft:plains", "mypack:ash_fields", "mypack:nether/ash"} {
+		if err := validateBiome("biome", biome); err != nil {
+			t.Errorf("validateBiome(%q): %v", biome, err)
+		}
+	}
+	for _, biome := range []string{"plains", "", "minecraft:", "Minecraft:Plains"} {
+		if err := validateBiome("biome", biome); err == nil {
+			t.Errorf("validateBiome(%q) succeeded, want an error", biome)
+		}
+	}
+}
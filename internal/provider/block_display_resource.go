@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = blockDisplayResourceType{}
+var _ tfsdk.Resource = blockDisplayResource{}
+var _ tfsdk.ResourceWithImportState = blockDisplayResource{}
+
+// ---------- Resource Type ----------
+
+type blockDisplayResourceType struct{}
+
+func (t blockDisplayResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	schema := tfsdk.Schema{
+		MarkdownDescription: "Summon a `minecraft:block_display` entity that renders a block state, optionally scaled, offset and turned. Handy for decorative builds.",
+		Attributes: map[string]tfsdk.Attribute{
+			"position": {
+				MarkdownDescription: "Where to summon the display entity.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"block_state": {
+				MarkdownDescription: "Block to render, with optional states (e.g. `minecraft:oak_stairs[facing=east,half=top]`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"scale": {
+				MarkdownDescription: "Scale along each axis. Defaults to `1` on every axis.",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Scale")),
+			},
+			"translation": {
+				MarkdownDescription: "Offset from the entity position, in blocks. Defaults to `0` on every axis.",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Offset")),
+			},
+			"billboard": {
+				MarkdownDescription: "How the display turns to face the viewer: `fixed` (default), `vertical`, `horizontal` or `center`.",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Stable UUID used as the entity's CustomName/tag.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+	for name, attr := range rotationAttributes("display") {
+		schema.Attributes[name] = attr
+	}
+	return schema, nil
+}
+
+func (t blockDisplayResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return blockDisplayResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type vec3 struct {
+	X float64 `tfsdk:"x"`
+	Y float64 `tfsdk:"y"`
+	Z float64 `tfsdk:"z"`
+}
+
+type blockDisplayResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Position struct {
+		X int64 `tfsdk:"x"`
+		Y int64 `tfsdk:"y"`
+		Z int64 `tfsdk:"z"`
+	} `tfsdk:"position"`
+	BlockState  string          `tfsdk:"block_state"`
+	Scale       *vec3           `tfsdk:"scale"`       // optional
+	Translation *vec3           `tfsdk:"translation"` // optional
+	Billboard   *string         `tfsdk:"billboard"`   // optional: fixed|vertical|horizontal|center
+	Rotation    *entityRotation `tfsdk:"rotation"`    // optional
+	LookAt      *vec3           `tfsdk:"look_at"`     // optional
+}
+
+// transform resolves the optional attributes into the values sent to the server.
+func (d blockDisplayResourceData) transform() (scale, translation [3]float64, billboard string, err error) {
+	scale = [3]float64{1, 1, 1}
+	if d.Scale != nil {
+		scale = [3]float64{d.Scale.X, d.Scale.Y, d.Scale.Z}
+	}
+	if d.Translation != nil {
+		translation = [3]float64{d.Translation.X, d.Translation.Y, d.Translation.Z}
+	}
+
+	billboard = "fixed"
+	if d.Billboard != nil {
+		billboard = *d.Billboard
+	}
+	switch billboard {
+	case "fixed", "vertical", "horizontal", "center":
+	default:
+		err = fmt.Errorf("billboard must be one of fixed|vertical|horizontal|center (got %q)", billboard)
+	}
+	return scale, translation, billboard, err
+}
+
+// ---------- Resource Impl ----------
+
+type blockDisplayResource struct {
+	provider provider
+}
+
+func (r blockDisplayResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data blockDisplayResourceData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scale, translation, billboard, err := data.transform()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	from := [3]float64{float64(data.Position.X), float64(data.Position.Y), float64(data.Position.Z)}
+	rotation, err := resolveRotation(from, data.Rotation, data.LookAt)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	id := uuid.NewString()
+	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+
+	if err := client.CreateBlockDisplay(ctx, pos, id, data.BlockState, scale, translation, billboard, rotation); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon block display: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: id}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r blockDisplayResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data blockDisplayResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r blockDisplayResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Position and block_state are ForceNew; transformation and billboard are merged in place.
+	var data blockDisplayResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scale, translation, billboard, err := data.transform()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.UpdateBlockDisplay(ctx, data.Id.Value, scale, translation, billboard); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update block display: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r blockDisplayResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data blockDisplayResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("minecraft:block_display %s", data.Id.Value)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+	if err := client.DeleteEntity(ctx, "minecraft:block_display", pos, data.Id.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete block display: %s", err))
+		return
+	}
+}
+
+func (r blockDisplayResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// Import by UUID (id). Config must specify matching position/block_state.
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
+
+// vec3Attributes returns x/y/z float attributes for nested vector blocks.
+func vec3Attributes(what string) map[string]tfsdk.Attribute {
+	attrs := map[string]tfsdk.Attribute{}
+	for _, axis := range []string{"x", "y", "z"} {
+		attrs[axis] = tfsdk.Attribute{
+			MarkdownDescription: fmt.Sprintf("%s along the %s axis.", what, axis),
+			Type:                types.Float64Type,
+			Required:            true,
+		}
+	}
+	return attrs
+}
+
+// entityRotation is a facing in degrees, as stored in an entity's Rotation tag.
+type entityRotation struct {
+	Yaw   float64 `tfsdk:"yaw"`
+	Pitch float64 `tfsdk:"pitch"`
+}
+
+// rotationAttributes returns the schema shared by the `rotation` and `look_at`
+// attributes of entities that can be aimed.
+func rotationAttributes(what string) map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"rotation": {
+			MarkdownDescription: fmt.Sprintf("Facing of the %s in degrees. Conflicts with `look_at`.", what),
+			Optional:            true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"yaw": {
+					MarkdownDescription: "Horizontal angle: `0` faces south (+Z), `90` west, `180` north, `-90` east.",
+					Type:                types.Float64Type,
+					Required:            true,
+				},
+				"pitch": {
+					MarkdownDescription: "Vertical angle from `-90` (straight up) to `90` (straight down).",
+					Type:                types.Float64Type,
+					Required:            true,
+				},
+			}),
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+		"look_at": {
+			MarkdownDescription: fmt.Sprintf("Point the %s faces, e.g. the middle of a walkway; the rotation is worked out when it is created. Conflicts with `rotation`.", what),
+			Optional:            true,
+			Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Coordinate")),
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// resolveRotation turns the optional rotation or look_at into the Rotation
+// sent to the server; nil means neither is set.
+func resolveRotation(from [3]float64, rotation *entityRotation, lookAt *vec3) (*[2]float64, error) {
+	switch {
+	case rotation != nil && lookAt != nil:
+		return nil, fmt.Errorf("set only one of rotation or look_at")
+	case rotation != nil:
+		if rotation.Pitch < -90 || rotation.Pitch > 90 {
+			return nil, fmt.Errorf("rotation.pitch must be between -90 and 90 (got %g)", rotation.Pitch)
+		}
+		return &[2]float64{rotation.Yaw, rotation.Pitch}, nil
+	case lookAt != nil:
+		to := [3]float64{lookAt.X, lookAt.Y, lookAt.Z}
+		if to == from {
+			return nil, fmt.Errorf("look_at must differ from the position")
+		}
+		yaw, pitch := rotationToFace(from, to)
+		return &[2]float64{yaw, pitch}, nil
+	}
+	return nil, nil
+}
+
+// rotationToFace returns the yaw and pitch, in Minecraft's convention, for an
+// entity at from to face to. Yaw 0 faces +Z and grows clockwise seen from
+// above (90 is -X); positive pitch looks down.
+func rotationToFace(from, to [3]float64) (yaw, pitch float64) {
+	dx, dy, dz := to[0]-from[0], to[1]-from[1], to[2]-from[2]
+	yaw = -math.Atan2(dx, dz) * 180 / math.Pi
+	pitch = -math.Atan2(dy, math.Hypot(dx, dz)) * 180 / math.Pi
+	// Turn -0 into 0 so the command doesn't read "-0f".
+	return yaw + 0, pitch + 0
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRotationToFace(t *testing.T) {
+	tests := []struct {
+		name       string
+		to         [3]float64
+		yaw, pitch float64
+	}{
+		{name: "south", to: [3]float64{0, 0, 5}, yaw: 0},
+		{name: "west", to: [3]float64{-5, 0, 0}, yaw: 90},
+		{name: "north", to: [3]float64{0, 0, -5}, yaw: 180},
+		{name: "east", to: [3]float64{5, 0, 0}, yaw: -90},
+		{name: "south-west", to: [3]float64{-3, 0, 3}, yaw: 45},
+		{name: "north-east", to: [3]float64{3, 0, -3}, yaw: -135},
+		{name: "up", to: [3]float64{0, 4, 4}, yaw: 0, pitch: -45},
+		{name: "straight down", to: [3]float64{0, -2, 0}, yaw: 0, pitch: 90},
+	}
+	for _, tt := range tests {
+		yaw, pitch := rotationToFace([3]float64{}, tt.to)
+		if !sameAngle(yaw, tt.yaw) || math.Abs(pitch-tt.pitch) > 1e-9 {
+			t.Errorf("%s: rotationToFace = (%g, %g), want (%g, %g)", tt.name, yaw, pitch, tt.yaw, tt.pitch)
+		}
+		if math.Signbit(yaw) && yaw == 0 || math.Signbit(pitch) && pitch == 0 {
+			t.Errorf("%s: rotationToFace = (%g, %g) has a negative zero", tt.name, yaw, pitch)
+		}
+	}
+}
+
+func TestResolveRotation(t *testing.T) {
+	from := [3]float64{1, 64, 1}
+	if _, err := resolveRotation(from, &entityRotation{}, &vec3{X: 2, Y: 64, Z: 1}); err == nil {
+		t.Error("rotation and look_at together: want an error")
+	}
+	if _, err := resolveRotation(from, &entityRotation{Pitch: 91}, nil); err == nil {
+		t.Error("pitch 91: want an error")
+	}
+	if _, err := resolveRotation(from, nil, &vec3{X: 1, Y: 64, Z: 1}); err == nil {
+		t.Error("look_at at the position: want an error")
+	}
+	if got, err := resolveRotation(from, nil, nil); got != nil || err != nil {
+		t.Errorf("neither set = (%v, %v), want (nil, nil)", got, err)
+	}
+	got, err := resolveRotation(from, nil, &vec3{X: 1, Y: 64, Z: -9})
+	if err != nil || !sameAngle(got[0], 180) || got[1] != 0 {
+		t.Errorf("look_at north = (%v, %v), want [180 0]", got, err)
+	}
+}
+
+// sameAngle reports whether a and b are the same direction; yaw 180 and -180
+// both face north.
+func sameAngle(a, b float64) bool {
+	d := math.Mod(a-b, 360)
+	return math.Abs(d) < 1e-9 || math.Abs(math.Abs(d)-360) < 1e-9
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = blockItemSlotResourceType{}
+var _ tfsdk.Resource = blockItemSlotResource{}
+
+// ---------- Resource Type ----------
+
+type blockItemSlotResourceType struct{}
+
+func (t blockItemSlotResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "One slot of a container block (chest, barrel, hopper, ...), filled with `item replace block`. Other slots are left alone, so several of these can share a container. Destroying it empties the slot.",
+		Attributes: map[string]tfsdk.Attribute{
+			"position": {
+				MarkdownDescription: "Position of the container block.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"container": {
+				MarkdownDescription: "Container block at `position`, e.g. `minecraft:chest`. Used to check `slot`; the block itself must already be placed.",
+				Required:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(containerBlocks()...),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"slot": {
+				MarkdownDescription: "Slot index (`container.<slot>`), from 0 to one less than the container's size: 27 for chests, barrels and shulker boxes (per half of a double chest), 9 for dispensers, droppers and crafters, 5 for hoppers and brewing stands, 3 for furnaces.",
+				Required:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"item": {
+				MarkdownDescription: "Item ID, e.g. `minecraft:diamond`. Changing it replaces the slot's contents in place.",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"count": {
+				MarkdownDescription: fmt.Sprintf("Stack size, 1 to %d. Defaults to `1`.", minecraft.MaxItemStack),
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "The container position and slot, `x,y,z/container.<slot>`.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t blockItemSlotResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return blockItemSlotResource{provider: p}, diags
+}
+
+// containerBlocks lists the container IDs in a stable order for validation messages.
+func containerBlocks() []string {
+	blocks := make([]string, 0, len(minecraft.ContainerSlots))
+	for block := range minecraft.ContainerSlots {
+		blocks = append(blocks, block)
+	}
+	sort.Strings(blocks)
+	return blocks
+}
+
+// ---------- Resource Data ----------
+
+type blockItemSlotResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Position struct {
+		X int `tfsdk:"x"`
+		Y int `tfsdk:"y"`
+		Z int `tfsdk:"z"`
+	} `tfsdk:"position"`
+	Container string      `tfsdk:"container"`
+	Slot      int64       `tfsdk:"slot"`
+	Item      string      `tfsdk:"item"`
+	Count     types.Int64 `tfsdk:"count"`
+}
+
+// applyDefaults fills unset optional values and validates the result.
+func (d *blockItemSlotResourceData) applyDefaults() error {
+	if d.Count.Null || d.Count.Unknown {
+		d.Count = types.Int64{Value: 1}
+	}
+	size, ok := minecraft.ContainerSlots[d.Container]
+	if !ok {
+		return fmt.Errorf("container %q is not a known container block", d.Container)
+	}
+	if d.Slot < 0 || d.Slot >= int64(size) {
+		return fmt.Errorf("slot must be between 0 and %d for %s (got %d)", size-1, d.Container, d.Slot)
+	}
+	if !resourceIDPattern.MatchString(d.Item) {
+		return fmt.Errorf("item must be an item ID such as minecraft:diamond (got %q)", d.Item)
+	}
+	if d.Count.Value < 1 || d.Count.Value > minecraft.MaxItemStack {
+		return fmt.Errorf("count must be between 1 and %d (got %d)", minecraft.MaxItemStack, d.Count.Value)
+	}
+	d.Id = types.String{Value: fmt.Sprintf("%d,%d,%d/container.%d", d.Position.X, d.Position.Y, d.Position.Z, d.Slot)}
+	return nil
+}
+
+// ---------- Resource Impl ----------
+
+type blockItemSlotResource struct {
+	provider provider
+}
+
+func (r blockItemSlotResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data blockItemSlotResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.replace(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r blockItemSlotResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data blockItemSlotResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r blockItemSlotResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Only item and count change in place; the slot's old stack is simply replaced.
+	var data blockItemSlotResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.replace(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r blockItemSlotResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data blockItemSlotResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("%s in %s", data.Item, data.Id.Value)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.ReplaceBlockItem(ctx, data.Position.X, data.Position.Y, data.Position.Z, int(data.Slot), "minecraft:air", 1); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to empty slot %s: %s", data.Id.Value, err))
+		return
+	}
+}
+
+// replace validates data and puts its item stack into the slot.
+func (r blockItemSlotResource) replace(ctx context.Context, data *blockItemSlotResourceData, diags *diag.Diagnostics) {
+	if err := data.applyDefaults(); err != nil {
+		diags.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.ReplaceBlockItem(ctx, data.Position.X, data.Position.Y, data.Position.Z, int(data.Slot), data.Item, int(data.Count.Value)); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to fill slot %s: %s", data.Id.Value, err))
+	}
+}
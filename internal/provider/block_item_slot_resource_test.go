@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBlockItemSlotCommands(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := blockItemSlotResourceType{}.GetSchema(ctx)
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	attrs := map[string]tftypes.Value{
+		"position":  xyzValue(ctx, schema, "position", 3, 64, -7),
+		"container": tftypes.NewValue(tftypes.String, "minecraft:chest"),
+		"slot":      tftypes.NewValue(tftypes.Number, 26),
+		"item":      tftypes.NewValue(tftypes.String, "minecraft:diamond"),
+	}
+	state, diags := createResource(t, p, blockItemSlotResourceType{}, attrs)
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	if got, want := stateString(t, state, "id"), "3,64,-7/container.26"; got != want {
+		t.Errorf("id = %q, want %q", got, want)
+	}
+
+	attrs["item"] = tftypes.NewValue(tftypes.String, "minecraft:emerald")
+	attrs["count"] = tftypes.NewValue(tftypes.Number, 16)
+	state, diags = updateResource(t, p, blockItemSlotResourceType{}, state, attrs)
+	if diags.HasError() {
+		t.Fatalf("Update: %v", diags)
+	}
+	if diags := deleteResource(t, p, blockItemSlotResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+
+	want := []string{
+		"item replace block 3 64 -7 container.26 with minecraft:diamond 1",
+		"item replace block 3 64 -7 container.26 with minecraft:emerald 16",
+		"item replace block 3 64 -7 container.26 with minecraft:air 1",
+	}
+	if got := server.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q\nwant %q", got, want)
+	}
+}
+
+func TestBlockItemSlotBounds(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := blockItemSlotResourceType{}.GetSchema(ctx)
+	for _, tt := range []struct {
+		container string
+		slot      int
+		ok        bool
+	}{
+		{container: "minecraft:chest", slot: 0, ok: true},
+		{container: "minecraft:chest", slot: 26, ok: true},
+		{container: "minecraft:chest", slot: 27},
+		{container: "minecraft:hopper", slot: 4, ok: true},
+		{container: "minecraft:hopper", slot: 5},
+		{container: "minecraft:furnace", slot: 3},
+		{container: "minecraft:dispenser", slot: -1},
+	} {
+		server := newFakeServer(t, nil)
+		p := configureProvider(t, server.address, nil)
+		_, diags := createResource(t, p, blockItemSlotResourceType{}, map[string]tftypes.Value{
+			"position":  xyzValue(ctx, schema, "position", 0, 64, 0),
+			"container": tftypes.NewValue(tftypes.String, tt.container),
+			"slot":      tftypes.NewValue(tftypes.Number, tt.slot),
+			"item":      tftypes.NewValue(tftypes.String, "minecraft:stick"),
+		})
+		if diags.HasError() == tt.ok {
+			t.Errorf("%s slot %d: diags = %v, want ok %t", tt.container, tt.slot, diags, tt.ok)
+		}
+		if sent := server.sent(); !tt.ok && len(sent) != 0 {
+			t.Errorf("%s slot %d: sent %q for a rejected slot", tt.container, tt.slot, sent)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -57,6 +58,22 @@ func (t blockResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Di
 					},
 				}),
 			},
+			"restore_previous_on_destroy": {
+				MarkdownDescription: "If true, the block that was at `position` before is cloned to the provider's `staging_origin` on create and cloned back on destroy, states and contents included, instead of leaving air. RCON can't read a block's material or states, so the copy is kept in the world rather than in state. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(), // the snapshot is taken at create time
+				},
+			},
+			"snapshot_origin": {
+				Computed:            true,
+				MarkdownDescription: "Where the previous block is stored (`x y z`) when `restore_previous_on_destroy` is set.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
 			"id": {
 				Computed:            true,
 				MarkdownDescription: "ID of the block",
@@ -85,6 +102,8 @@ type blockResourceData struct {
 		Y int `tfsdk:"y"`
 		Z int `tfsdk:"z"`
 	} `tfsdk:"position"`
+	RestorePrevious types.Bool   `tfsdk:"restore_previous_on_destroy"`
+	SnapshotOrigin  types.String `tfsdk:"snapshot_origin"`
 }
 
 type blockResource struct {
@@ -101,24 +120,74 @@ func (r blockResource) Create(ctx context.Context, req tfsdk.CreateResourceReque
 		return
 	}
 
+	if data.RestorePrevious.Value && r.provider.stagingOrigin == nil {
+		resp.Diagnostics.AddError("Validation Error", "restore_previous_on_destroy requires `staging_origin` to be set on the provider.")
+		return
+	}
+
+	r.provider.warnSpawnProtection(ctx, &resp.Diagnostics, fmt.Sprintf("Block at %d %d %d", data.Position.X, data.Position.Y, data.Position.Z),
+		data.Position.X, data.Position.Z, data.Position.X, data.Position.Z)
+
 	client, err := r.provider.GetClient(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
 		return
 	}
 
-	err = client.CreateBlock(ctx, data.Material, data.Position.X, data.Position.Y, data.Position.Z)
+	data.Id = types.String{Value: fmt.Sprintf("block-%d-%d-%d", data.Position.X, data.Position.Y, data.Position.Z)}
+
+	data.SnapshotOrigin = types.String{Null: true}
+	if data.RestorePrevious.Value {
+		origin, ok := r.snapshot(ctx, client, data, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		data.SnapshotOrigin = types.String{Value: formatCoords(origin)}
+	}
+
+	err = r.writeBlock(ctx, client, data)
 	if err != nil {
+		// A failed setblock changes nothing, so the snapshot isn't needed.
+		if origin, ok := parseCoords(data.SnapshotOrigin.Value); ok {
+			r.provider.releaseSnapshot(ctx, client, origin, &resp.Diagnostics)
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create block, got error: %s", err))
 		return
 	}
 
-	data.Id = types.String{Value: fmt.Sprintf("block-%d-%d-%d", data.Position.X, data.Position.Y, data.Position.Z)}
-
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
+// snapshot clones the block at the resource's position to a free staging slot
+// and returns the slot. It reports false if that failed.
+func (r blockResource) snapshot(ctx context.Context, client *minecraft.Client, data blockResourceData, diags *diag.Diagnostics) ([3]int, bool) {
+	x, y, z := data.Position.X, data.Position.Y, data.Position.Z
+	origin, err := r.provider.fillSnapshots.allocate(data.Id.Value, *r.provider.stagingOrigin, [3]int{1, 1, 1}, r.provider.buildLimits(),
+		func(corner [3]int) (bool, error) { return client.ClaimStagingSlot(ctx, corner) })
+	if err != nil {
+		diags.AddError("Snapshot Error", err.Error())
+		return origin, false
+	}
+	if err := client.CloneRegion(ctx, x, y, z, x, y, z, origin[0], origin[1], origin[2]); err != nil {
+		r.provider.releaseSnapshot(ctx, client, origin, diags)
+		diags.AddError("Client Error", fmt.Sprintf("Unable to snapshot block to %s: %s", formatCoords(origin), err))
+		return origin, false
+	}
+	return origin, true
+}
+
+// writeBlock places the block, skipping the setblock when idempotent_writes is
+// on and the block already matches. A failed check falls back to writing.
+func (r blockResource) writeBlock(ctx context.Context, client *minecraft.Client, data blockResourceData) error {
+	if r.provider.idempotentWrites {
+		if ok, err := client.BlockMatches(ctx, data.Material, data.Position.X, data.Position.Y, data.Position.Z); err == nil && ok {
+			return nil
+		}
+	}
+	return client.CreateBlock(ctx, data.Material, data.Position.X, data.Position.Y, data.Position.Z, "")
+}
+
 // It is impossible to read a block without an entity, so we do nothing for now.
 func (r blockResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
 	var data blockResourceData
@@ -150,7 +219,7 @@ func (r blockResource) Update(ctx context.Context, req tfsdk.UpdateResourceReque
 		return
 	}
 
-	err = client.CreateBlock(ctx, data.Material, data.Position.X, data.Position.Y, data.Position.Z)
+	err = r.writeBlock(ctx, client, data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update block, got error: %s", err))
 		return
@@ -170,12 +239,39 @@ func (r blockResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReque
 		return
 	}
 
+	x, y, z := data.Position.X, data.Position.Y, data.Position.Z
+	origin, snapshot := parseCoords(data.SnapshotOrigin.Value)
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("block at %d,%d,%d", x, y, z)) {
+		// The staging copy stays too, but its slot is given up for reuse.
+		if snapshot {
+			if client, err := r.provider.GetClient(ctx); err != nil {
+				resp.Diagnostics.AddWarning("Snapshot Warning", fmt.Sprintf("The snapshot slot at %s could not be released: %s", data.SnapshotOrigin.Value, err))
+			} else {
+				r.provider.releaseSnapshot(ctx, client, origin, &resp.Diagnostics)
+			}
+		}
+		return
+	}
+
 	client, err := r.provider.GetClient(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
 		return
 	}
 
+	if snapshot {
+		// Clone the previous block back, then clear the staging copy.
+		if err := client.CloneRegion(ctx, origin[0], origin[1], origin[2], origin[0], origin[1], origin[2], x, y, z); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore block from snapshot at %s: %s", data.SnapshotOrigin.Value, err))
+			return
+		}
+		if err := client.DeleteBlock(ctx, origin[0], origin[1], origin[2]); err != nil {
+			resp.Diagnostics.AddWarning("Snapshot Warning", fmt.Sprintf("Block restored, but the staging copy at %s could not be cleared: %s", data.SnapshotOrigin.Value, err))
+		}
+		r.provider.releaseSnapshot(ctx, client, origin, &resp.Diagnostics)
+		return
+	}
+
 	err = client.DeleteBlock(ctx, data.Position.X, data.Position.Y, data.Position.Z)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete block, got error: %s", err))
@@ -186,3 +282,27 @@ func (r blockResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReque
 func (r blockResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
 	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
 }
+
+// setblockModeFromConfig validates the optional `mode` attribute shared by the
+// block-placing resources. A nil mode means the setblock default (`replace`).
+func setblockModeFromConfig(mode *string) (string, error) {
+	if mode == nil {
+		return "replace", nil
+	}
+	switch *mode {
+	case "replace", "keep", "destroy":
+		return *mode, nil
+	default:
+		return "", fmt.Errorf("mode must be one of replace|keep|destroy (got %q)", *mode)
+	}
+}
+
+// ownedSetblockMode returns the mode for re-placing blocks the resource
+// already placed. `keep` would refuse them, since the position now holds the
+// resource's own block, so it becomes `replace`.
+func ownedSetblockMode(mode string) string {
+	if mode == "keep" {
+		return "replace"
+	}
+	return mode
+}
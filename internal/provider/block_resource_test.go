@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// blockAttrs configures a block of material at pos.
+func blockAttrs(t *testing.T, material string, pos [3]int) map[string]tftypes.Value {
+	t.Helper()
+	schema, _ := blockResourceType{}.GetSchema(context.Background())
+	return map[string]tftypes.Value{
+		"material": tftypes.NewValue(tftypes.String, material),
+		"position": xyzValue(context.Background(), schema, "position", pos[0], pos[1], pos[2]),
+	}
+}
+
+func TestBlockIdempotentWrites(t *testing.T) {
+	const stairs = "minecraft:oak_stairs[facing=south,half=bottom,shape=straight,waterlogged=false]"
+	tests := []struct {
+		name      string
+		matches   bool
+		wantWrite bool
+	}{
+		{name: "matching block is skipped", matches: true},
+		{name: "differing block is written", wantWrite: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeServer(t, func(command string) string {
+				if !strings.HasPrefix(command, "execute if block ") {
+					return ""
+				}
+				if tt.matches {
+					return "Test passed"
+				}
+				return "Test failed"
+			})
+			p := configureProvider(t, server.address, map[string]tftypes.Value{
+				"idempotent_writes": tftypes.NewValue(tftypes.Bool, true),
+			})
+
+			if _, diags := createResource(t, p, blockResourceType{}, blockAttrs(t, stairs, [3]int{5, 70, 5})); diags.HasError() {
+				t.Fatalf("Create: %v", diags)
+			}
+			sent := server.sent()
+			if !containsCommand(sent, "execute if block 5 70 5 "+stairs) {
+				t.Errorf("block wasn't tested; got %q", sent)
+			}
+			if got := containsCommand(sent, "setblock 5 70 5 "+stairs+" replace"); got != tt.wantWrite {
+				t.Errorf("wrote the block: %t, want %t; got %q", got, tt.wantWrite, sent)
+			}
+		})
+	}
+}
+
+func TestBlockWritesWithoutIdempotentWrites(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	if _, diags := createResource(t, p, blockResourceType{}, blockAttrs(t, "minecraft:stone", [3]int{1, 2, 3})); diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	if sent := server.sent(); len(sent) != 1 || sent[0] != "setblock 1 2 3 minecraft:stone replace" {
+		t.Errorf("sent %q, want only the setblock", sent)
+	}
+}
+
+func TestBlockRestoresPreviousBlock(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, unclaimedStaging)
+	providerSchema, _ := New("test")().GetSchema(ctx)
+	p := configureProvider(t, server.address, map[string]tftypes.Value{
+		"staging_origin": xyzValue(ctx, providerSchema, "staging_origin", 100000, 0, 100000),
+	})
+
+	attrs := blockAttrs(t, "minecraft:glass", [3]int{1, 64, 3})
+	attrs["restore_previous_on_destroy"] = tftypes.NewValue(tftypes.Bool, true)
+	state, diags := createResource(t, p, blockResourceType{}, attrs)
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	origin, ok := parseCoords(stateString(t, state, "snapshot_origin"))
+	if !ok {
+		t.Fatalf("snapshot_origin = %q", stateString(t, state, "snapshot_origin"))
+	}
+	if diags := deleteResource(t, p, blockResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+
+	o := fmt.Sprintf("%d %d %d", origin[0], origin[1], origin[2])
+	claim := fmt.Sprintf("@e[type=minecraft:marker,tag=tf_staging_claim,x=%d,y=%d,z=%d,dx=0,dy=0,dz=0]", origin[0], origin[1], origin[2])
+	want := []string{
+		"execute if entity " + claim,
+		fmt.Sprintf(`summon minecraft:marker %s {Tags:["tf_staging_claim"]}`, o),
+		"clone 1 64 3 1 64 3 " + o + " replace",
+		"setblock 1 64 3 minecraft:glass replace",
+		"clone " + o + " " + o + " 1 64 3 replace",
+		"setblock " + o + " minecraft:air replace",
+		"kill " + claim,
+	}
+	if got := server.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q\nwant %q", got, want)
+	}
+}
+
+func TestBlockRestorePreviousNeedsStagingOrigin(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	attrs := blockAttrs(t, "minecraft:glass", [3]int{1, 64, 3})
+	attrs["restore_previous_on_destroy"] = tftypes.NewValue(tftypes.Bool, true)
+	if _, diags := createResource(t, p, blockResourceType{}, attrs); !diags.HasError() {
+		t.Error("Create succeeded without staging_origin")
+	}
+	if sent := server.sent(); len(sent) != 0 {
+		t.Errorf("sent %q", sent)
+	}
+}
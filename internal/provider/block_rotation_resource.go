@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = blockRotationResourceType{}
+var _ tfsdk.Resource = blockRotationResource{}
+
+type blockRotationResourceType struct{}
+
+func (t blockRotationResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Rotate an existing directional block (stairs, furnace, observer, ...) clockwise in quarter turns, without redeclaring it. Destroying the resource turns it back. Other block states are reset to their defaults when rewritten.",
+		Attributes: map[string]tfsdk.Attribute{
+			"material": {
+				MarkdownDescription: "The block already at the position (e.g. `minecraft:furnace`). Needed because RCON can't report an arbitrary block's state.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"position": {
+				MarkdownDescription: "The position of the block to rotate.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"turns": {
+				MarkdownDescription: "Number of clockwise quarter turns (1-3). Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"facing": {
+				Computed:            true,
+				MarkdownDescription: "The block's facing after rotation.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "ID of the rotation",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t blockRotationResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return blockRotationResource{provider: provider}, diags
+}
+
+type blockRotationResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Material string       `tfsdk:"material"`
+	Position struct {
+		X int `tfsdk:"x"`
+		Y int `tfsdk:"y"`
+		Z int `tfsdk:"z"`
+	} `tfsdk:"position"`
+	Turns  types.Int64  `tfsdk:"turns"`
+	Facing types.String `tfsdk:"facing"`
+}
+
+type blockRotationResource struct {
+	provider provider
+}
+
+func (r blockRotationResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data blockRotationResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Turns.Null || data.Turns.Unknown {
+		data.Turns = types.Int64{Value: 1}
+	}
+	if data.Turns.Value < 1 || data.Turns.Value > 3 {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("turns must be between 1 and 3 (got %d)", data.Turns.Value))
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	var facing string
+	for i := int64(0); i < data.Turns.Value; i++ {
+		facing, err = client.RotateBlock(ctx, data.Material, data.Position.X, data.Position.Y, data.Position.Z)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate block, got error: %s", err))
+			return
+		}
+	}
+
+	data.Facing = types.String{Value: facing}
+	data.Id = types.String{Value: fmt.Sprintf("rotation-%d-%d-%d", data.Position.X, data.Position.Y, data.Position.Z)}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r blockRotationResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data blockRotationResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r blockRotationResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// All inputs are ForceNew; nothing to update in place.
+	var data blockRotationResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r blockRotationResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data blockRotationResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	// Finish the full circle to get back to the original facing (best effort).
+	for i := data.Turns.Value; i < 4; i++ {
+		if _, err := client.RotateBlock(ctx, data.Material, data.Position.X, data.Position.Y, data.Position.Z); err != nil {
+			resp.Diagnostics.AddWarning("Restore Warning", fmt.Sprintf("Unable to rotate block back to its original facing: %s", err))
+			return
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = captureResourceType{}
+var _ tfsdk.Resource = captureResource{}
+
+// ---------- Resource Type ----------
+
+type captureResourceType struct{}
+
+func (t captureResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Trigger that runs a command with `execute store result score` when created and exposes its numeric result as `value`, e.g. to output how many entities match a selector. The result is kept in the `target` score of `objective`, which must already exist. Change `triggers` to capture again; destroying it does nothing in game.",
+		Attributes: map[string]tfsdk.Attribute{
+			"command": {
+				MarkdownDescription: "Command whose result is stored, without the leading slash (e.g. `execute if entity @e[type=minecraft:cow]`). A command that fails stores `0`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"objective": {
+				MarkdownDescription: "Existing objective the result is stored in, e.g. from `minecraft_scoreboard_objective` with criterion `dummy`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"target": {
+				MarkdownDescription: "Score holder the result is stored for. Defaults to the fake player `#terraform`, which the sidebar doesn't show.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, capture again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"value": {
+				Computed:            true,
+				MarkdownDescription: "The command's result when it was captured.",
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this capture.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t captureResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return captureResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type captureResourceData struct {
+	Id        types.String `tfsdk:"id"`
+	Command   string       `tfsdk:"command"`
+	Objective string       `tfsdk:"objective"`
+	Target    types.String `tfsdk:"target"`
+	Triggers  types.Map    `tfsdk:"triggers"`
+	Value     types.Int64  `tfsdk:"value"`
+}
+
+func (d *captureResourceData) applyDefaults() {
+	if d.Target.Null || d.Target.Unknown {
+		d.Target = types.String{Value: "#terraform"}
+	}
+}
+
+func (d captureResourceData) validate() error {
+	if strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(d.Command), "/")) == "" {
+		return fmt.Errorf("command must not be empty")
+	}
+	if d.Objective == "" || strings.ContainsAny(d.Objective, " \t\n") {
+		return fmt.Errorf("objective must be an objective name without spaces (got %q)", d.Objective)
+	}
+	if strings.TrimSpace(d.Target.Value) == "" {
+		return fmt.Errorf("target must not be empty")
+	}
+	return nil
+}
+
+// ---------- Resource Impl ----------
+
+type captureResource struct {
+	provider provider
+}
+
+func (r captureResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data captureResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.applyDefaults()
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	value, err := client.ExecuteStoreResult(ctx, strings.TrimSpace(data.Target.Value), data.Objective, data.Command)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to capture command result: %s", err))
+		return
+	}
+
+	data.Value = types.Int64{Value: int64(value)}
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r captureResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// value is a snapshot from creation; keep state as-is.
+	var data captureResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r captureResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data captureResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.applyDefaults()
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r captureResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The stored score is left for datapacks that read it; just drop it from state.
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCaptureStoresValue(t *testing.T) {
+	server := newFakeServer(t, func(command string) string {
+		if strings.HasPrefix(command, "scoreboard players get ") {
+			return "#terraform has 7 [counts]"
+		}
+		return ""
+	})
+	p := configureProvider(t, server.address, nil)
+	state, diags := createResource(t, p, captureResourceType{}, map[string]tftypes.Value{
+		"command":   tftypes.NewValue(tftypes.String, "execute if entity @e[type=minecraft:cow]"),
+		"objective": tftypes.NewValue(tftypes.String, "counts"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	if !containsCommand(server.sent(), "execute store result score #terraform counts run execute if entity @e[type=minecraft:cow]") {
+		t.Errorf("sent %q, want the store into #terraform", server.sent())
+	}
+	var value types.Int64
+	state.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("value"), &value)
+	if value.Value != 7 {
+		t.Errorf("value = %v, want 7", value)
+	}
+}
+
+func TestCaptureValidation(t *testing.T) {
+	for _, d := range []captureResourceData{
+		{Command: " / ", Objective: "counts", Target: types.String{Value: "#t"}},
+		{Command: "time query day", Objective: "my counts", Target: types.String{Value: "#t"}},
+		{Command: "time query day", Objective: "counts", Target: types.String{Value: " "}},
+	} {
+		if err := d.validate(); err == nil {
+			t.Errorf("%+v accepted", d)
+		}
+	}
+}
@@ -53,6 +53,11 @@ func (t chestResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Di
 				Optional:            true,
 				Type:                types.BoolType,
 			},
+			"mode": {
+				MarkdownDescription: "Placement mode: `replace` (default) overwrites the existing block, `keep` only places where there is air, `destroy` breaks the existing block first (dropping it as an item). With `keep`, creation fails when the position isn't air, so the resource never owns (or later clears) a block it didn't place.",
+				Optional:            true,
+				Type:                types.StringType,
+			},
 			"id": {
 				Computed:            true,
 				MarkdownDescription: "ID of the chest resource.",
@@ -75,6 +80,7 @@ type chestResourceData struct {
 	Size        string       `tfsdk:"size"`
 	Trapped     *bool        `tfsdk:"trapped"`
 	Waterlogged *bool        `tfsdk:"waterlogged"`
+	Mode        *string      `tfsdk:"mode"` // optional: replace|keep|destroy
 	Position    struct {
 		X int `tfsdk:"x"`
 		Y int `tfsdk:"y"`
@@ -115,10 +121,16 @@ func (r chestResource) Create(ctx context.Context, req tfsdk.CreateResourceReque
 		material = "minecraft:trapped_chest"
 	}
 
+	mode, err := setblockModeFromConfig(data.Mode)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
 	switch data.Size {
 	case "single":
 		block := fmt.Sprintf(`%s[type=single,waterlogged=%t]`, material, waterlogged)
-		err = client.CreateBlock(ctx, block, data.Position.X, data.Position.Y, data.Position.Z)
+		err = client.CreateBlock(ctx, block, data.Position.X, data.Position.Y, data.Position.Z, mode)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to place single chest: %s", err))
 			return
@@ -126,12 +138,12 @@ func (r chestResource) Create(ctx context.Context, req tfsdk.CreateResourceReque
 	case "double":
 		blockLeft := fmt.Sprintf(`%s[type=left,waterlogged=%t]`, material, waterlogged)
 		blockRight := fmt.Sprintf(`%s[type=right,waterlogged=%t]`, material, waterlogged)
-		err = client.CreateBlock(ctx, blockLeft, data.Position.X, data.Position.Y, data.Position.Z)
+		err = client.CreateBlock(ctx, blockLeft, data.Position.X, data.Position.Y, data.Position.Z, mode)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to place left half of double chest: %s", err))
 			return
 		}
-		err = client.CreateBlock(ctx, blockRight, data.Position.X+1, data.Position.Y, data.Position.Z)
+		err = client.CreateBlock(ctx, blockRight, data.Position.X+1, data.Position.Y, data.Position.Z, mode)
 		if err != nil {
 			_ = client.DeleteBlock(ctx, data.Position.X, data.Position.Y, data.Position.Z)
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to place right half of double chest: %s", err))
@@ -187,10 +199,17 @@ func (r chestResource) Update(ctx context.Context, req tfsdk.UpdateResourceReque
 		material = "minecraft:trapped_chest"
 	}
 
+	mode, err := setblockModeFromConfig(data.Mode)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	mode = ownedSetblockMode(mode)
+
 	switch data.Size {
 	case "single":
 		block := fmt.Sprintf(`%s[type=single,waterlogged=%t]`, material, waterlogged)
-		err = client.CreateBlock(ctx, block, data.Position.X, data.Position.Y, data.Position.Z)
+		err = client.CreateBlock(ctx, block, data.Position.X, data.Position.Y, data.Position.Z, mode)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update single chest: %s", err))
 			return
@@ -198,12 +217,12 @@ func (r chestResource) Update(ctx context.Context, req tfsdk.UpdateResourceReque
 	case "double":
 		blockLeft := fmt.Sprintf(`%s[type=left,waterlogged=%t]`, material, waterlogged)
 		blockRight := fmt.Sprintf(`%s[type=right,waterlogged=%t]`, material, waterlogged)
-		err = client.CreateBlock(ctx, blockLeft, data.Position.X, data.Position.Y, data.Position.Z)
+		err = client.CreateBlock(ctx, blockLeft, data.Position.X, data.Position.Y, data.Position.Z, mode)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update left half of double chest: %s", err))
 			return
 		}
-		err = client.CreateBlock(ctx, blockRight, data.Position.X+1, data.Position.Y, data.Position.Z)
+		err = client.CreateBlock(ctx, blockRight, data.Position.X+1, data.Position.Y, data.Position.Z, mode)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update right half of double chest: %s", err))
 			return
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = chunkResourceType{}
+var _ tfsdk.Resource = chunkResource{}
+
+// ---------- Resource Type ----------
+
+type chunkResourceType struct{}
+
+func (t chunkResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: fmt.Sprintf("Chunks that are reset to freshly generated terrain on destroy, and whenever `regenerate` changes. Vanilla can't regenerate chunks, so the server needs a datapack defining the function `%s`, usually wrapping a plugin command; it gets the chunk range as the macro arguments `from_x`, `from_z`, `to_x` and `to_z` (chunk coordinates, 1.20.2+). Without it, regenerating fails as unsupported. Creating the resource changes nothing.", minecraft.RegenerateChunksFunction),
+		Attributes: map[string]tfsdk.Attribute{
+			"from": {
+				MarkdownDescription: "One corner of the area, in block coordinates. Every chunk the area touches is regenerated.",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(columnCornerAttributes()),
+			},
+			"to": {
+				MarkdownDescription: "The opposite corner of the area, in block coordinates.",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(columnCornerAttributes()),
+			},
+			"regenerate": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, regenerates the chunks in place.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+			},
+			"id": {
+				Computed:            true,
+				Type:                types.StringType,
+				MarkdownDescription: "Terraform ID for this area (`x1,z1->x2,z2`).",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t chunkResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return chunkResource{provider: provider}, diags
+}
+
+// ---------- Resource Data ----------
+
+type chunkResourceData struct {
+	Id   types.String `tfsdk:"id"`
+	From struct {
+		X int64 `tfsdk:"x"`
+		Z int64 `tfsdk:"z"`
+	} `tfsdk:"from"`
+	To struct {
+		X int64 `tfsdk:"x"`
+		Z int64 `tfsdk:"z"`
+	} `tfsdk:"to"`
+	Regenerate types.Map `tfsdk:"regenerate"`
+}
+
+// ---------- Resource Impl ----------
+
+type chunkResource struct {
+	provider provider
+}
+
+func (r chunkResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data chunkResourceData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf("%d,%d->%d,%d", data.From.X, data.From.Z, data.To.X, data.To.Z)}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r chunkResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data chunkResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r chunkResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// from and to force a new resource, so only regenerate can have changed.
+	var data chunkResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.regenerate(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r chunkResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data chunkResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("chunks %s", data.Id.Value)) {
+		return
+	}
+	r.regenerate(ctx, data, &resp.Diagnostics)
+}
+
+// regenerate resets the chunks covering the area.
+func (r chunkResource) regenerate(ctx context.Context, data chunkResourceData, diags *diag.Diagnostics) {
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	from := [2]int{int(data.From.X), int(data.From.Z)}
+	to := [2]int{int(data.To.X), int(data.To.Z)}
+	if err := client.RegenerateChunks(ctx, from, to); err != nil {
+		if errors.Is(err, minecraft.ErrUnsupported) {
+			diags.AddError("Unsupported", fmt.Sprintf("Unable to regenerate chunks %s: %s. Add the datapack function, or set prevent_destructive_delete to remove the resource without regenerating.", data.Id.Value, err))
+			return
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to regenerate chunks %s: %s", data.Id.Value, err))
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// chunkAttrs configures the chunks between two block corners, with an
+// optional regenerate trigger.
+func chunkAttrs(from, to [2]int, trigger string) map[string]tftypes.Value {
+	ctx := context.Background()
+	schema, _ := chunkResourceType{}.GetSchema(ctx)
+	corner := func(name string, c [2]int) tftypes.Value {
+		typ := schema.TerraformType(ctx).(tftypes.Object).AttributeTypes[name]
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"x": tftypes.NewValue(tftypes.Number, c[0]),
+			"z": tftypes.NewValue(tftypes.Number, c[1]),
+		})
+	}
+	attrs := map[string]tftypes.Value{
+		"from": corner("from", from),
+		"to":   corner("to", to),
+	}
+	if trigger != "" {
+		attrs["regenerate"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"run": tftypes.NewValue(tftypes.String, trigger),
+		})
+	}
+	return attrs
+}
+
+func TestChunkRegeneratesOnTriggerAndDelete(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	state, diags := createResource(t, p, chunkResourceType{}, chunkAttrs([2]int{-1, 0}, [2]int{40, 31}, "1"))
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	if sent := server.sent(); len(sent) != 0 {
+		t.Fatalf("Create sent %q, want nothing", sent)
+	}
+
+	state, diags = updateResource(t, p, chunkResourceType{}, state, chunkAttrs([2]int{-1, 0}, [2]int{40, 31}, "2"))
+	if diags.HasError() {
+		t.Fatalf("Update: %v", diags)
+	}
+	if diags := deleteResource(t, p, chunkResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+
+	const regen = "function terraform:regenerate_chunks {from_x:-1,from_z:0,to_x:2,to_z:1}"
+	if got, want := server.sent(), []string{regen, regen}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestChunkRegenerateUnsupported(t *testing.T) {
+	server := newFakeServer(t, func(string) string { return "Unknown function terraform:regenerate_chunks" })
+	p := configureProvider(t, server.address, nil)
+
+	state, diags := createResource(t, p, chunkResourceType{}, chunkAttrs([2]int{0, 0}, [2]int{15, 15}, ""))
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	diags = deleteResource(t, p, chunkResourceType{}, state)
+	if !diags.HasError() || diags[0].Summary() != "Unsupported" {
+		t.Errorf("Delete diags = %v, want an Unsupported error", diags)
+	}
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = commandBlockResourceType{}
+var _ tfsdk.Resource = commandBlockResource{}
+
+type commandBlockResourceType struct{}
+
+func (t commandBlockResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A command block (impulse, chain or repeating). Changing `command` or `auto` edits the block in place. The server needs `enable-command-block=true` for it to run.",
+		Attributes: map[string]tfsdk.Attribute{
+			"kind": {
+				MarkdownDescription: "Command block kind: `impulse` (default), `chain` or `repeating`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("impulse", "chain", "repeating"),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"position": {
+				MarkdownDescription: "The position of the command block.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"facing": {
+				MarkdownDescription: "Direction the block points (where a chain continues): `north`, `south`, `east`, `west`, `up` or `down`. Defaults to `up`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("north", "south", "east", "west", "up", "down"),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"command": {
+				MarkdownDescription: "Command the block runs, e.g. `say hello`.",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"auto": {
+				MarkdownDescription: "If true, the block is always active and needs no redstone. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+			},
+			"conditional": {
+				MarkdownDescription: "If true, the block only runs when the block behind it succeeded. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "ID of the block",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t commandBlockResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return commandBlockResource{provider: provider}, diags
+}
+
+type commandBlockResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Kind     types.String `tfsdk:"kind"`
+	Position struct {
+		X int `tfsdk:"x"`
+		Y int `tfsdk:"y"`
+		Z int `tfsdk:"z"`
+	} `tfsdk:"position"`
+	Facing      types.String `tfsdk:"facing"`
+	Command     string       `tfsdk:"command"`
+	Auto        types.Bool   `tfsdk:"auto"`
+	Conditional types.Bool   `tfsdk:"conditional"`
+}
+
+type commandBlockResource struct {
+	provider provider
+}
+
+func (d *commandBlockResourceData) applyDefaults() {
+	if d.Kind.Null || d.Kind.Unknown {
+		d.Kind = types.String{Value: "impulse"}
+	}
+	if d.Facing.Null || d.Facing.Unknown {
+		d.Facing = types.String{Value: "up"}
+	}
+	if d.Auto.Null || d.Auto.Unknown {
+		d.Auto = types.Bool{Value: false}
+	}
+	if d.Conditional.Null || d.Conditional.Unknown {
+		d.Conditional = types.Bool{Value: false}
+	}
+}
+
+func (r commandBlockResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data commandBlockResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.applyDefaults()
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	err = client.CreateCommandBlock(ctx,
+		strings.ToLower(data.Kind.Value),
+		data.Position.X, data.Position.Y, data.Position.Z,
+		strings.ToLower(data.Facing.Value),
+		data.Command,
+		data.Auto.Value,
+		data.Conditional.Value,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to place command block, got error: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf("command-block-%d-%d-%d", data.Position.X, data.Position.Y, data.Position.Z)}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r commandBlockResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data commandBlockResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r commandBlockResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Only command and auto change in place; the rest is ForceNew.
+	var data commandBlockResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.applyDefaults()
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	if err := client.UpdateCommandBlock(ctx, data.Position.X, data.Position.Y, data.Position.Z, data.Command, data.Auto.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update command block, got error: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r commandBlockResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data commandBlockResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("command block at %d,%d,%d", data.Position.X, data.Position.Y, data.Position.Z)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	if err := client.DeleteBlock(ctx, data.Position.X, data.Position.Y, data.Position.Z); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove command block, got error: %s", err))
+		return
+	}
+}
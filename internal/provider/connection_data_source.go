@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = connectionDataSourceType{}
+var _ tfsdk.DataSource = connectionDataSource{}
+
+type connectionDataSourceType struct{}
+
+func (t connectionDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "What the provider actually connects to, for debugging provider configuration in CI. Connects once, times a `list` and asks the server its release. The password is never reported.",
+		Attributes: map[string]tfsdk.Attribute{
+			"address": {
+				Computed:            true,
+				MarkdownDescription: "The resolved RCON address, from `address` or `MINECRAFT_ADDRESS`.",
+				Type:                types.StringType,
+			},
+			"host": {
+				Computed:            true,
+				MarkdownDescription: "Host part of `address`.",
+				Type:                types.StringType,
+			},
+			"port": {
+				Computed:            true,
+				MarkdownDescription: "Port part of `address`.",
+				Type:                types.Int64Type,
+			},
+			"latency_ms": {
+				Computed:            true,
+				MarkdownDescription: "Round trip time of the `list` command in milliseconds.",
+				Type:                types.Int64Type,
+			},
+			"server_version": {
+				Computed:            true,
+				MarkdownDescription: "Release the server reports through `version`, e.g. `1.20.4`. Servers without that command (vanilla before 1.21.6) fall back to the provider's `server_version`, or `\"\"` without one. RCON is Java Edition only, so this is always a Java release.",
+				Type:                types.StringType,
+			},
+			"version_detected": {
+				Computed:            true,
+				MarkdownDescription: "Whether `server_version` came from the server rather than the provider configuration.",
+				Type:                types.BoolType,
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Same as `address`.",
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t connectionDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return connectionDataSource{provider: provider}, diags
+}
+
+type connectionDataSourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Address         types.String `tfsdk:"address"`
+	Host            types.String `tfsdk:"host"`
+	Port            types.Int64  `tfsdk:"port"`
+	LatencyMs       types.Int64  `tfsdk:"latency_ms"`
+	ServerVersion   types.String `tfsdk:"server_version"`
+	VersionDetected types.Bool   `tfsdk:"version_detected"`
+}
+
+type connectionDataSource struct {
+	provider provider
+}
+
+func (d connectionDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data connectionDataSourceData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	host, port, err := minecraft.SplitAddress(d.provider.address)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Address", err.Error())
+		return
+	}
+	data.Id = types.String{Value: d.provider.address}
+	data.Address = types.String{Value: d.provider.address}
+	data.Host = types.String{Value: host}
+	data.Port = types.Int64{Value: int64(port)}
+
+	client, err := d.provider.dial()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to connect to %s: %s", d.provider.address, err))
+		return
+	}
+	latency, err := client.Ping(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Minecraft server at %s did not answer RCON: %s", d.provider.address, err))
+		return
+	}
+	data.LatencyMs = types.Int64{Value: latency.Milliseconds()}
+
+	// Ask the server even when server_version is configured, so a mismatch shows.
+	data.ServerVersion = types.String{Value: d.provider.serverVersion}
+	data.VersionDetected = types.Bool{Value: false}
+	if v, err := client.GetVersion(ctx); err == nil {
+		data.ServerVersion = types.String{Value: v}
+		data.VersionDetected = types.Bool{Value: true}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func readConnection(t *testing.T, p *provider) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	schema, _ := connectionDataSourceType{}.GetSchema(ctx)
+	ds, diags := connectionDataSourceType{}.NewDataSource(ctx, p)
+	if diags.HasError() {
+		t.Fatalf("NewDataSource: %v", diags)
+	}
+	config := objectValue(ctx, schema, nil)
+	resp := tfsdk.ReadDataSourceResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)}}
+	ds.Read(ctx, tfsdk.ReadDataSourceRequest{Config: tfsdk.Config{Schema: schema, Raw: config}}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func TestConnectionReportsAddressWithoutPassword(t *testing.T) {
+	server := newFakeServer(t, func(command string) string {
+		if command == "version" {
+			return "This server is running Paper version git-Paper-496 (MC: 1.20.4) (Implementing API version 1.20.4-R0.1-SNAPSHOT)"
+		}
+		return ""
+	})
+	p := configureProvider(t, server.address, nil)
+
+	state, diags := readConnection(t, p)
+	if diags.HasError() {
+		t.Fatalf("Read: %v", diags)
+	}
+	var data connectionDataSourceData
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("reading state: %v", diags)
+	}
+
+	host, port, _ := net.SplitHostPort(server.address)
+	if data.Address.Value != server.address || data.Host.Value != host || data.LatencyMs.Value < 0 {
+		t.Errorf("data = %+v, want address %s", data, server.address)
+	}
+	if got := data.Port.Value; port != strconv.FormatInt(got, 10) {
+		t.Errorf("port = %d, want %s", got, port)
+	}
+	if data.ServerVersion.Value != "1.20.4" || !data.VersionDetected.Value {
+		t.Errorf("server_version = %q (detected %t), want 1.20.4 from the server", data.ServerVersion.Value, data.VersionDetected.Value)
+	}
+
+	schema, _ := connectionDataSourceType{}.GetSchema(context.Background())
+	if _, ok := schema.Attributes["password"]; ok {
+		t.Error("schema has a password attribute")
+	}
+	if strings.Contains(state.Raw.String(), "secret") {
+		t.Errorf("state %s contains the password", state.Raw)
+	}
+}
+
+func TestConnectionFallsBackToConfiguredVersion(t *testing.T) {
+	server := newFakeServer(t, func(command string) string {
+		if command == "version" {
+			return "Unknown or incomplete command, see below for error"
+		}
+		return ""
+	})
+	p := configureProvider(t, server.address, nil)
+
+	state, diags := readConnection(t, p)
+	if diags.HasError() {
+		t.Fatalf("Read: %v", diags)
+	}
+	var data connectionDataSourceData
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("reading state: %v", diags)
+	}
+	if data.ServerVersion.Value != testServerVersion || data.VersionDetected.Value {
+		t.Errorf("server_version = %q (detected %t), want the configured %s", data.ServerVersion.Value, data.VersionDetected.Value, testServerVersion)
+	}
+}
+
+func TestConnectionUnreachable(t *testing.T) {
+	p := configureProvider(t, closedAddress(t), nil)
+	if _, diags := readConnection(t, p); !diags.HasError() {
+		t.Errorf("diags = %v, want an error", diags)
+	}
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// cornerAttributes is the schema of one block corner of a cuboid region, as
+// read into a cornerModel. Moving a corner replaces the resource.
+func cornerAttributes() map[string]tfsdk.Attribute {
+	attrs := map[string]tfsdk.Attribute{}
+	for _, axis := range []string{"x", "y", "z"} {
+		attrs[axis] = tfsdk.Attribute{
+			MarkdownDescription: fmt.Sprintf("%s coordinate.", strings.ToUpper(axis)),
+			Type:                types.Int64Type,
+			Required:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		}
+	}
+	return attrs
+}
+
+// cornerModel holds a corner read from cornerAttributes.
+type cornerModel struct {
+	X int64 `tfsdk:"x"`
+	Y int64 `tfsdk:"y"`
+	Z int64 `tfsdk:"z"`
+}
+
+func (c cornerModel) coords() [3]int {
+	return [3]int{int(c.X), int(c.Y), int(c.Z)}
+}
+
+// columnCornerAttributes is cornerAttributes without y, for areas that span
+// every height, such as chunks.
+func columnCornerAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"x": {
+			MarkdownDescription: "X coordinate.",
+			Type:                types.Int64Type,
+			Required:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+		"z": {
+			MarkdownDescription: "Z coordinate.",
+			Type:                types.Int64Type,
+			Required:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+	}
+}
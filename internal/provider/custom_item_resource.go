@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = customItemResourceType{}
+var _ tfsdk.Resource = customItemResource{}
+
+// ---------- Resource Type ----------
+
+type customItemResourceType struct{}
+
+func (t customItemResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "One-shot trigger that gives a player an item customised with 1.20.5+ item components (name, lore, unbreakable, model data, glint). The exact syntax follows the provider's `server_version`; older servers are rejected. Change `triggers` to give again; destroying it does nothing in game.",
+		Attributes: map[string]tfsdk.Attribute{
+			"player": {
+				MarkdownDescription: "Player name or selector receiving the item.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"item": {
+				MarkdownDescription: "Item ID, e.g. `minecraft:stick`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"custom_name": {
+				MarkdownDescription: "Display name of the item.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"lore": {
+				MarkdownDescription: fmt.Sprintf("Lore lines shown under the name, at most %d.", minecraft.MaxLoreLines),
+				Optional:            true,
+				Type:                types.ListType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"unbreakable": {
+				MarkdownDescription: "If true, the item never loses durability. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"custom_model_data": {
+				MarkdownDescription: "Model data value picked up by resource packs.",
+				Optional:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"enchantment_glint_override": {
+				MarkdownDescription: "Forces the enchantment glint on (`true`) or off (`false`). Unset keeps the item's default.",
+				Optional:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, give the item again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this give.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t customItemResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return customItemResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type customItemResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Player          string       `tfsdk:"player"`
+	Item            string       `tfsdk:"item"`
+	CustomName      types.String `tfsdk:"custom_name"`
+	Lore            []string     `tfsdk:"lore"`
+	Unbreakable     types.Bool   `tfsdk:"unbreakable"`
+	CustomModelData types.Int64  `tfsdk:"custom_model_data"`
+	GlintOverride   types.Bool   `tfsdk:"enchantment_glint_override"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+}
+
+// components validates the configuration and converts it for the client.
+func (d customItemResourceData) components() (minecraft.ItemComponents, error) {
+	var comp minecraft.ItemComponents
+
+	if !resourceIDPattern.MatchString(d.Item) {
+		return comp, fmt.Errorf("invalid item %q: expected e.g. minecraft:stick", d.Item)
+	}
+	if !d.CustomName.Null {
+		if strings.TrimSpace(d.CustomName.Value) == "" {
+			return comp, fmt.Errorf("custom_name must not be empty")
+		}
+		comp.CustomName = d.CustomName.Value
+	}
+	if len(d.Lore) > minecraft.MaxLoreLines {
+		return comp, fmt.Errorf("lore may have at most %d lines (got %d)", minecraft.MaxLoreLines, len(d.Lore))
+	}
+	for i, line := range d.Lore {
+		if strings.ContainsAny(line, "\r\n") {
+			return comp, fmt.Errorf("lore line %d must not contain newlines; use one list entry per line", i+1)
+		}
+	}
+	comp.Lore = d.Lore
+	comp.Unbreakable = d.Unbreakable.Value
+	if !d.CustomModelData.Null {
+		v := d.CustomModelData.Value
+		if v < -2147483648 || v > 2147483647 {
+			return comp, fmt.Errorf("custom_model_data must fit in a 32-bit int (got %d)", v)
+		}
+		n := int(v)
+		comp.CustomModelData = &n
+	}
+	if !d.GlintOverride.Null {
+		glint := d.GlintOverride.Value
+		comp.GlintOverride = &glint
+	}
+	return comp, nil
+}
+
+// ---------- Resource Impl ----------
+
+type customItemResource struct {
+	provider provider
+}
+
+func (r customItemResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data customItemResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.provider.useItemComponents() {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("minecraft_custom_item needs item components, which arrived in 1.20.5 (server_version is %s)", r.provider.release()))
+		return
+	}
+	comp, err := data.components()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	comp.FloatModelData = r.provider.serverAtLeast([3]int{1, 21, 4})
+	comp.SNBTText = r.provider.serverAtLeast([3]int{1, 21, 5})
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	player := strings.TrimSpace(data.Player)
+	if err := client.GiveComponentItem(ctx, player, data.Item, comp); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to give %s to %s: %s", data.Item, player, err))
+		return
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r customItemResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// The item belongs to the player now; keep state as-is.
+	var data customItemResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r customItemResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data customItemResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r customItemResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// A given item can't be taken back reliably; just drop it from state.
+}
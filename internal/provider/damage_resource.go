@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = damageResourceType{}
+var _ tfsdk.Resource = damageResource{}
+
+// ---------- Resource Type ----------
+
+type damageResourceType struct{}
+
+func (t damageResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "One-shot trigger that damages a target with `/damage` (1.19.4+) when created. Change `triggers` to deal damage again; destroying it does nothing in game.",
+		Attributes: map[string]tfsdk.Attribute{
+			"target": {
+				MarkdownDescription: "Player name or selector to damage (e.g. `@a[team=red]`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"amount": {
+				MarkdownDescription: "Damage in half-hearts. Must not be negative.",
+				Required:            true,
+				Type:                types.Float64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"damage_type": {
+				MarkdownDescription: "Damage type ID (e.g. `minecraft:magic`). Defaults to `minecraft:generic`.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"source": {
+				MarkdownDescription: "Entity selector credited with the damage. Conflicts with `location`.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"location": {
+				MarkdownDescription: "Where the damage comes from, for knockback direction. Conflicts with `source`.",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(vec3Attributes("Position")),
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, deal damage again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this hit.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t damageResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return damageResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type damageResourceData struct {
+	Id         types.String `tfsdk:"id"`
+	Target     string       `tfsdk:"target"`
+	Amount     float64      `tfsdk:"amount"`
+	DamageType *string      `tfsdk:"damage_type"` // optional
+	Source     *string      `tfsdk:"source"`      // optional
+	Location   *vec3        `tfsdk:"location"`    // optional
+	Triggers   types.Map    `tfsdk:"triggers"`
+}
+
+// command resolves the optional attributes into DamageTarget arguments.
+func (d damageResourceData) command() (damageType, source, location string, err error) {
+	if d.Amount < 0 {
+		return "", "", "", fmt.Errorf("amount must not be negative (got %v)", d.Amount)
+	}
+	if d.Source != nil && d.Location != nil {
+		return "", "", "", fmt.Errorf("only one of source or location may be set")
+	}
+	if d.DamageType != nil {
+		damageType = *d.DamageType
+	}
+	if d.Source != nil {
+		source = *d.Source
+	}
+	if d.Location != nil {
+		location = fmt.Sprintf("%v %v %v", d.Location.X, d.Location.Y, d.Location.Z)
+	}
+	return damageType, source, location, nil
+}
+
+// ---------- Resource Impl ----------
+
+type damageResource struct {
+	provider provider
+}
+
+func (r damageResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data damageResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	damageType, source, location, err := data.command()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.DamageTarget(ctx, data.Target, data.Amount, damageType, source, location); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to damage %s: %s", data.Target, err))
+		return
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r damageResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Nothing persists in game; keep state as-is.
+	var data damageResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r damageResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data damageResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r damageResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Damage can't be undone; just drop it from state.
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = datapackResourceType{}
+var _ tfsdk.Resource = datapackResource{}
+
+// ---------- Resource Type ----------
+
+type datapackResourceType struct{}
+
+func (t datapackResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Enables a datapack that is already in the world's `datapacks` folder, and disables it on destroy. A pack disabled outside Terraform is enabled again on the next apply.",
+		Attributes: map[string]tfsdk.Attribute{
+			"name": {
+				MarkdownDescription: "Pack name as `datapack list` shows it: `file/<folder or zip>` for world packs, e.g. `file/my_pack.zip`, or a built-in pack such as `bundle`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Same as `name`.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t datapackResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return datapackResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type datapackResourceData struct {
+	Id   types.String `tfsdk:"id"`
+	Name string       `tfsdk:"name"`
+}
+
+// Pack names are `file/<folder or zip>` for world packs, or a built-in ID
+// such as `vanilla` or `bundle`.
+var datapackNamePattern = regexp.MustCompile(`^(file/[^"\\/\n]+|[a-z0-9_.-]+)$`)
+
+func (d datapackResourceData) validate() error {
+	if !datapackNamePattern.MatchString(d.Name) {
+		return fmt.Errorf("name must be a datapack name such as file/my_pack.zip or vanilla (got %q)", d.Name)
+	}
+	return nil
+}
+
+// ---------- Resource Impl ----------
+
+type datapackResource struct {
+	provider provider
+}
+
+func (r datapackResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data datapackResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.EnableDatapack(ctx, data.Name); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable datapack: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: data.Name}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r datapackResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data datapackResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	enabled, _, err := client.ListDatapacks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list datapacks: %s", err))
+		return
+	}
+	found := false
+	for _, name := range enabled {
+		if name == data.Name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r datapackResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data datapackResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r datapackResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data datapackResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.DisableDatapack(ctx, data.Name); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable datapack: %s", err))
+		return
+	}
+}
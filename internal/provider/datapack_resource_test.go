@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDatapackReadDropsDisabledPack(t *testing.T) {
+	list := ""
+	server := newFakeServer(t, func(command string) string {
+		if command == "datapack list" {
+			return list
+		}
+		return ""
+	})
+	p := configureProvider(t, server.address, nil)
+	state, diags := createResource(t, p, datapackResourceType{}, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "file/mypack"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+
+	for reply, kept := range map[string]bool{
+		"There are 2 data pack(s) enabled: [vanilla (built-in)], [file/mypack (world)]": true,
+		"There are 1 data pack(s) enabled: [vanilla (built-in)]":                        false,
+	} {
+		list = reply
+		got, diags := readResource(t, p, datapackResourceType{}, state)
+		if diags.HasError() {
+			t.Fatalf("Read: %v", diags)
+		}
+		if got.Raw.IsNull() == kept {
+			t.Errorf("%q: kept in state = %t, want %t", reply, !got.Raw.IsNull(), kept)
+		}
+	}
+}
+
+func TestDatapackNameValidation(t *testing.T) {
+	for _, name := range []string{"file/my_pack", "file/My Pack.zip", "vanilla", "bundle"} {
+		if err := (datapackResourceData{Name: name}).validate(); err != nil {
+			t.Errorf("%q rejected: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "file/", "file/a/b", `file/say "hi"`, `file/a\b`, "Vanilla"} {
+		if err := (datapackResourceData{Name: name}).validate(); err == nil {
+			t.Errorf("%q accepted", name)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure types satisfy framework interfaces
+var _ tfsdk.ResourceType = difficultyResourceType{}
+var _ tfsdk.Resource = difficultyResource{}
+
+// -------- Resource Type --------
+
+type difficultyResourceType struct{}
+
+func (t difficultyResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Sets the world difficulty. With `lock`, every refresh re-applies the difficulty if the server drifted (e.g. a wrapper reset it on restart). Destroying the resource leaves the difficulty as-is.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Resource ID. Always `\"default\"` for this global server setting.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"difficulty": {
+				Type:                types.StringType,
+				Required:            true,
+				MarkdownDescription: "One of `peaceful`, `easy`, `normal`, `hard`.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("peaceful", "easy", "normal", "hard"),
+				},
+			},
+			"lock": {
+				Type:                types.BoolType,
+				Optional:            true,
+				MarkdownDescription: "If true, Read resets the difficulty when the server reports a different one instead of surfacing the drift in the plan. Defaults to `false`.",
+			},
+		},
+	}, nil
+}
+
+func (t difficultyResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return difficultyResource{provider: p}, diags
+}
+
+// -------- Data & Resource --------
+
+type difficultyResourceData struct {
+	ID         types.String `tfsdk:"id"`
+	Difficulty types.String `tfsdk:"difficulty"`
+	Lock       types.Bool   `tfsdk:"lock"`
+}
+
+type difficultyResource struct {
+	provider provider
+}
+
+func (r difficultyResource) apply(ctx context.Context, plan *difficultyResourceData, diags *diag.Diagnostics) {
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	difficulty := strings.ToLower(plan.Difficulty.Value)
+	if err := client.SetDifficulty(ctx, difficulty); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set difficulty to %q: %s", difficulty, err))
+		return
+	}
+
+	plan.ID = types.String{Value: "default"}
+}
+
+// -------- CRUD --------
+
+func (r difficultyResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan difficultyResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r difficultyResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state difficultyResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	current, err := client.GetDifficulty(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read difficulty: %s", err))
+		return
+	}
+
+	want := strings.ToLower(state.Difficulty.Value)
+	if current != want {
+		if state.Lock.Value {
+			// Pinned: put it back rather than reporting drift.
+			if err := client.SetDifficulty(ctx, want); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to re-apply difficulty %q: %s", want, err))
+				return
+			}
+		} else {
+			state.Difficulty = types.String{Value: current}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r difficultyResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan difficultyResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r difficultyResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The previous difficulty isn't known, so there's nothing sensible to restore.
+}
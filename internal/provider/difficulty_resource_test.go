@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDifficultyLockReappliesDrift(t *testing.T) {
+	tests := []struct {
+		name           string
+		lock           bool
+		live           string
+		wantSet        bool
+		wantDifficulty string
+	}{
+		{name: "locked drift is re-applied", lock: true, live: "Easy", wantSet: true, wantDifficulty: "hard"},
+		{name: "locked match is left alone", lock: true, live: "Hard", wantDifficulty: "hard"},
+		{name: "unlocked drift is recorded", live: "Easy", wantDifficulty: "easy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			live := "Hard"
+			server := newFakeServer(t, func(command string) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if command == "difficulty" {
+					return "The difficulty is " + live
+				}
+				return ""
+			})
+			p := configureProvider(t, server.address, nil)
+
+			state, diags := createResource(t, p, difficultyResourceType{}, map[string]tftypes.Value{
+				"difficulty": tftypes.NewValue(tftypes.String, "hard"),
+				"lock":       tftypes.NewValue(tftypes.Bool, tt.lock),
+			})
+			if diags.HasError() {
+				t.Fatalf("Create: %v", diags)
+			}
+			mu.Lock()
+			live = tt.live
+			mu.Unlock()
+			created := len(server.sent())
+
+			state, diags = readResource(t, p, difficultyResourceType{}, state)
+			if diags.HasError() {
+				t.Fatalf("Read: %v", diags)
+			}
+			if got := containsCommand(server.sent()[created:], "difficulty hard"); got != tt.wantSet {
+				t.Errorf("re-applied: %t, want %t; sent %q", got, tt.wantSet, server.sent()[created:])
+			}
+			if got := stateString(t, state, "difficulty"); got != tt.wantDifficulty {
+				t.Errorf("difficulty = %q, want %q", got, tt.wantDifficulty)
+			}
+		})
+	}
+}
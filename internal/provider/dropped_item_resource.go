@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = droppedItemResourceType{}
+var _ tfsdk.Resource = droppedItemResource{}
+
+// ---------- Resource Type ----------
+
+type droppedItemResourceType struct{}
+
+func (t droppedItemResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "An item lying in the world as a `minecraft:item` entity. With `no_despawn` and `pickup_locked` it stays put as decoration; otherwise it behaves like any dropped item and may be picked up or despawn.",
+		Attributes: map[string]tfsdk.Attribute{
+			"position": {
+				MarkdownDescription: "Where to drop the item. Use `.5` to center it on a block.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"item": {
+				MarkdownDescription: "Item ID, e.g. `minecraft:diamond`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"count": {
+				MarkdownDescription: fmt.Sprintf("Stack size, 1 to %d. Defaults to `1`.", minecraft.MaxItemStack),
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"no_despawn": {
+				MarkdownDescription: "If true, the item never despawns. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"pickup_locked": {
+				MarkdownDescription: "If true, nobody can pick the item up (`PickupDelay:32767`). Usually combined with `no_despawn`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Stable UUID used as the entity's CustomName/tag.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t droppedItemResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return droppedItemResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type droppedItemResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Position struct {
+		X float64 `tfsdk:"x"`
+		Y float64 `tfsdk:"y"`
+		Z float64 `tfsdk:"z"`
+	} `tfsdk:"position"`
+	Item         string      `tfsdk:"item"`
+	Count        types.Int64 `tfsdk:"count"`
+	NoDespawn    types.Bool  `tfsdk:"no_despawn"`
+	PickupLocked types.Bool  `tfsdk:"pickup_locked"`
+}
+
+// applyDefaults fills unset optional values and validates the result.
+func (d *droppedItemResourceData) applyDefaults() error {
+	if d.Count.Null || d.Count.Unknown {
+		d.Count = types.Int64{Value: 1}
+	}
+	if d.NoDespawn.Null || d.NoDespawn.Unknown {
+		d.NoDespawn = types.Bool{Value: false}
+	}
+	if d.PickupLocked.Null || d.PickupLocked.Unknown {
+		d.PickupLocked = types.Bool{Value: false}
+	}
+	if !resourceIDPattern.MatchString(d.Item) {
+		return fmt.Errorf("item must be an item ID such as minecraft:diamond (got %q)", d.Item)
+	}
+	if d.Count.Value < 1 || d.Count.Value > minecraft.MaxItemStack {
+		return fmt.Errorf("count must be between 1 and %d (got %d)", minecraft.MaxItemStack, d.Count.Value)
+	}
+	return nil
+}
+
+// ---------- Resource Impl ----------
+
+type droppedItemResource struct {
+	provider provider
+}
+
+func (r droppedItemResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data droppedItemResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.applyDefaults(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	if data.PickupLocked.Value && !data.NoDespawn.Value {
+		resp.Diagnostics.AddWarning("Item Will Despawn",
+			"pickup_locked is set without no_despawn, so the item can't be picked up but still disappears after five minutes. Set no_despawn = true to keep it.")
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	id := uuid.NewString()
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
+
+	err = client.CreateDroppedItem(ctx, pos, id, data.Item, int(data.Count.Value),
+		data.NoDespawn.Value, data.PickupLocked.Value, r.provider.useItemComponents())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to drop item: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: id}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r droppedItemResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data droppedItemResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r droppedItemResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data droppedItemResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r droppedItemResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data droppedItemResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("%s at %s", data.Item, pos)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	// Gone already if it was picked up or despawned; kill is a no-op then.
+	if err := client.DeleteEntity(ctx, "minecraft:item", pos, data.Id.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove dropped item: %s", err))
+		return
+	}
+}
@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = effectCloudResourceType{}
+var _ tfsdk.Resource = effectCloudResource{}
+
+const (
+	defaultCloudDuration = 600 // ticks, as for a lingering potion
+	maxCloudRadius       = 32  // the game clamps larger radii
+)
+
+// ---------- Resource Type ----------
+
+type effectCloudResourceType struct{}
+
+func (t effectCloudResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Summons a `minecraft:area_effect_cloud`, the lingering potion cloud that applies `effects` to entities standing in it, e.g. for trap builds. The cloud expires after `duration` ticks; destroying the resource kills it if it is still there. Every attribute forces a new cloud.",
+		Attributes: map[string]tfsdk.Attribute{
+			"position": {
+				MarkdownDescription: "Where the cloud is centered. Use `.5` to center it on a block.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"radius": {
+				MarkdownDescription: fmt.Sprintf("Cloud radius in blocks, greater than 0 and at most %d.", maxCloudRadius),
+				Required:            true,
+				Type:                types.Float64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"duration": {
+				MarkdownDescription: fmt.Sprintf("How long the cloud lasts, in ticks. Defaults to `%d` (30 seconds).", defaultCloudDuration),
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"effects": {
+				MarkdownDescription: "Status effects applied to entities in the cloud.",
+				Required:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"effect": {
+						MarkdownDescription: "Effect ID, e.g. `minecraft:poison`.",
+						Required:            true,
+						Type:                types.StringType,
+					},
+					"amplifier": {
+						MarkdownDescription: "Effect level minus one, 0-255. Defaults to `0` (level I).",
+						Optional:            true,
+						Type:                types.Int64Type,
+					},
+					"duration": {
+						MarkdownDescription: "Effect duration in ticks once applied.",
+						Required:            true,
+						Type:                types.Int64Type,
+					},
+				}),
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"particle": {
+				MarkdownDescription: "Particle the cloud is drawn with, e.g. `minecraft:witch`. Defaults to the game's effect particle.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"color": {
+				MarkdownDescription: "Particle color as a 24-bit RGB integer, e.g. `0x4E9331`. Defaults to the mix of the effects' colors.",
+				Optional:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Stable UUID used as the entity's CustomName/tag.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t effectCloudResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return effectCloudResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type effectCloudEffect struct {
+	Effect    string      `tfsdk:"effect"`
+	Amplifier types.Int64 `tfsdk:"amplifier"`
+	Duration  int64       `tfsdk:"duration"`
+}
+
+type effectCloudResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Position struct {
+		X float64 `tfsdk:"x"`
+		Y float64 `tfsdk:"y"`
+		Z float64 `tfsdk:"z"`
+	} `tfsdk:"position"`
+	Radius   float64             `tfsdk:"radius"`
+	Duration types.Int64         `tfsdk:"duration"`
+	Effects  []effectCloudEffect `tfsdk:"effects"`
+	Particle types.String        `tfsdk:"particle"`
+	Color    types.Int64         `tfsdk:"color"`
+}
+
+func (d *effectCloudResourceData) applyDefaults() {
+	if d.Duration.Null || d.Duration.Unknown {
+		d.Duration = types.Int64{Value: defaultCloudDuration}
+	}
+}
+
+// effects validates the cloud's settings and converts the effects for the client.
+func (d effectCloudResourceData) effects() ([]minecraft.CloudEffect, error) {
+	if d.Radius <= 0 || d.Radius > maxCloudRadius {
+		return nil, fmt.Errorf("radius must be greater than 0 and at most %d (got %g)", maxCloudRadius, d.Radius)
+	}
+	if d.Duration.Value <= 0 {
+		return nil, fmt.Errorf("duration must be a positive number of ticks (got %d)", d.Duration.Value)
+	}
+	if !d.Particle.Null && !resourceIDPattern.MatchString(d.Particle.Value) {
+		return nil, fmt.Errorf("particle must be a particle ID such as minecraft:witch (got %q)", d.Particle.Value)
+	}
+	if !d.Color.Null && (d.Color.Value < 0 || d.Color.Value > 0xFFFFFF) {
+		return nil, fmt.Errorf("color must be a 24-bit RGB value between 0 and 16777215 (got %d)", d.Color.Value)
+	}
+	if len(d.Effects) == 0 {
+		return nil, fmt.Errorf("effects must list at least one effect")
+	}
+
+	effects := make([]minecraft.CloudEffect, len(d.Effects))
+	for i, e := range d.Effects {
+		if !resourceIDPattern.MatchString(e.Effect) {
+			return nil, fmt.Errorf("effects[%d].effect must be an effect ID such as minecraft:poison (got %q)", i, e.Effect)
+		}
+		if e.Amplifier.Value < 0 || e.Amplifier.Value > 255 {
+			return nil, fmt.Errorf("effects[%d].amplifier must be between 0 and 255 (got %d)", i, e.Amplifier.Value)
+		}
+		if e.Duration <= 0 {
+			return nil, fmt.Errorf("effects[%d].duration must be a positive number of ticks (got %d)", i, e.Duration)
+		}
+		effects[i] = minecraft.CloudEffect{ID: e.Effect, Amplifier: int(e.Amplifier.Value), Duration: int(e.Duration)}
+	}
+	return effects, nil
+}
+
+// ---------- Resource Impl ----------
+
+type effectCloudResource struct {
+	provider provider
+}
+
+func (r effectCloudResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data effectCloudResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.applyDefaults()
+	effects, err := data.effects()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	var color *int
+	if !data.Color.Null {
+		c := int(data.Color.Value)
+		color = &c
+	}
+
+	id := uuid.NewString()
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
+	r.provider.warnSpawnProtection(ctx, &resp.Diagnostics, "effect cloud", int(data.Position.X), int(data.Position.Z), int(data.Position.X), int(data.Position.Z))
+
+	err = client.CreateEffectCloud(ctx, pos, id, data.Radius, int(data.Duration.Value), effects, data.Particle.Value, color, r.provider.useItemComponents())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon effect cloud: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: id}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r effectCloudResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// The cloud expires on its own; keep state as-is.
+	var data effectCloudResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r effectCloudResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data effectCloudResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.applyDefaults()
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r effectCloudResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data effectCloudResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("minecraft:area_effect_cloud %s", data.Id.Value)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
+	if err := client.DeleteEntity(ctx, "minecraft:area_effect_cloud", pos, data.Id.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete effect cloud: %s", err))
+		return
+	}
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEffectCloudValidation(t *testing.T) {
+	valid := func() effectCloudResourceData {
+		return effectCloudResourceData{
+			Radius:   3,
+			Duration: types.Int64{Value: 600},
+			Effects:  []effectCloudEffect{{Effect: "minecraft:poison", Amplifier: types.Int64{Value: 255}, Duration: 200}},
+			Particle: types.String{Null: true},
+			Color:    types.Int64{Null: true},
+		}
+	}
+	tests := []struct {
+		name    string
+		modify  func(d *effectCloudResourceData)
+		wantErr string
+	}{
+		{name: "valid", modify: func(d *effectCloudResourceData) {}},
+		{name: "zero radius", modify: func(d *effectCloudResourceData) { d.Radius = 0 }, wantErr: "radius"},
+		{name: "radius too big", modify: func(d *effectCloudResourceData) { d.Radius = 33 }, wantErr: "radius"},
+		{name: "zero duration", modify: func(d *effectCloudResourceData) { d.Duration = types.Int64{Value: 0} }, wantErr: "duration must"},
+		{name: "bad particle", modify: func(d *effectCloudResourceData) { d.Particle = types.String{Value: "Witch!"} }, wantErr: "particle"},
+		{name: "color too big", modify: func(d *effectCloudResourceData) { d.Color = types.Int64{Value: 0x1000000} }, wantErr: "color"},
+		{name: "no effects", modify: func(d *effectCloudResourceData) { d.Effects = nil }, wantErr: "at least one"},
+		{name: "amplifier", modify: func(d *effectCloudResourceData) { d.Effects[0].Amplifier = types.Int64{Value: 256} }, wantErr: "effects[0].amplifier"},
+		{name: "effect duration", modify: func(d *effectCloudResourceData) { d.Effects[0].Duration = 0 }, wantErr: "effects[0].duration"},
+	}
+	for _, tt := range tests {
+		d := valid()
+		tt.modify(&d)
+		_, err := d.effects()
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: err = %v, want one mentioning %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = entityAppearanceResourceType{}
+var _ tfsdk.Resource = entityAppearanceResource{}
+
+// ---------- Resource Type ----------
+
+type entityAppearanceResourceType struct{}
+
+func (t entityAppearanceResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Makes an existing entity (e.g. a `minecraft_entity`) invisible or glowing without summoning it again. Both options update in place; destroying the resource makes the entity visible and stops the glow.",
+		Attributes: map[string]tfsdk.Attribute{
+			"entity_id": {
+				MarkdownDescription: "ID of the entity (the `id` of its `minecraft_entity`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"invisible": {
+				MarkdownDescription: "Hide the entity with a never-ending invisibility effect without particles. Only living entities can be made invisible; armor and held items stay visible. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"glowing": {
+				MarkdownDescription: "Outline the entity so it is seen through walls. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Same as `entity_id`.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t entityAppearanceResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return entityAppearanceResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type entityAppearanceResourceData struct {
+	Id        types.String `tfsdk:"id"`
+	EntityId  string       `tfsdk:"entity_id"`
+	Invisible types.Bool   `tfsdk:"invisible"`
+	Glowing   types.Bool   `tfsdk:"glowing"`
+}
+
+// ---------- Resource Impl ----------
+
+type entityAppearanceResource struct {
+	provider provider
+}
+
+func (r entityAppearanceResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data entityAppearanceResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: data.EntityId}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r entityAppearanceResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data entityAppearanceResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r entityAppearanceResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// entity_id is ForceNew; both options are applied in place.
+	var data entityAppearanceResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: data.EntityId}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r entityAppearanceResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data entityAppearanceResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	// The entity may already be gone (e.g. destroyed in the same apply); that's fine.
+	if data.Invisible.Value {
+		if err := client.SetEntityInvisible(ctx, data.EntityId, false); err != nil {
+			resp.Diagnostics.AddWarning("Delete Warning", fmt.Sprintf("Unable to make entity %s visible: %s", data.EntityId, err))
+		}
+	}
+	if data.Glowing.Value {
+		if err := client.SetEntityGlowing(ctx, data.EntityId, false); err != nil {
+			resp.Diagnostics.AddWarning("Delete Warning", fmt.Sprintf("Unable to stop entity %s glowing: %s", data.EntityId, err))
+		}
+	}
+}
+
+func (r entityAppearanceResource) apply(ctx context.Context, data entityAppearanceResourceData, diags *diag.Diagnostics) {
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SetEntityInvisible(ctx, data.EntityId, data.Invisible.Value); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set invisibility of entity %s: %s", data.EntityId, err))
+		return
+	}
+	if err := client.SetEntityGlowing(ctx, data.EntityId, data.Glowing.Value); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set glowing of entity %s: %s", data.EntityId, err))
+	}
+}
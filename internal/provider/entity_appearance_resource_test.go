@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestEntityAppearanceDestroyUndoesOnlyWhatItSet(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+	state, diags := createResource(t, p, entityAppearanceResourceType{}, map[string]tftypes.Value{
+		"entity_id": tftypes.NewValue(tftypes.String, "e-1"),
+		"glowing":   tftypes.NewValue(tftypes.Bool, true),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	created := len(server.sent())
+	if diags := deleteResource(t, p, entityAppearanceResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+	want := []string{"data merge entity @e[tag=e-1,limit=1] {Glowing:0b}"}
+	if got := server.sent()[created:]; !reflect.DeepEqual(got, want) {
+		t.Errorf("delete sent %q, want %q", got, want)
+	}
+}
@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"math"
+	"regexp"
+	"strings"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -19,7 +22,7 @@ var _ tfsdk.ResourceWithImportState = entityResource{}
 type entityResourceType struct{}
 
 func (t entityResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
-	return tfsdk.Schema{
+	schema := tfsdk.Schema{
 		MarkdownDescription: "A Minecraft entity, summoned and tracked by a stable UUID.",
 
 		Attributes: map[string]tfsdk.Attribute{
@@ -32,12 +35,12 @@ func (t entityResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 				},
 			},
 			"position": {
-				MarkdownDescription: "The position to summon the entity at.",
+				MarkdownDescription: "The position to summon the entity at. Use `.5` to center it on a block.",
 				Required:            true,
 				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
 					"x": {
 						MarkdownDescription: "X coordinate",
-						Type:                types.NumberType,
+						Type:                types.Float64Type,
 						Required:            true,
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							tfsdk.RequiresReplace(),
@@ -45,7 +48,7 @@ func (t entityResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 					},
 					"y": {
 						MarkdownDescription: "Y coordinate",
-						Type:                types.NumberType,
+						Type:                types.Float64Type,
 						Required:            true,
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							tfsdk.RequiresReplace(),
@@ -53,7 +56,7 @@ func (t entityResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 					},
 					"z": {
 						MarkdownDescription: "Z coordinate",
-						Type:                types.NumberType,
+						Type:                types.Float64Type,
 						Required:            true,
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							tfsdk.RequiresReplace(),
@@ -61,6 +64,60 @@ func (t entityResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 					},
 				}),
 			},
+			"name": {
+				MarkdownDescription: "Display name shown above the entity. Tracking uses a tag, so this is free text (max 256 characters).",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name_visible": {
+				MarkdownDescription: "Show the name even when not looking at the entity. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"no_gravity": {
+				MarkdownDescription: "If true, the entity floats in place instead of falling. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"invulnerable": {
+				MarkdownDescription: "If true, the entity can't be hurt by players, mobs or the environment; destroying the resource still removes it. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"silent": silentAttribute("entity"),
+			"team":   summonTeamAttribute("entity"),
+			"tags": {
+				MarkdownDescription: "Extra scoreboard tags so datapacks can select the entity, e.g. `@e[tag=boss]`. The tracking tag (the `id`) is always added as well. Letters, digits and `_.+-` only.",
+				Optional:            true,
+				Type:                types.ListType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"death_loot_table":     deathLootTableAttribute("entity"),
+			"equipment_loot_table": equipmentLootTableAttribute("entity"),
+			"vehicle": {
+				MarkdownDescription: "Set when other entities ride this one (boats, minecarts, horses, ...). Passengers are dismounted before the entity is killed on destroy, so they aren't orphaned or caught by the kill.",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"equipment": {
+				MarkdownDescription: "Armor and held items for mobs that can wear them (zombies, skeletons, armor stands, ...).",
+				Optional:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(entityEquipmentAttributes()),
+			},
 			"id": {
 				Computed:            true,
 				MarkdownDescription: "UUID for this entity (also embedded as the entity's CustomName/tag).",
@@ -70,7 +127,11 @@ func (t entityResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 				},
 			},
 		},
-	}, nil
+	}
+	for name, attr := range rotationAttributes("entity") {
+		schema.Attributes[name] = attr
+	}
+	return schema, nil
 }
 
 func (t entityResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
@@ -82,10 +143,105 @@ type entityResourceData struct {
 	Id       types.String `tfsdk:"id"`
 	Type     string       `tfsdk:"type"`
 	Position struct {
-		X int `tfsdk:"x"`
-		Y int `tfsdk:"y"`
-		Z int `tfsdk:"z"`
+		X float64 `tfsdk:"x"`
+		Y float64 `tfsdk:"y"`
+		Z float64 `tfsdk:"z"`
 	} `tfsdk:"position"`
+	Name           *string           `tfsdk:"name"`                 // optional
+	NameVisible    types.Bool        `tfsdk:"name_visible"`         // optional
+	NoGravity      types.Bool        `tfsdk:"no_gravity"`           // optional
+	Invulnerable   types.Bool        `tfsdk:"invulnerable"`         // optional
+	Silent         types.Bool        `tfsdk:"silent"`               // optional
+	Team           types.String      `tfsdk:"team"`                 // optional
+	Tags           []string          `tfsdk:"tags"`                 // optional
+	DeathLootTable types.String      `tfsdk:"death_loot_table"`     // optional
+	EquipmentLoot  map[string]string `tfsdk:"equipment_loot_table"` // optional
+	Vehicle        types.Bool        `tfsdk:"vehicle"`
+	Equipment      *entityEquipment  `tfsdk:"equipment"` // optional
+	Rotation       *entityRotation   `tfsdk:"rotation"`  // optional
+	LookAt         *vec3             `tfsdk:"look_at"`   // optional
+}
+
+type entityEquipment struct {
+	Head             *string   `tfsdk:"head"`
+	Chest            *string   `tfsdk:"chest"`
+	Legs             *string   `tfsdk:"legs"`
+	Feet             *string   `tfsdk:"feet"`
+	MainHand         *string   `tfsdk:"mainhand"`
+	OffHand          *string   `tfsdk:"offhand"`
+	ArmorDropChances []float64 `tfsdk:"armor_drop_chances"`
+	HandDropChances  []float64 `tfsdk:"hand_drop_chances"`
+}
+
+// toClient validates drop chances and converts the block for the client.
+func (e entityEquipment) toClient() (minecraft.Equipment, error) {
+	str := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	eq := minecraft.Equipment{
+		Feet:     str(e.Feet),
+		Legs:     str(e.Legs),
+		Chest:    str(e.Chest),
+		Head:     str(e.Head),
+		MainHand: str(e.MainHand),
+		OffHand:  str(e.OffHand),
+	}
+
+	if err := validateDropChances("armor_drop_chances", e.ArmorDropChances, 4); err != nil {
+		return eq, err
+	}
+	if err := validateDropChances("hand_drop_chances", e.HandDropChances, 2); err != nil {
+		return eq, err
+	}
+	if e.ArmorDropChances != nil {
+		eq.ArmorDropChances = &[4]float64{}
+		copy(eq.ArmorDropChances[:], e.ArmorDropChances)
+	}
+	if e.HandDropChances != nil {
+		eq.HandDropChances = &[2]float64{}
+		copy(eq.HandDropChances[:], e.HandDropChances)
+	}
+	return eq, nil
+}
+
+func validateDropChances(name string, chances []float64, slots int) error {
+	if chances == nil {
+		return nil
+	}
+	if len(chances) != slots {
+		return fmt.Errorf("%s must have exactly %d values (got %d)", name, slots, len(chances))
+	}
+	for _, c := range chances {
+		if c < 0 || c > 2 {
+			return fmt.Errorf("%s values must be between 0.0 and 2.0 (got %v)", name, c)
+		}
+	}
+	return nil
+}
+
+// Keeps the summon command well inside RCON's request size limit.
+const maxEntityNameLength = 256
+
+func validateEntityName(name string) error {
+	if n := len([]rune(name)); n > maxEntityNameLength {
+		return fmt.Errorf("name must be at most %d characters (got %d)", maxEntityNameLength, n)
+	}
+	return nil
+}
+
+// Scoreboard tags are unquoted words in /tag and target selectors.
+var entityTagPattern = regexp.MustCompile(`^[A-Za-z0-9_.+-]+$`)
+
+func validateEntityTags(tags []string) error {
+	for _, t := range tags {
+		if !entityTagPattern.MatchString(t) {
+			return fmt.Errorf("tags may only contain letters, digits and _.+- (got %q)", t)
+		}
+	}
+	return nil
 }
 
 type entityResource struct {
@@ -100,21 +256,68 @@ func (r entityResource) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		return
 	}
 
+	x, z := int(math.Floor(data.Position.X)), int(math.Floor(data.Position.Z))
+	r.provider.warnSpawnProtection(ctx, &resp.Diagnostics, fmt.Sprintf("Entity %s", data.Type), x, z, x, z)
+
 	client, err := r.provider.GetClient(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
 		return
 	}
 
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
 	// Generate a stable UUID and use it as both TF id and the entity's tag/CustomName.
-	id := uuid.NewString()
-	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+	id := r.provider.newEntityID(data.Type, pos)
 
-	if err := client.CreateEntity(ctx, data.Type, pos, id); err != nil {
+	name := ""
+	if data.Name != nil {
+		name = *data.Name
+		if err := validateEntityName(name); err != nil {
+			resp.Diagnostics.AddError("Validation Error", err.Error())
+			return
+		}
+	}
+
+	if err := validateEntityTags(data.Tags); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	if err := validateEquipmentLootTables(data.EquipmentLoot); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	rotation, err := resolveRotation([3]float64{data.Position.X, data.Position.Y, data.Position.Z}, data.Rotation, data.LookAt)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	if data.Equipment != nil {
+		eq, err := data.Equipment.toClient()
+		if err != nil {
+			resp.Diagnostics.AddError("Validation Error", err.Error())
+			return
+		}
+		if err := client.CreateArmoredEntity(ctx, data.Type, pos, id, name, data.NameVisible.Value, eq, r.provider.useItemComponents(), data.NoGravity.Value, data.Invulnerable.Value, data.Silent.Value, rotation, data.Tags, data.DeathLootTable.Value); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon entity: %s", err))
+			return
+		}
+	} else if err := client.CreateEntity(ctx, data.Type, pos, id, name, data.NameVisible.Value, data.NoGravity.Value, data.Invulnerable.Value, data.Silent.Value, rotation, data.Tags, data.DeathLootTable.Value); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon entity: %s", err))
 		return
 	}
 
+	equipFromLoot(ctx, client, data.Type, id, data.EquipmentLoot, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	joinSummonedTeam(ctx, client, data.Type, pos, id, data.Team, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.Id = types.String{Value: id}
 
 	diags = resp.State.Set(ctx, &data)
@@ -155,13 +358,24 @@ func (r entityResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequ
 		return
 	}
 
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("%s %s", data.Type, data.Id.Value)) {
+		return
+	}
+
 	client, err := r.provider.GetClient(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
 		return
 	}
 
-	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+	if data.Vehicle.Value {
+		if err := client.DismountPassengers(ctx, data.Type, data.Id.Value); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to dismount passengers: %s", err))
+			return
+		}
+	}
+
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
 	if err := client.DeleteEntity(ctx, data.Type, pos, data.Id.Value); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete entity: %s", err))
 		return
@@ -172,3 +386,132 @@ func (r entityResource) ImportState(ctx context.Context, req tfsdk.ImportResourc
 	// Import by UUID (id). Caller supplies matching config (type/position) in HCL.
 	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
 }
+
+// silentAttribute returns the schema of a mob's `silent`.
+func silentAttribute(what string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		MarkdownDescription: fmt.Sprintf("If true, the %s makes no sounds, which keeps large farms from drowning out everything else. Defaults to `false`.", what),
+		Optional:            true,
+		Type:                types.BoolType,
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			tfsdk.RequiresReplace(),
+		},
+	}
+}
+
+// deathLootTableAttribute returns the schema of a mob's `death_loot_table`.
+func deathLootTableAttribute(what string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		MarkdownDescription: fmt.Sprintf("Namespaced loot table the %s drops on death instead of its own, e.g. `minecraft:entities/blaze` or a datapack's `farm:drops/gold`. Only mobs drop loot.", what),
+		Optional:            true,
+		Type:                types.StringType,
+		Validators: []tfsdk.AttributeValidator{
+			namespacedID(),
+		},
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			tfsdk.RequiresReplace(),
+		},
+	}
+}
+
+// equipmentLootTableAttribute returns the schema of a mob's `equipment_loot_table`.
+func equipmentLootTableAttribute(what string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		MarkdownDescription: fmt.Sprintf("Loot table per equipment slot, rolled into the slot right after the %s is summoned so each one gets its own random gear, e.g. `{ \"armor.head\" = \"gear:helmets\" }`. Slots are `%s`. Extra stacks spill into the next slot, so use tables that roll one item.", what, strings.Join(minecraft.EquipmentSlots, "`, `")),
+		Optional:            true,
+		Type:                types.MapType{ElemType: types.StringType},
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			tfsdk.RequiresReplace(),
+		},
+	}
+}
+
+func validateEquipmentLootTables(tables map[string]string) error {
+	for slot, table := range tables {
+		if !minecraft.IsEquipmentSlot(slot) {
+			return fmt.Errorf("equipment_loot_table slot must be one of %s (got %q)", strings.Join(minecraft.EquipmentSlots, ", "), slot)
+		}
+		if !namespacedIDPattern.MatchString(table) {
+			return fmt.Errorf("equipment_loot_table %s must be a namespaced loot table such as minecraft:entities/zombie (got %q)", slot, table)
+		}
+	}
+	return nil
+}
+
+// equipFromLoot rolls each configured loot table into its slot on a freshly
+// summoned entity, in EquipmentSlots order.
+func equipFromLoot(ctx context.Context, client *minecraft.Client, entity, id string, tables map[string]string, diags *diag.Diagnostics) {
+	sel := minecraft.SelectorByTag(entity, id)
+	for _, slot := range minecraft.EquipmentSlots {
+		table, ok := tables[slot]
+		if !ok {
+			continue
+		}
+		if err := client.EquipFromLoot(ctx, sel, slot, table); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to equip %s from %s: %s", entity, table, err))
+			return
+		}
+	}
+}
+
+// namespacedIDValidator requires a namespaced ID such as minecraft:entities/zombie.
+type namespacedIDValidator struct{}
+
+func namespacedID() tfsdk.AttributeValidator {
+	return namespacedIDValidator{}
+}
+
+func (v namespacedIDValidator) Description(ctx context.Context) string {
+	return "value must be a namespaced ID such as minecraft:entities/zombie"
+}
+
+func (v namespacedIDValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a namespaced ID such as `minecraft:entities/zombie`"
+}
+
+func (v namespacedIDValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := req.AttributeConfig.(types.String)
+	if !ok || s.Null || s.Unknown {
+		return
+	}
+	if !namespacedIDPattern.MatchString(s.Value) {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Namespaced ID",
+			fmt.Sprintf("%q is not valid; %s.", s.Value, v.Description(ctx)),
+		)
+	}
+}
+
+// entityEquipmentAttributes returns the equipment slots; any change re-summons the entity.
+func entityEquipmentAttributes() map[string]tfsdk.Attribute {
+	attrs := map[string]tfsdk.Attribute{
+		"armor_drop_chances": {
+			MarkdownDescription: "Drop chance for each armor slot as `[feet, legs, chest, head]`. `0` never drops, `2` always drops undamaged.",
+			Type:                types.ListType{ElemType: types.Float64Type},
+			Optional:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+		"hand_drop_chances": {
+			MarkdownDescription: "Drop chance for each hand as `[mainhand, offhand]`. `0` never drops, `2` always drops undamaged.",
+			Type:                types.ListType{ElemType: types.Float64Type},
+			Optional:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		},
+	}
+	for _, slot := range []string{"head", "chest", "legs", "feet", "mainhand", "offhand"} {
+		attrs[slot] = tfsdk.Attribute{
+			MarkdownDescription: fmt.Sprintf("Item ID for the %s slot.", slot),
+			Type:                types.StringType,
+			Optional:            true,
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				tfsdk.RequiresReplace(),
+			},
+		}
+	}
+	return attrs
+}
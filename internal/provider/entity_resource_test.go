@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateDropChances(t *testing.T) {
+	tests := []struct {
+		name    string
+		chances []float64
+		slots   int
+		wantErr bool
+	}{
+		{name: "unset", chances: nil, slots: 4},
+		{name: "bounds", chances: []float64{0, 2, 0.085, 1}, slots: 4},
+		{name: "too few", chances: []float64{0, 0, 0}, slots: 4, wantErr: true},
+		{name: "negative", chances: []float64{-0.1, 0}, slots: 2, wantErr: true},
+		{name: "above two", chances: []float64{0, 2.5}, slots: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateDropChances("drop_chances", tt.chances, tt.slots); (err != nil) != tt.wantErr {
+				t.Errorf("validateDropChances(%v) = %v, wantErr %t", tt.chances, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntityDeleteDismountsPassengersFirst(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := entityResourceType{}.GetSchema(ctx)
+	for _, vehicle := range []bool{true, false} {
+		server := newFakeServer(t, nil)
+		p := configureProvider(t, server.address, nil)
+		state, diags := createResource(t, p, entityResourceType{}, map[string]tftypes.Value{
+			"type":     tftypes.NewValue(tftypes.String, "minecraft:boat"),
+			"position": xyzValue(ctx, schema, "position", 0, 64, 0),
+			"vehicle":  tftypes.NewValue(tftypes.Bool, vehicle),
+		})
+		if diags.HasError() {
+			t.Fatalf("Create: %v", diags)
+		}
+		created := len(server.sent())
+		if diags := deleteResource(t, p, entityResourceType{}, state); diags.HasError() {
+			t.Fatalf("Delete: %v", diags)
+		}
+
+		// Every dismount has to land before the first kill.
+		sent := server.sent()[created:]
+		dismounts, killed := 0, false
+		for _, command := range sent {
+			switch {
+			case strings.HasPrefix(command, "kill "):
+				killed = true
+			case strings.HasSuffix(command, " on passengers run ride @s dismount"):
+				dismounts++
+				if killed {
+					t.Errorf("vehicle=%t: dismount after kill in %q", vehicle, sent)
+				}
+			}
+		}
+		if !killed {
+			t.Errorf("vehicle=%t: delete sent %q, want a kill", vehicle, sent)
+		}
+		if (dismounts > 0) != vehicle {
+			t.Errorf("vehicle=%t: delete sent %d dismounts", vehicle, dismounts)
+		}
+	}
+}
+
+func TestEntityDeleteSendsNoClear(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := entityResourceType{}.GetSchema(ctx)
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+	state, diags := createResource(t, p, entityResourceType{}, map[string]tftypes.Value{
+		"type":     tftypes.NewValue(tftypes.String, "minecraft:zombie"),
+		"position": xyzValue(ctx, schema, "position", 0, 64, 0),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	if diags := deleteResource(t, p, entityResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+	for _, command := range server.sent() {
+		if strings.HasPrefix(command, "clear ") {
+			t.Errorf("delete sent %q; an entity type is not an item ID", command)
+		}
+	}
+}
+
+func TestEntityInvulnerableStillKilledByTag(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := entityResourceType{}.GetSchema(ctx)
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+	state, diags := createResource(t, p, entityResourceType{}, map[string]tftypes.Value{
+		"type":         tftypes.NewValue(tftypes.String, "minecraft:pig"),
+		"position":     xyzValue(ctx, schema, "position", 0, 64, 0),
+		"invulnerable": tftypes.NewValue(tftypes.Bool, true),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	sent := server.sent()
+	if len(sent) == 0 || !strings.HasPrefix(sent[0], "summon minecraft:pig ") || !strings.Contains(sent[0], "Invulnerable:1b") {
+		t.Fatalf("create sent %q, want a summon with Invulnerable:1b", sent)
+	}
+	created := len(sent)
+
+	if diags := deleteResource(t, p, entityResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+	id := stateString(t, state, "id")
+	want := "kill @e[type=minecraft:pig,tag=" + id + "]"
+	if sent := server.sent()[created:]; !containsCommand(sent, want) {
+		t.Errorf("delete sent %q, want %q", sent, want)
+	}
+}
+
+func TestValidateEntityTags(t *testing.T) {
+	if err := validateEntityTags([]string{"boss", "arena.red", "wave_2", "a+b-c"}); err != nil {
+		t.Errorf("valid tags rejected: %v", err)
+	}
+	for _, tag := range []string{"", "two words", "quote\"", "semi;colon"} {
+		if err := validateEntityTags([]string{tag}); err == nil {
+			t.Errorf("%q accepted", tag)
+		}
+	}
+}
+
+func TestEntityTagsInSummon(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := entityResourceType{}.GetSchema(ctx)
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+	state, diags := createResource(t, p, entityResourceType{}, map[string]tftypes.Value{
+		"type":     tftypes.NewValue(tftypes.String, "minecraft:pig"),
+		"position": xyzValue(ctx, schema, "position", 0, 64, 0),
+		"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "boss"),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	want := `{Tags:["` + stateString(t, state, "id") + `","boss"],`
+	if sent := server.sent(); len(sent) != 1 || !strings.Contains(sent[0], want) {
+		t.Errorf("sent %q, want Tags %s", sent, want)
+	}
+}
+
+func TestNamespacedIDValidator(t *testing.T) {
+	v := namespacedID()
+	for _, id := range []string{"minecraft:entities/zombie", "farm:mobs/zombie_drops"} {
+		if resp := validateString(v, types.String{Value: id}); resp.Diagnostics.HasError() {
+			t.Errorf("%q rejected: %v", id, resp.Diagnostics)
+		}
+	}
+	for _, id := range []string{"entities/zombie", "Farm:Drops", "farm:", ""} {
+		if resp := validateString(v, types.String{Value: id}); !resp.Diagnostics.HasError() {
+			t.Errorf("%q accepted", id)
+		}
+	}
+}
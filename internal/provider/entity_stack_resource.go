@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = entityStackResourceType{}
+var _ tfsdk.Resource = entityStackResource{}
+
+// ---------- Resource Type ----------
+
+type entityStackResourceType struct{}
+
+func (t entityStackResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Summon a stack of entities riding each other (e.g. a spider jockey) in one command. Every entity shares a group tag, so the whole stack is killed on destroy.",
+		Attributes: map[string]tfsdk.Attribute{
+			"entities": {
+				MarkdownDescription: fmt.Sprintf("The stack from the bottom up: the first entity is summoned and each next one rides the previous. 1 to %d entries.", minecraft.MaxStackDepth),
+				Required:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"type": {
+						MarkdownDescription: "The entity type (e.g. `minecraft:spider`).",
+						Required:            true,
+						Type:                types.StringType,
+					},
+					"name": {
+						MarkdownDescription: "Optional visible custom name.",
+						Optional:            true,
+						Type:                types.StringType,
+					},
+				}),
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"position": {
+				MarkdownDescription: "Where the bottom entity is summoned. Use `.5` to center it on a block.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "UUID used as the shared group tag.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t entityStackResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return entityStackResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type entityStackEntry struct {
+	Type string       `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+}
+
+type entityStackResourceData struct {
+	Id       types.String       `tfsdk:"id"`
+	Entities []entityStackEntry `tfsdk:"entities"`
+	Position struct {
+		X float64 `tfsdk:"x"`
+		Y float64 `tfsdk:"y"`
+		Z float64 `tfsdk:"z"`
+	} `tfsdk:"position"`
+}
+
+// stack validates the entries and converts them for the client.
+func (d entityStackResourceData) stack() ([]minecraft.StackEntry, error) {
+	if len(d.Entities) < 1 || len(d.Entities) > minecraft.MaxStackDepth {
+		return nil, fmt.Errorf("entities must list between 1 and %d entries (got %d)", minecraft.MaxStackDepth, len(d.Entities))
+	}
+	stack := make([]minecraft.StackEntry, len(d.Entities))
+	for i, e := range d.Entities {
+		if !resourceIDPattern.MatchString(e.Type) {
+			return nil, fmt.Errorf("entities[%d].type must be an entity ID such as minecraft:spider (got %q)", i, e.Type)
+		}
+		if len([]rune(e.Name.Value)) > maxEntityNameLength {
+			return nil, fmt.Errorf("entities[%d].name must be at most %d characters", i, maxEntityNameLength)
+		}
+		stack[i] = minecraft.StackEntry{Entity: e.Type, Name: e.Name.Value}
+	}
+	return stack, nil
+}
+
+// ---------- Resource Impl ----------
+
+type entityStackResource struct {
+	provider provider
+}
+
+func (r entityStackResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data entityStackResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stack, err := data.stack()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
+	id := r.provider.newEntityID("entity_stack", pos)
+
+	if err := client.SummonStack(ctx, pos, id, stack); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon entity stack: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: id}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r entityStackResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data entityStackResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r entityStackResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data entityStackResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r entityStackResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data entityStackResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("entity stack %s", data.Id.Value)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.KillGroup(ctx, data.Id.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to kill entity stack: %s", err))
+		return
+	}
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = executeResourceType{}
+var _ tfsdk.Resource = executeResource{}
+
+// ---------- Resource Type ----------
+
+type executeResourceType struct{}
+
+func (t executeResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Trigger that runs a command as and at each matching entity (`execute as <selector> at @s ... run <command>`) when created. Change `triggers` to run it again; on destroy only `destroy_run` is executed, if set.",
+		Attributes: map[string]tfsdk.Attribute{
+			"as": {
+				MarkdownDescription: "Selector (or player name) whose entities run the command, each at its own position.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"run": {
+				MarkdownDescription: "Command to run, without the leading slash (e.g. `particle minecraft:heart ~ ~1 ~`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"positioned": {
+				MarkdownDescription: "Position override after `at @s`, e.g. `~ ~1 ~`.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"rotated": {
+				MarkdownDescription: "Rotation override as `yaw pitch`, e.g. `~ 0`.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"in": {
+				MarkdownDescription: "Dimension to run in, e.g. `minecraft:the_nether`.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"destroy_run": {
+				MarkdownDescription: "Optional command run in the same context when the resource is destroyed.",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, run the command again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this run.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t executeResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return executeResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type executeResourceData struct {
+	Id         types.String `tfsdk:"id"`
+	As         string       `tfsdk:"as"`
+	Run        string       `tfsdk:"run"`
+	Positioned *string      `tfsdk:"positioned"`  // optional
+	Rotated    *string      `tfsdk:"rotated"`     // optional
+	In         *string      `tfsdk:"in"`          // optional
+	DestroyRun *string      `tfsdk:"destroy_run"` // optional
+	Triggers   types.Map    `tfsdk:"triggers"`
+}
+
+// Player names, or a target selector such as @e[type=minecraft:cow].
+var executeTargetPattern = regexp.MustCompile(`^(@[aeprsn](\[.*\])?|[A-Za-z0-9_]{1,16})$`)
+
+func (d executeResourceData) options() (minecraft.ExecuteOptions, error) {
+	var opts minecraft.ExecuteOptions
+	if !executeTargetPattern.MatchString(strings.TrimSpace(d.As)) {
+		return opts, fmt.Errorf("as must be a player name or target selector such as @e[type=minecraft:cow] (got %q)", d.As)
+	}
+	if strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(d.Run), "/")) == "" {
+		return opts, fmt.Errorf("run must not be empty")
+	}
+	if d.Positioned != nil {
+		opts.Positioned = *d.Positioned
+	}
+	if d.Rotated != nil {
+		opts.Rotated = *d.Rotated
+	}
+	if d.In != nil {
+		opts.In = *d.In
+	}
+	return opts, nil
+}
+
+// ---------- Resource Impl ----------
+
+type executeResource struct {
+	provider provider
+}
+
+func (r executeResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data executeResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, err := data.options()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.ExecuteAs(ctx, strings.TrimSpace(data.As), data.Run, opts); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run command: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r executeResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Nothing persists in game; keep state as-is.
+	var data executeResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r executeResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data executeResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // only destroy_run changes in place; it runs on destroy
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r executeResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data executeResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || data.DestroyRun == nil || strings.TrimSpace(*data.DestroyRun) == "" {
+		return
+	}
+
+	opts, err := data.options()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.ExecuteAs(ctx, strings.TrimSpace(data.As), *data.DestroyRun, opts); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run destroy command: %s", err))
+		return
+	}
+}
@@ -0,0 +1,25 @@
+package provider
+
+import "testing"
+
+func TestExecuteOptionsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		as, run string
+		wantErr bool
+	}{
+		{name: "selector", as: "@e[type=minecraft:cow]", run: "say moo"},
+		{name: "player", as: "Steve", run: "/say hi"},
+		{name: "bad selector", as: "@x", run: "say moo", wantErr: true},
+		{name: "bad player", as: "not a player", run: "say moo", wantErr: true},
+		{name: "empty run", as: "@a", run: " / ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := executeResourceData{As: tt.as, Run: tt.run}.options()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("options() = %v, wantErr %t", err, tt.wantErr)
+			}
+		})
+	}
+}
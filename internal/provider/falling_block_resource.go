@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = fallingBlockResourceType{}
+var _ tfsdk.Resource = fallingBlockResource{}
+
+// ---------- Resource Type ----------
+
+type fallingBlockResourceType struct{}
+
+func (t fallingBlockResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "One-shot trigger that summons a `minecraft:falling_block` at a position when created. Change `triggers` to summon another; destroying it does nothing in game (the block lands or despawns on its own).",
+		Attributes: map[string]tfsdk.Attribute{
+			"position": {
+				MarkdownDescription: "Where the falling block is summoned.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"block_state": {
+				MarkdownDescription: "Block state to drop, e.g. `minecraft:sand` or `minecraft:anvil[facing=north]`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"no_gravity": {
+				MarkdownDescription: "If true, the block hangs in place instead of falling. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"time": {
+				MarkdownDescription: "Initial age in ticks. Falling blocks older than 600 ticks despawn without landing.",
+				Optional:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, summon again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this summon.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t fallingBlockResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return fallingBlockResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type fallingBlockResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Position struct {
+		X int64 `tfsdk:"x"`
+		Y int64 `tfsdk:"y"`
+		Z int64 `tfsdk:"z"`
+	} `tfsdk:"position"`
+	BlockState string      `tfsdk:"block_state"`
+	NoGravity  types.Bool  `tfsdk:"no_gravity"`
+	Time       types.Int64 `tfsdk:"time"`
+	Triggers   types.Map   `tfsdk:"triggers"`
+}
+
+// ---------- Resource Impl ----------
+
+type fallingBlockResource struct {
+	provider provider
+}
+
+func (r fallingBlockResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data fallingBlockResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateBlockState(data.BlockState); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	if data.Time.Value < 0 {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("time must not be negative (got %d)", data.Time.Value))
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+	if err := client.SummonFallingBlock(ctx, pos, data.BlockState, data.NoGravity.Value, int(data.Time.Value)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon falling block: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r fallingBlockResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Nothing persists in game; keep state as-is.
+	var data fallingBlockResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r fallingBlockResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data fallingBlockResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r fallingBlockResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The entity lands or despawns on its own; just drop it from state.
+}
+
+// Accepts `[namespace:]id` with optional `[key=value,...]` properties.
+var blockStatePattern = regexp.MustCompile(`^([a-z0-9_.-]+:)?[a-z0-9_./-]+(\[[a-z0-9_]+=[a-z0-9_]+(,[a-z0-9_]+=[a-z0-9_]+)*\])?$`)
+
+func validateBlockState(state string) error {
+	if !blockStatePattern.MatchString(state) {
+		return fmt.Errorf("invalid block_state %q: expected e.g. minecraft:sand or minecraft:oak_stairs[facing=east]", state)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package provider
+
+import "testing"
+
+func TestValidateBlockState(t *testing.T) {
+	for _, state := range []string{"minecraft:sand", "sand", "minecraft:oak_stairs[facing=east,half=top]"} {
+		if err := validateBlockState(state); err != nil {
+			t.Errorf("validateBlockState(%q): %v", state, err)
+		}
+	}
+	for _, state := range []string{"", "minecraft:Sand", "minecraft:oak_stairs[facing]", "minecraft:sand{}"} {
+		if err := validateBlockState(state); err == nil {
+			t.Errorf("validateBlockState(%q) succeeded, want an error", state)
+		}
+	}
+}
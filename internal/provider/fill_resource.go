@@ -3,17 +3,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ tfsdk.ResourceType = fillResourceType{}
 var _ tfsdk.Resource = fillResource{}
 var _ tfsdk.ResourceWithImportState = fillResource{}
+var _ tfsdk.ResourceWithModifyPlan = fillResource{}
 
 type fillResourceType struct{}
 
@@ -91,6 +96,30 @@ func (t fillResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Dia
 				}),
 			},
 
+			"restore_mode": {
+				MarkdownDescription: "What happens to the region on destroy: `air` (default) clears it, `snapshot` clones the original terrain to the provider's `staging_origin` on create and clones it back on destroy.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(), // the snapshot is taken at create time
+				},
+			},
+
+			"clear_entities": {
+				MarkdownDescription: "If true, every fill is followed by killing the non-player entities (mobs, dropped items, ...) inside the region. Combined with `minecraft:air` this clears an arena in one resource. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+
+			"snapshot_origin": {
+				Computed:            true,
+				Type:                types.StringType,
+				MarkdownDescription: "Where the original terrain is stored (`x y z`) when `restore_mode` is `snapshot`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+
 			"id": {
 				Computed:            true,
 				Type:                types.StringType,
@@ -121,6 +150,9 @@ type fillResourceData struct {
 		Y int `tfsdk:"y"`
 		Z int `tfsdk:"z"`
 	} `tfsdk:"end"`
+	RestoreMode    types.String `tfsdk:"restore_mode"`
+	ClearEntities  types.Bool   `tfsdk:"clear_entities"`
+	SnapshotOrigin types.String `tfsdk:"snapshot_origin"`
 }
 
 type fillResource struct {
@@ -135,27 +167,71 @@ func (r fillResource) Create(ctx context.Context, req tfsdk.CreateResourceReques
 		return
 	}
 
+	snapshot := false
+	switch data.RestoreMode.Value {
+	case "", "air":
+	case "snapshot":
+		snapshot = true
+		if r.provider.stagingOrigin == nil {
+			resp.Diagnostics.AddError("Validation Error", "restore_mode = \"snapshot\" requires `staging_origin` to be set on the provider.")
+			return
+		}
+	default:
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("restore_mode must be one of air|snapshot (got %q)", data.RestoreMode.Value))
+		return
+	}
+
+	r.provider.warnSpawnProtection(ctx, &resp.Diagnostics, fmt.Sprintf("Fill of %s", data.Material),
+		data.Start.X, data.Start.Z, data.End.X, data.End.Z)
+
 	client, err := r.provider.GetClient(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
 		return
 	}
 
-	if err := client.FillBlock(ctx,
+	data.Id = types.String{Value: fmt.Sprintf(
+		"%s|%d,%d,%d->%d,%d,%d",
 		data.Material,
 		data.Start.X, data.Start.Y, data.Start.Z,
 		data.End.X, data.End.Y, data.End.Z,
-	); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill region: %s", err))
-		return
+	)}
+
+	data.SnapshotOrigin = types.String{Null: true}
+	if snapshot {
+		origin, err := r.provider.fillSnapshots.allocate(data.Id.Value, *r.provider.stagingOrigin, regionSize(data), r.provider.buildLimits(),
+			func(corner [3]int) (bool, error) { return client.ClaimStagingSlot(ctx, corner) })
+		if err != nil {
+			resp.Diagnostics.AddError("Snapshot Error", err.Error())
+			return
+		}
+		if err := client.CloneRegion(ctx,
+			data.Start.X, data.Start.Y, data.Start.Z,
+			data.End.X, data.End.Y, data.End.Z,
+			origin[0], origin[1], origin[2],
+		); err != nil {
+			r.provider.releaseSnapshot(ctx, client, origin, &resp.Diagnostics)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to snapshot region to %s: %s", formatCoords(origin), err))
+			return
+		}
+		data.SnapshotOrigin = types.String{Value: formatCoords(origin)}
 	}
 
-	data.Id = types.String{Value: fmt.Sprintf(
-		"%s|%d,%d,%d->%d,%d,%d",
+	if err := client.FillBlock(ctx,
 		data.Material,
 		data.Start.X, data.Start.Y, data.Start.Z,
 		data.End.X, data.End.Y, data.End.Z,
-	)}
+	); err != nil {
+		// /fill changes nothing when it fails, so the snapshot isn't needed.
+		if origin, ok := parseCoords(data.SnapshotOrigin.Value); ok {
+			r.provider.releaseSnapshot(ctx, client, origin, &resp.Diagnostics)
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill region: %s", err))
+		return
+	}
+	if !r.clearEntities(ctx, client, data, &resp.Diagnostics) {
+		return
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -169,6 +245,7 @@ func (r fillResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -196,6 +273,9 @@ func (r fillResource) Update(ctx context.Context, req tfsdk.UpdateResourceReques
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update filled region: %s", err))
 		return
 	}
+	if !r.clearEntities(ctx, client, data, &resp.Diagnostics) {
+		return
+	}
 
 	// ID stays the same unless you want it to include material.
 	// If you prefer material-agnostic ID, comment the next line out.
@@ -218,12 +298,53 @@ func (r fillResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReques
 		return
 	}
 
+	origin, snapshot := parseCoords(data.SnapshotOrigin.Value)
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf(
+		"region %d,%d,%d->%d,%d,%d",
+		data.Start.X, data.Start.Y, data.Start.Z,
+		data.End.X, data.End.Y, data.End.Z,
+	)) {
+		// The staging copy stays too, but its slot is given up so later
+		// snapshots may reuse it.
+		if snapshot {
+			if client, err := r.provider.GetClient(ctx); err != nil {
+				resp.Diagnostics.AddWarning("Snapshot Warning", fmt.Sprintf("The snapshot slot at %s could not be released: %s", data.SnapshotOrigin.Value, err))
+			} else {
+				r.provider.releaseSnapshot(ctx, client, origin, &resp.Diagnostics)
+			}
+		}
+		return
+	}
+
 	client, err := r.provider.GetClient(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
 		return
 	}
 
+	if snapshot {
+		// Clone the original terrain back, then clear the staging copy.
+		size := regionSize(data)
+		min := regionMin(data)
+		if err := client.CloneRegion(ctx,
+			origin[0], origin[1], origin[2],
+			origin[0]+size[0]-1, origin[1]+size[1]-1, origin[2]+size[2]-1,
+			min[0], min[1], min[2],
+		); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore region from snapshot at %s: %s", data.SnapshotOrigin.Value, err))
+			return
+		}
+		if err := client.FillBlock(ctx,
+			"minecraft:air",
+			origin[0], origin[1], origin[2],
+			origin[0]+size[0]-1, origin[1]+size[1]-1, origin[2]+size[2]-1,
+		); err != nil {
+			resp.Diagnostics.AddWarning("Snapshot Warning", fmt.Sprintf("Region restored, but the staging copy at %s could not be cleared: %s", data.SnapshotOrigin.Value, err))
+		}
+		r.provider.releaseSnapshot(ctx, client, origin, &resp.Diagnostics)
+		return
+	}
+
 	if err := client.FillBlock(ctx,
 		"minecraft:air",
 		data.Start.X, data.Start.Y, data.Start.Z,
@@ -234,7 +355,214 @@ func (r fillResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReques
 	}
 }
 
+// releaseSnapshot gives up the staging slot at origin. Failing to remove the
+// claim only leaves that slot unused, so it warns rather than errors.
+func (p *provider) releaseSnapshot(ctx context.Context, client *minecraft.Client, origin [3]int, diags *diag.Diagnostics) {
+	p.fillSnapshots.release(origin)
+	if err := client.ReleaseStagingSlot(ctx, origin); err != nil {
+		diags.AddWarning("Snapshot Warning", fmt.Sprintf("The snapshot slot at %s could not be released: %s", formatCoords(origin), err))
+	}
+}
+
+// clearEntities kills non-player entities in the region when clear_entities
+// is set. It reports false if that failed.
+func (r fillResource) clearEntities(ctx context.Context, client *minecraft.Client, data fillResourceData, diags *diag.Diagnostics) bool {
+	if !data.ClearEntities.Value {
+		return true
+	}
+	from := [3]int{data.Start.X, data.Start.Y, data.Start.Z}
+	to := [3]int{data.End.X, data.End.Y, data.End.Z}
+	if err := client.KillEntitiesInRegion(ctx, from, to, true); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Region filled, but entities inside could not be cleared: %s", err))
+		return false
+	}
+	return true
+}
+
+func (r fillResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Being destroyed.
+		return
+	}
+	var data fillResourceData
+	diags := req.Plan.Get(ctx, &data)
+	if diags.HasError() {
+		// Unknown values (interpolation) can't be compared yet; they are
+		// planned again with their final values at apply.
+		return
+	}
+
+	for _, other := range r.provider.fillRegions.record(data) {
+		resp.Diagnostics.AddWarning(
+			"Overlapping Fill Regions",
+			fmt.Sprintf(
+				"Region %d,%d,%d->%d,%d,%d (%s) overlaps region %d,%d,%d->%d,%d,%d (%s). "+
+					"Terraform applies resources in no guaranteed order, so the overlapping blocks may end up as either material.",
+				data.Start.X, data.Start.Y, data.Start.Z, data.End.X, data.End.Y, data.End.Z, data.Material,
+				other.Start.X, other.Start.Y, other.Start.Z, other.End.X, other.End.Y, other.End.Z, other.Material,
+			),
+		)
+	}
+}
+
 func (r fillResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
 	// Import by ID string. Caller must supply matching config (material/start/end) in HCL.
 	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
 }
+
+// -------- Overlap detection --------
+
+// Terraform plans each resource separately (the provider-level ValidateConfig
+// only ever sees the provider block), so fill regions are collected as they
+// are planned and each new one is compared against those planned before it.
+// Every provider instance, and so every server, keeps its own registry, and
+// Terraform starts fresh instances for each plan or apply, so each resource
+// is recorded once with its current config.
+type fillRegionRegistry struct {
+	mu      sync.Mutex
+	regions []fillResourceData
+}
+
+// record stores the region and returns previously seen regions that overlap it
+// with a different material. A nil registry (unconfigured provider) records nothing.
+func (reg *fillRegionRegistry) record(data fillResourceData) []fillResourceData {
+	if reg == nil {
+		return nil
+	}
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	var conflicts []fillResourceData
+	for _, other := range reg.regions {
+		if other.Material != data.Material && regionsOverlap(data, other) {
+			conflicts = append(conflicts, other)
+		}
+	}
+	reg.regions = append(reg.regions, data)
+	return conflicts
+}
+
+// regionsOverlap reports whether two inclusive cuboids share at least one block.
+// Regions that merely touch (adjacent faces) do not overlap.
+func regionsOverlap(a, b fillResourceData) bool {
+	return spansOverlap(a.Start.X, a.End.X, b.Start.X, b.End.X) &&
+		spansOverlap(a.Start.Y, a.End.Y, b.Start.Y, b.End.Y) &&
+		spansOverlap(a.Start.Z, a.End.Z, b.Start.Z, b.End.Z)
+}
+
+// spansOverlap compares two inclusive ranges whose ends may be given in either order.
+func spansOverlap(a1, a2, b1, b2 int) bool {
+	if a1 > a2 {
+		a1, a2 = a2, a1
+	}
+	if b1 > b2 {
+		b1, b2 = b2, b1
+	}
+	return a1 <= b2 && b1 <= a2
+}
+
+// -------- Snapshots --------
+
+// Snapshots are laid out in slots along +X from the provider's staging_origin.
+// A region's slot is picked from a hash of its ID and probed forward past slots
+// in use, so two fills never share staging space. The chosen slot is kept in
+// state as snapshot_origin.
+//
+// Slots are claimed in the world itself (see minecraft.Client.ClaimStagingSlot),
+// so snapshots taken by other runs, or other provider configurations sharing
+// the staging area, are seen too. Each provider instance also remembers the
+// slots it handed out, so concurrent creates in one run can't race for a slot.
+const (
+	snapshotSlotStride = 256
+	snapshotSlotCount  = 1024
+)
+
+type snapshotAllocator struct {
+	mu   sync.Mutex
+	used map[[3]int]string
+}
+
+func newSnapshotAllocator() *snapshotAllocator {
+	return &snapshotAllocator{used: map[[3]int]string{}}
+}
+
+// allocate finds a free staging slot for a region of the given size, claims it
+// with claim and returns its lowest corner. claim reports false when the slot
+// is already taken. limits is the world's lowest and highest buildable Y.
+func (a *snapshotAllocator) allocate(id string, origin stagingOrigin, size [3]int, limits [2]int, claim func(corner [3]int) (bool, error)) ([3]int, error) {
+	if size[0] > snapshotSlotStride {
+		return [3]int{}, fmt.Errorf("region is %d blocks wide on X; snapshots support at most %d", size[0], snapshotSlotStride)
+	}
+	if top := int(origin.Y) + size[1] - 1; int(origin.Y) < limits[0] || top > limits[1] {
+		return [3]int{}, fmt.Errorf("a %d block tall snapshot at staging_origin y = %d would span y %d..%d, outside the world's %d..%d",
+			size[1], origin.Y, origin.Y, top, limits[0], limits[1])
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(id))
+	start := int(h.Sum32() % snapshotSlotCount)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := 0; i < snapshotSlotCount; i++ {
+		slot := (start + i) % snapshotSlotCount
+		corner := [3]int{int(origin.X) + slot*snapshotSlotStride, int(origin.Y), int(origin.Z)}
+		if _, taken := a.used[corner]; taken {
+			continue
+		}
+		ok, err := claim(corner)
+		if err != nil {
+			return [3]int{}, fmt.Errorf("claiming snapshot slot at %s: %w", formatCoords(corner), err)
+		}
+		if ok {
+			a.used[corner] = id
+			return corner, nil
+		}
+	}
+	return [3]int{}, fmt.Errorf("no free snapshot slots left in the staging area")
+}
+
+func (a *snapshotAllocator) release(corner [3]int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.used, corner)
+}
+
+// regionMin returns the lowest corner of the region, whatever order start/end were given in.
+func regionMin(d fillResourceData) [3]int {
+	return [3]int{minInt(d.Start.X, d.End.X), minInt(d.Start.Y, d.End.Y), minInt(d.Start.Z, d.End.Z)}
+}
+
+// regionSize returns the region's inclusive size along each axis.
+func regionSize(d fillResourceData) [3]int {
+	return [3]int{absInt(d.End.X-d.Start.X) + 1, absInt(d.End.Y-d.Start.Y) + 1, absInt(d.End.Z-d.Start.Z) + 1}
+}
+
+func formatCoords(c [3]int) string {
+	return fmt.Sprintf("%d %d %d", c[0], c[1], c[2])
+}
+
+func parseCoords(s string) ([3]int, bool) {
+	var c [3]int
+	if strings.TrimSpace(s) == "" {
+		return c, false
+	}
+	if _, err := fmt.Sscanf(s, "%d %d %d", &c[0], &c[1], &c[2]); err != nil {
+		return c, false
+	}
+	return c, true
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func absInt(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
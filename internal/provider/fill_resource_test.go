@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// region builds fill data of material between two corners.
+func region(material string, from, to [3]int) fillResourceData {
+	var data fillResourceData
+	data.Material = material
+	data.Start.X, data.Start.Y, data.Start.Z = from[0], from[1], from[2]
+	data.End.X, data.End.Y, data.End.Z = to[0], to[1], to[2]
+	return data
+}
+
+func TestRegionsOverlap(t *testing.T) {
+	base := region("minecraft:stone", [3]int{0, 60, 0}, [3]int{4, 64, 4})
+	tests := []struct {
+		name     string
+		from, to [3]int
+		want     bool
+	}{
+		{name: "touching", from: [3]int{5, 60, 0}, to: [3]int{9, 64, 4}, want: false},
+		{name: "sharing an edge", from: [3]int{4, 64, 4}, to: [3]int{8, 68, 8}, want: true},
+		{name: "overlapping", from: [3]int{2, 62, 2}, to: [3]int{6, 66, 6}, want: true},
+		{name: "inside", from: [3]int{1, 61, 1}, to: [3]int{2, 62, 2}, want: true},
+		{name: "disjoint", from: [3]int{10, 60, 10}, to: [3]int{12, 64, 12}, want: false},
+		{name: "disjoint on one axis", from: [3]int{0, 70, 0}, to: [3]int{4, 74, 4}, want: false},
+		{name: "reversed corners", from: [3]int{6, 66, 6}, to: [3]int{2, 62, 2}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := region("minecraft:glass", tt.from, tt.to)
+			if got := regionsOverlap(base, other); got != tt.want {
+				t.Errorf("regionsOverlap(base, other) = %t, want %t", got, tt.want)
+			}
+			if got := regionsOverlap(other, base); got != tt.want {
+				t.Errorf("regionsOverlap(other, base) = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFillRegionsPerProvider(t *testing.T) {
+	east := configureProvider(t, "east.example:25575", nil)
+	west := configureProvider(t, "west.example:25575", nil)
+
+	stone := region("minecraft:stone", [3]int{0, 60, 0}, [3]int{4, 64, 4})
+	glass := region("minecraft:glass", [3]int{2, 62, 2}, [3]int{6, 66, 6})
+	if got := east.fillRegions.record(stone); len(got) != 0 {
+		t.Errorf("first region conflicts with %v", got)
+	}
+	if got := west.fillRegions.record(glass); len(got) != 0 {
+		t.Errorf("another provider's region conflicts with %v", got)
+	}
+	if got := east.fillRegions.record(region("minecraft:stone", [3]int{2, 62, 2}, [3]int{6, 66, 6})); len(got) != 0 {
+		t.Errorf("same material conflicts with %v", got)
+	}
+	if got := east.fillRegions.record(glass); len(got) != 2 {
+		t.Errorf("overlapping glass conflicts with %v, want both stone regions", got)
+	}
+}
+
+// unclaimedStaging answers staging slot checks as if nothing were claimed yet.
+func unclaimedStaging(command string) string {
+	if strings.HasPrefix(command, "execute if entity") {
+		return "Test failed"
+	}
+	return ""
+}
+
+func TestFillSnapshotCloneCommands(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, unclaimedStaging)
+	providerSchema, _ := New("test")().GetSchema(ctx)
+	p := configureProvider(t, server.address, map[string]tftypes.Value{
+		"staging_origin": xyzValue(ctx, providerSchema, "staging_origin", 100000, 0, 100000),
+	})
+
+	schema, _ := fillResourceType{}.GetSchema(ctx)
+	state, diags := createResource(t, p, fillResourceType{}, map[string]tftypes.Value{
+		"material":     tftypes.NewValue(tftypes.String, "minecraft:stone"),
+		"start":        xyzValue(ctx, schema, "start", 4, 64, 2),
+		"end":          xyzValue(ctx, schema, "end", 0, 60, 0),
+		"restore_mode": tftypes.NewValue(tftypes.String, "snapshot"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	origin, ok := parseCoords(stateString(t, state, "snapshot_origin"))
+	if !ok {
+		t.Fatalf("snapshot_origin = %q", stateString(t, state, "snapshot_origin"))
+	}
+	if diags := deleteResource(t, p, fillResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+
+	claim := fmt.Sprintf("@e[type=minecraft:marker,tag=tf_staging_claim,x=%d,y=%d,z=%d,dx=0,dy=0,dz=0]", origin[0], origin[1], origin[2])
+	want := []string{
+		"execute if entity " + claim,
+		fmt.Sprintf(`summon minecraft:marker %d %d %d {Tags:["tf_staging_claim"]}`, origin[0], origin[1], origin[2]),
+		fmt.Sprintf("clone 4 64 2 0 60 0 %d %d %d replace", origin[0], origin[1], origin[2]),
+		"fill 4 64 2 0 60 0 minecraft:stone hollow",
+		fmt.Sprintf("clone %d %d %d %d %d %d 0 60 0 replace", origin[0], origin[1], origin[2], origin[0]+4, origin[1]+4, origin[2]+2),
+		fmt.Sprintf("fill %d %d %d %d %d %d minecraft:air hollow", origin[0], origin[1], origin[2], origin[0]+4, origin[1]+4, origin[2]+2),
+		"kill " + claim,
+	}
+	if got := server.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q\nwant %q", got, want)
+	}
+}
+
+func TestFillSnapshotReleasedWhenWorldKept(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, unclaimedStaging)
+	providerSchema, _ := New("test")().GetSchema(ctx)
+	p := configureProvider(t, server.address, map[string]tftypes.Value{
+		"staging_origin":             xyzValue(ctx, providerSchema, "staging_origin", 100000, 0, 100000),
+		"prevent_destructive_delete": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	schema, _ := fillResourceType{}.GetSchema(ctx)
+	state, diags := createResource(t, p, fillResourceType{}, map[string]tftypes.Value{
+		"material":     tftypes.NewValue(tftypes.String, "minecraft:stone"),
+		"start":        xyzValue(ctx, schema, "start", 4, 64, 2),
+		"end":          xyzValue(ctx, schema, "end", 0, 60, 0),
+		"restore_mode": tftypes.NewValue(tftypes.String, "snapshot"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	origin, _ := parseCoords(stateString(t, state, "snapshot_origin"))
+	before := len(server.sent())
+	if diags := deleteResource(t, p, fillResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+
+	want := []string{fmt.Sprintf("kill @e[type=minecraft:marker,tag=tf_staging_claim,x=%d,y=%d,z=%d,dx=0,dy=0,dz=0]", origin[0], origin[1], origin[2])}
+	if got := server.sent()[before:]; !reflect.DeepEqual(got, want) {
+		t.Errorf("Delete sent %q, want only the claim released: %q", got, want)
+	}
+	if _, held := p.fillSnapshots.used[origin]; held {
+		t.Errorf("slot %v still held after Delete", origin)
+	}
+}
+
+func TestSnapshotSlotsDontCollide(t *testing.T) {
+	origin := stagingOrigin{X: 100000, Y: 0, Z: 100000}
+	size := [3]int{5, 5, 5}
+	limits := [2]int{-64, 319}
+
+	// Slots claimed in the world, e.g. by an earlier run.
+	claimed := map[[3]int]bool{}
+	claim := func(corner [3]int) (bool, error) {
+		if claimed[corner] {
+			return false, nil
+		}
+		claimed[corner] = true
+		return true, nil
+	}
+
+	// The same ID hashes to the same slot, so the second must probe past it.
+	a := newSnapshotAllocator()
+	first, err := a.allocate("fill", origin, size, limits, claim)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := a.allocate("fill", origin, size, limits, claim)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Fatalf("both snapshots got slot %v", first)
+	}
+	if second[0]-first[0] != snapshotSlotStride && first[0]-second[0] != (snapshotSlotCount-1)*snapshotSlotStride {
+		t.Errorf("second slot %v isn't the one after %v", second, first)
+	}
+
+	// A fresh allocator (a later run) still skips the slots claimed in the world.
+	if third, _ := newSnapshotAllocator().allocate("fill", origin, size, limits, claim); third == first || third == second {
+		t.Errorf("third snapshot reused slot %v", third)
+	}
+}
+
+func TestSnapshotStaysInsideTheWorld(t *testing.T) {
+	claim := func([3]int) (bool, error) { return true, nil }
+	size := [3]int{5, 20, 5}
+	tests := []struct {
+		y      int64
+		limits [2]int
+		ok     bool
+	}{
+		{y: 0, limits: [2]int{-64, 319}, ok: true},
+		{y: -64, limits: [2]int{-64, 319}, ok: true},
+		{y: 300, limits: [2]int{-64, 319}, ok: true},
+		{y: 301, limits: [2]int{-64, 319}, ok: false},
+		{y: -10, limits: [2]int{0, 255}, ok: false},
+		{y: 240, limits: [2]int{0, 255}, ok: false},
+	}
+	for _, tt := range tests {
+		origin := stagingOrigin{X: 100000, Y: tt.y, Z: 100000}
+		_, err := newSnapshotAllocator().allocate("fill", origin, size, tt.limits, claim)
+		if got := err == nil; got != tt.ok {
+			t.Errorf("y = %d in %v: err = %v, want ok = %t", tt.y, tt.limits, err, tt.ok)
+		}
+	}
+}
+
+func TestFillClearEntitiesSparesPlayers(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := fillResourceType{}.GetSchema(ctx)
+	_, diags := createResource(t, p, fillResourceType{}, map[string]tftypes.Value{
+		"material":       tftypes.NewValue(tftypes.String, "minecraft:air"),
+		"start":          xyzValue(ctx, schema, "start", 9, 64, 9),
+		"end":            xyzValue(ctx, schema, "end", 0, 60, 0),
+		"clear_entities": tftypes.NewValue(tftypes.Bool, true),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	sent := server.sent()
+	if want := "kill @e[type=!minecraft:player,x=0,y=60,z=0,dx=9,dy=4,dz=9]"; len(sent) == 0 || sent[len(sent)-1] != want {
+		t.Errorf("sent %q, want the fill followed by %q", sent, want)
+	}
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = forceloadResourceType{}
+var _ tfsdk.Resource = forceloadResource{}
+var _ tfsdk.ResourceWithImportState = forceloadResource{}
+
+type forceloadResourceType struct{}
+
+func (t forceloadResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Keep the chunks covering an area loaded even with no players nearby (wraps `/forceload`).",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"from": {
+				MarkdownDescription: "One corner of the area, in block coordinates.",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(columnCornerAttributes()),
+			},
+			"to": {
+				MarkdownDescription: "The opposite corner of the area, in block coordinates.",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(columnCornerAttributes()),
+			},
+			"id": {
+				Computed:            true,
+				Type:                types.StringType,
+				MarkdownDescription: "Terraform ID for this area (`x1,z1->x2,z2`).",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t forceloadResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return forceloadResource{provider: provider}, diags
+}
+
+type forceloadResourceData struct {
+	Id   types.String `tfsdk:"id"`
+	From struct {
+		X int64 `tfsdk:"x"`
+		Z int64 `tfsdk:"z"`
+	} `tfsdk:"from"`
+	To struct {
+		X int64 `tfsdk:"x"`
+		Z int64 `tfsdk:"z"`
+	} `tfsdk:"to"`
+}
+
+// Vanilla refuses to force-load more than 256 chunks in a single command.
+const maxForceloadChunks = 256
+
+// chunkCount returns how many chunks the area spans.
+func (d forceloadResourceData) chunkCount() int64 {
+	span := func(a, b int64) int64 {
+		ca, cb := floorDiv(a, 16), floorDiv(b, 16)
+		if ca > cb {
+			ca, cb = cb, ca
+		}
+		return cb - ca + 1
+	}
+	return span(d.From.X, d.To.X) * span(d.From.Z, d.To.Z)
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+type forceloadResource struct {
+	provider provider
+}
+
+func (r forceloadResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data forceloadResourceData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if n := data.chunkCount(); n > maxForceloadChunks {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("area spans %d chunks; at most %d can be force-loaded at once", n, maxForceloadChunks))
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.ForceloadAdd(ctx, int(data.From.X), int(data.From.Z), int(data.To.X), int(data.To.Z)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to force-load area: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf("%d,%d->%d,%d", data.From.X, data.From.Z, data.To.X, data.To.Z)}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r forceloadResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data forceloadResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	// Checking both corners catches the common case of someone running `forceload remove all`.
+	for _, corner := range [][2]int64{{data.From.X, data.From.Z}, {data.To.X, data.To.Z}} {
+		loaded, err := client.ForceloadQuery(ctx, int(corner[0]), int(corner[1]))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to query force-loaded chunks: %s", err))
+			return
+		}
+		if !loaded {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r forceloadResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// All attributes are ForceNew; there's nothing to update in place.
+	var data forceloadResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r forceloadResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data forceloadResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.ForceloadRemove(ctx, int(data.From.X), int(data.From.Z), int(data.To.X), int(data.To.Z)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to stop force-loading area: %s", err))
+		return
+	}
+}
+
+func (r forceloadResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// Import by ID string. Caller must supply matching config (from/to) in HCL.
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
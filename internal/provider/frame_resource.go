@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = frameResourceType{}
+var _ tfsdk.Resource = frameResource{}
+
+// ---------- Resource Type ----------
+
+type frameResourceType struct{}
+
+func (t frameResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A wireframe: the 12 edges of a **cuboid region** drawn in one material, e.g. to mark the bounds of a build. Unlike `/fill ... outline`, which builds all six faces, the faces stay open. Each edge is one `/fill`, so edges are capped at 32768 blocks. Destroying it clears the edges with air.",
+		Attributes: map[string]tfsdk.Attribute{
+			"material": {
+				MarkdownDescription: "Block ID to draw the edges with (e.g. `minecraft:gold_block`). Changing it redraws the frame in place.",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"from": {
+				MarkdownDescription: "One corner of the cuboid (inclusive).",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(cornerAttributes()),
+			},
+			"to": {
+				MarkdownDescription: "The opposite corner of the cuboid (inclusive).",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(cornerAttributes()),
+			},
+			"id": {
+				Computed:            true,
+				Type:                types.StringType,
+				MarkdownDescription: "Terraform ID for this frame (`x1,y1,z1->x2,y2,z2`).",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t frameResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return frameResource{provider: provider}, diags
+}
+
+// ---------- Resource Data ----------
+
+type frameResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Material string       `tfsdk:"material"`
+	From     cornerModel  `tfsdk:"from"`
+	To       cornerModel  `tfsdk:"to"`
+}
+
+// ---------- Resource Impl ----------
+
+type frameResource struct {
+	provider provider
+}
+
+func (r frameResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data frameResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.provider.warnSpawnProtection(ctx, &resp.Diagnostics, fmt.Sprintf("Frame of %s", data.Material),
+		int(data.From.X), int(data.From.Z), int(data.To.X), int(data.To.Z))
+
+	r.draw(ctx, data, data.Material, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf(
+		"%d,%d,%d->%d,%d,%d",
+		data.From.X, data.From.Y, data.From.Z,
+		data.To.X, data.To.Y, data.To.Z,
+	)}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r frameResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data frameResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r frameResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// from and to force a new resource, so only material can have changed.
+	var data frameResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.draw(ctx, data, data.Material, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r frameResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data frameResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("frame %s", data.Id.Value)) {
+		return
+	}
+	r.draw(ctx, data, "minecraft:air", &resp.Diagnostics)
+}
+
+// draw fills the frame's edges with material.
+func (r frameResource) draw(ctx context.Context, data frameResourceData, material string, diags *diag.Diagnostics) {
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.FillFrame(ctx, material, data.From.coords(), data.To.coords()); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to fill frame with %s: %s", material, err))
+	}
+}
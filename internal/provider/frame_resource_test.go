@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// frameAttrs configures a frame of material between two corners.
+func frameAttrs(material string, from, to [3]int) map[string]tftypes.Value {
+	ctx := context.Background()
+	schema, _ := frameResourceType{}.GetSchema(ctx)
+	corner := func(name string, c [3]int) tftypes.Value {
+		typ := schema.TerraformType(ctx).(tftypes.Object).AttributeTypes[name]
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"x": tftypes.NewValue(tftypes.Number, c[0]),
+			"y": tftypes.NewValue(tftypes.Number, c[1]),
+			"z": tftypes.NewValue(tftypes.Number, c[2]),
+		})
+	}
+	return map[string]tftypes.Value{
+		"material": tftypes.NewValue(tftypes.String, material),
+		"from":     corner("from", from),
+		"to":       corner("to", to),
+	}
+}
+
+func TestFrameDrawsEdgesAndClearsWithAir(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+	from, to := [3]int{0, 64, 0}, [3]int{4, 68, 4}
+
+	state, diags := createResource(t, p, frameResourceType{}, frameAttrs("minecraft:gold_block", from, to))
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	edges := []string{
+		"fill 0 64 0 4 64 0", "fill 0 64 4 4 64 4", "fill 0 68 0 4 68 0", "fill 0 68 4 4 68 4",
+		"fill 0 64 0 0 68 0", "fill 4 64 0 4 68 0", "fill 0 64 4 0 68 4", "fill 4 64 4 4 68 4",
+		"fill 0 64 0 0 64 4", "fill 0 68 0 0 68 4", "fill 4 64 0 4 64 4", "fill 4 68 0 4 68 4",
+	}
+	withMaterial := func(material string) []string {
+		commands := make([]string, len(edges))
+		for i, edge := range edges {
+			commands[i] = edge + " " + material
+		}
+		return commands
+	}
+	if got, want := server.sent(), withMaterial("minecraft:gold_block"); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Create sent %q, want %q", got, want)
+	}
+	if id := stateString(t, state, "id"); id != "0,64,0->4,68,4" {
+		t.Errorf("id = %q", id)
+	}
+
+	created := len(server.sent())
+	if diags := deleteResource(t, p, frameResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+	if got, want := server.sent()[created:], withMaterial("minecraft:air"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Delete sent %q, want %q", got, want)
+	}
+	for _, command := range server.sent() {
+		if strings.Contains(command, " outline") || strings.Contains(command, " hollow") {
+			t.Errorf("sent %q; a frame fills edges only", command)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -22,12 +23,12 @@ type gamemodeResourceType struct{}
 
 func (t gamemodeResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
-		MarkdownDescription: "Set the default server gamemode or a specific player's gamemode.",
+		MarkdownDescription: "Set the default server gamemode, a specific player's gamemode, or the gamemode of every player matched by a selector.",
 		Attributes: map[string]tfsdk.Attribute{
 			"id": {
 				Type:                types.StringType,
 				Computed:            true,
-				MarkdownDescription: "Resource ID (`default` or `player:<name>`).",
+				MarkdownDescription: "Resource ID (`default`, `player:<name>` or `target:<selector>`).",
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.UseStateForUnknown(),
 				},
@@ -40,15 +41,23 @@ func (t gamemodeResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag
 			"player": {
 				Type:     types.StringType,
 				Optional: true,
-				MarkdownDescription: "If set, applies the mode to this player; otherwise sets the server default.",
+				MarkdownDescription: "If set, applies the mode to this player. Conflicts with `target`; with neither set, the server default is changed.",
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					tfsdk.RequiresReplace(), // switching target identity => ForceNew
 				},
 			},
+			"target": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "If set, applies the mode to every player matched by this selector (e.g. `@a`, `@a[team=red]`). A group can't be snapshotted, so `previous_mode` stays empty and destroy doesn't revert. Conflicts with `player`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
 			"previous_mode": {
 				Type:                types.StringType,
 				Computed:            true,
-				MarkdownDescription: "Best-effort snapshot of the prior mode at create/update time. Used for revert.",
+				MarkdownDescription: "Best-effort snapshot of the prior mode at create/update time. Used for revert. Always empty for `target`.",
 			},
 		},
 	}, nil
@@ -65,6 +74,7 @@ type gamemodeResourceData struct {
 	ID           types.String `tfsdk:"id"`
 	Mode         types.String `tfsdk:"mode"`
 	Player       types.String `tfsdk:"player"`
+	Target       types.String `tfsdk:"target"`
 	PreviousMode types.String `tfsdk:"previous_mode"`
 }
 
@@ -104,11 +114,25 @@ func (r gamemodeResource) Create(ctx context.Context, req tfsdk.CreateResourceRe
 		return
 	}
 
+	player, target, err := gamemodeSubject(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
 	var id string
 	var prev string
 
-	player := strings.TrimSpace(plan.Player.Value)
-	if player == "" {
+	switch {
+	case target != "":
+		id = "target:" + target
+
+		// No snapshot: a selector can match many players with different modes.
+		if err := client.SetUserGameMode(ctx, mode, target); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set %q gamemode to %q: %s", target, mode, err))
+			return
+		}
+	case player == "":
 		id = "default"
 
 		// Snapshot previous default (best effort)
@@ -120,7 +144,7 @@ func (r gamemodeResource) Create(ctx context.Context, req tfsdk.CreateResourceRe
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set default gamemode to %q: %s", mode, err))
 			return
 		}
-	} else {
+	default:
 		id = "player:" + player
 
 		// Snapshot previous player mode (best effort)
@@ -170,8 +194,21 @@ func (r gamemodeResource) Update(ctx context.Context, req tfsdk.UpdateResourceRe
 		return
 	}
 
-	player := strings.TrimSpace(plan.Player.Value)
-	if player == "" {
+	player, target, err := gamemodeSubject(plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	switch {
+	case target != "":
+		plan.PreviousMode = types.String{Value: ""}
+
+		if err := client.SetUserGameMode(ctx, mode, target); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set %q gamemode to %q: %s", target, mode, err))
+			return
+		}
+	case player == "":
 		// Refresh previous_mode for default (best effort)
 		prev := state.PreviousMode.Value
 		if got, e := client.GetDefaultGameMode(ctx); e == nil && got != "" {
@@ -183,7 +220,7 @@ func (r gamemodeResource) Update(ctx context.Context, req tfsdk.UpdateResourceRe
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set default gamemode to %q: %s", mode, err))
 			return
 		}
-	} else {
+	default:
 		// Refresh previous_mode for player (best effort)
 		prev := state.PreviousMode.Value
 		if got, e := client.GetUserGameMode(ctx, player); e == nil && got != "" {
@@ -217,7 +254,8 @@ func (r gamemodeResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRe
 	prev := strings.TrimSpace(state.PreviousMode.Value)
 	player := strings.TrimSpace(state.Player.Value)
 
-	if prev != "" {
+	// Selector targets never have a snapshot, so they are left as they are.
+	if prev != "" && strings.TrimSpace(state.Target.Value) == "" {
 		if player == "" {
 			if err := client.SetDefaultGameMode(ctx, prev); err != nil {
 				resp.Diagnostics.AddWarning("Restore Warning", fmt.Sprintf("Failed to restore default gamemode to %q: %s", prev, err))
@@ -233,10 +271,10 @@ func (r gamemodeResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRe
 }
 
 func (r gamemodeResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
-	// Accept "default" or "player:<name>"
+	// Accept "default", "player:<name>" or "target:<selector>"
 	id := strings.TrimSpace(req.ID)
 	if id == "" {
-		resp.Diagnostics.AddError("Import Error", "Expected `default` or `player:<name>` as import ID.")
+		resp.Diagnostics.AddError("Import Error", "Expected `default`, `player:<name>` or `target:<selector>` as import ID.")
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), id)...)
@@ -252,7 +290,13 @@ func (r gamemodeResource) ImportState(ctx context.Context, req tfsdk.ImportResou
 		return
 	}
 
-	resp.Diagnostics.AddError("Import Error", "Unrecognized import ID. Use `default` or `player:<name>`.")
+	if strings.HasPrefix(id, "target:") {
+		target := strings.TrimPrefix(id, "target:")
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("target"), target)...)
+		return
+	}
+
+	resp.Diagnostics.AddError("Import Error", "Unrecognized import ID. Use `default`, `player:<name>` or `target:<selector>`.")
 }
 
 // ---------- Helpers ----------
@@ -265,3 +309,20 @@ func validateMode(m string) error {
 		return fmt.Errorf("mode must be one of: survival, creative, adventure, spectator (got %q)", m)
 	}
 }
+
+// Player target selectors such as @a or @a[team=red].
+var gamemodeSelectorPattern = regexp.MustCompile(`^@[aeprs](\[.*\])?$`)
+
+// gamemodeSubject returns the trimmed player and target; at most one is set.
+// Both empty means the server default.
+func gamemodeSubject(d gamemodeResourceData) (player, target string, err error) {
+	player = strings.TrimSpace(d.Player.Value)
+	target = strings.TrimSpace(d.Target.Value)
+	if player != "" && target != "" {
+		return "", "", fmt.Errorf("only one of player or target may be set")
+	}
+	if target != "" && !gamemodeSelectorPattern.MatchString(target) {
+		return "", "", fmt.Errorf("target must be a selector such as @a or @a[team=red] (got %q)", target)
+	}
+	return player, target, nil
+}
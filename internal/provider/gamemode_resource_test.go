@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGamemodeSelectorTarget(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	state, diags := createResource(t, p, gamemodeResourceType{}, map[string]tftypes.Value{
+		"mode":   tftypes.NewValue(tftypes.String, "creative"),
+		"target": tftypes.NewValue(tftypes.String, "@a[team=red]"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	if got, want := server.sent(), []string{"gamemode creative @a[team=red]"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+	if id := stateString(t, state, "id"); id != "target:@a[team=red]" {
+		t.Errorf("id = %q", id)
+	}
+	if prev := stateString(t, state, "previous_mode"); prev != "" {
+		t.Errorf("previous_mode = %q, want no snapshot for a selector", prev)
+	}
+}
+
+func TestGamemodeSubject(t *testing.T) {
+	tests := []struct {
+		name                string
+		player, target      string
+		wantPlayer, wantTgt string
+		wantErr             bool
+	}{
+		{name: "default"},
+		{name: "player", player: " Steve ", wantPlayer: "Steve"},
+		{name: "target", target: "@a[team=red]", wantTgt: "@a[team=red]"},
+		{name: "both", player: "Steve", target: "@a", wantErr: true},
+		{name: "bare name as target", target: "Steve", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			player, target, err := gamemodeSubject(gamemodeResourceData{
+				Player: types.String{Value: tt.player},
+				Target: types.String{Value: tt.target},
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if player != tt.wantPlayer || target != tt.wantTgt {
+				t.Errorf("got (%q, %q), want (%q, %q)", player, target, tt.wantPlayer, tt.wantTgt)
+			}
+		})
+	}
+}
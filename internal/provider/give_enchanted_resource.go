@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = giveEnchantedResourceType{}
+var _ tfsdk.Resource = giveEnchantedResource{}
+
+// ---------- Resource Type ----------
+
+type giveEnchantedResourceType struct{}
+
+func (t giveEnchantedResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "One-shot trigger that gives a player an enchanted item when created. Uses item components on 1.20.5+ and the legacy `Enchantments` tag on older servers (see the provider's `server_version`). Change `triggers` to give again; destroying it does nothing in game.",
+		Attributes: map[string]tfsdk.Attribute{
+			"player": {
+				MarkdownDescription: "Player name or selector receiving the item.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"item": {
+				MarkdownDescription: "Item ID, e.g. `minecraft:diamond_sword`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"enchantments": {
+				MarkdownDescription: "Enchantment levels keyed by ID, e.g. `{ \"minecraft:sharpness\" = 5 }`. Levels must be between 1 and 255.",
+				Required:            true,
+				Type:                types.MapType{ElemType: types.Int64Type},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, give the item again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this give.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t giveEnchantedResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return giveEnchantedResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type giveEnchantedResourceData struct {
+	Id           types.String     `tfsdk:"id"`
+	Player       string           `tfsdk:"player"`
+	Item         string           `tfsdk:"item"`
+	Enchantments map[string]int64 `tfsdk:"enchantments"`
+	Triggers     types.Map        `tfsdk:"triggers"`
+}
+
+// Namespaced resource location, e.g. minecraft:sharpness.
+var resourceIDPattern = regexp.MustCompile(`^([a-z0-9_.-]+:)?[a-z0-9_./-]+$`)
+
+// enchants validates the configured enchantments and converts them for the client.
+func (d giveEnchantedResourceData) enchants() (map[string]int, error) {
+	if !resourceIDPattern.MatchString(d.Item) {
+		return nil, fmt.Errorf("invalid item %q: expected e.g. minecraft:diamond_sword", d.Item)
+	}
+
+	ids := make([]string, 0, len(d.Enchantments))
+	for id := range d.Enchantments {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	out := make(map[string]int, len(ids))
+	for _, id := range ids {
+		lvl := d.Enchantments[id]
+		if !resourceIDPattern.MatchString(id) {
+			return nil, fmt.Errorf("invalid enchantment %q: expected e.g. minecraft:sharpness", id)
+		}
+		if lvl < 1 || lvl > 255 {
+			return nil, fmt.Errorf("enchantment %s level must be between 1 and 255 (got %d)", id, lvl)
+		}
+		out[id] = int(lvl)
+	}
+	return out, nil
+}
+
+// ---------- Resource Impl ----------
+
+type giveEnchantedResource struct {
+	provider provider
+}
+
+func (r giveEnchantedResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data giveEnchantedResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enchants, err := data.enchants()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	player := strings.TrimSpace(data.Player)
+	if err := client.GiveEnchantedItem(ctx, player, data.Item, enchants, r.provider.useItemComponents()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to give %s to %s: %s", data.Item, player, err))
+		return
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r giveEnchantedResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// The item belongs to the player now; keep state as-is.
+	var data giveEnchantedResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r giveEnchantedResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data giveEnchantedResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r giveEnchantedResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// A given item can't be taken back reliably; just drop it from state.
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUseItemComponents(t *testing.T) {
+	tests := map[string]bool{
+		"":       true,
+		"1.20.4": false,
+		"1.20.5": true,
+		"1.19":   false,
+		"1.21":   true,
+	}
+	for version, want := range tests {
+		attrs := map[string]tftypes.Value{}
+		if version != "" {
+			attrs["server_version"] = tftypes.NewValue(tftypes.String, version)
+		}
+		p := configureProvider(t, "localhost:25575", attrs)
+		if got := p.useItemComponents(); got != want {
+			t.Errorf("server_version %q: useItemComponents() = %t, want %t", version, got, want)
+		}
+	}
+}
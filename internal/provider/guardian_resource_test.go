@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGuardianTypeAndPersistence(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := guardianResourceType{}.GetSchema(ctx)
+	for _, tt := range []struct {
+		elder, persistent bool
+		entity            string
+	}{
+		{elder: false, persistent: false, entity: "minecraft:guardian"},
+		{elder: true, persistent: true, entity: "minecraft:elder_guardian"},
+	} {
+		server := newFakeServer(t, nil)
+		p := configureProvider(t, server.address, nil)
+
+		state, diags := createResource(t, p, guardianResourceType{}, map[string]tftypes.Value{
+			"position":   xyzValue(ctx, schema, "position", 0.5, 40, 0.5),
+			"elder":      tftypes.NewValue(tftypes.Bool, tt.elder),
+			"persistent": tftypes.NewValue(tftypes.Bool, tt.persistent),
+		})
+		if diags.HasError() {
+			t.Fatalf("%s: Create: %v", tt.entity, diags)
+		}
+		sent := server.sent()
+		if len(sent) != 1 || !strings.HasPrefix(sent[0], "summon "+tt.entity+" 0.5 40 0.5 {") {
+			t.Fatalf("%s: sent %q, want one summon", tt.entity, sent)
+		}
+		if got := strings.Contains(sent[0], "PersistenceRequired:1b"); got != tt.persistent {
+			t.Errorf("%s: summon %q, PersistenceRequired %t, want %t", tt.entity, sent[0], got, tt.persistent)
+		}
+
+		if diags := deleteResource(t, p, guardianResourceType{}, state); diags.HasError() {
+			t.Fatalf("%s: Delete: %v", tt.entity, diags)
+		}
+		want := "kill @e[type=" + tt.entity + ",tag=" + stateString(t, state, "id") + "]"
+		if !containsCommand(server.sent()[1:], want) {
+			t.Errorf("%s: delete sent %q, want %q", tt.entity, server.sent()[1:], want)
+		}
+	}
+}
+
+func TestGuardianSummonFailure(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, func(command string) string {
+		if strings.HasPrefix(command, "summon ") {
+			return "Invalid position for summon"
+		}
+		return ""
+	})
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := guardianResourceType{}.GetSchema(ctx)
+	_, diags := createResource(t, p, guardianResourceType{}, map[string]tftypes.Value{
+		"position": xyzValue(ctx, schema, "position", 0, 40000000, 0),
+	})
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "Invalid position") {
+		t.Errorf("diags = %v, want the server's error", diags)
+	}
+}
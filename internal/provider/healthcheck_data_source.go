@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = healthcheckDataSourceType{}
+var _ tfsdk.DataSource = healthcheckDataSource{}
+
+type healthcheckDataSourceType struct{}
+
+func (t healthcheckDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Checks that the server answers RCON with the configured password by running `list` once. An unreachable server is reported as a warning and `reachable = false`, so other resources can be gated on it, unless `fail_on_unreachable` is set.",
+		Attributes: map[string]tfsdk.Attribute{
+			"fail_on_unreachable": {
+				MarkdownDescription: "If true, an unreachable server is an error instead of a warning. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"reachable": {
+				Computed:            true,
+				MarkdownDescription: "Whether the server answered.",
+				Type:                types.BoolType,
+			},
+			"latency_ms": {
+				Computed:            true,
+				MarkdownDescription: "Round trip time of the `list` command in milliseconds; `0` if unreachable.",
+				Type:                types.Int64Type,
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Always `healthcheck`.",
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t healthcheckDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return healthcheckDataSource{provider: provider}, diags
+}
+
+type healthcheckDataSourceData struct {
+	Id                types.String `tfsdk:"id"`
+	FailOnUnreachable types.Bool   `tfsdk:"fail_on_unreachable"`
+	Reachable         types.Bool   `tfsdk:"reachable"`
+	LatencyMs         types.Int64  `tfsdk:"latency_ms"`
+}
+
+type healthcheckDataSource struct {
+	provider provider
+}
+
+func (d healthcheckDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data healthcheckDataSourceData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.String{Value: "healthcheck"}
+	data.Reachable = types.Bool{Value: false}
+	data.LatencyMs = types.Int64{Value: 0}
+
+	err := d.ping(ctx, &data)
+	if err != nil {
+		summary := "Server Unreachable"
+		detail := fmt.Sprintf("Minecraft server at %s did not answer RCON: %s", d.provider.address, err)
+		if data.FailOnUnreachable.Value {
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+		resp.Diagnostics.AddWarning(summary, detail)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ping connects and times one command, filling reachable and latency_ms.
+func (d healthcheckDataSource) ping(ctx context.Context, data *healthcheckDataSourceData) error {
+	client, err := d.provider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	latency, err := client.Ping(ctx)
+	if err != nil {
+		return err
+	}
+	data.Reachable = types.Bool{Value: true}
+	data.LatencyMs = types.Int64{Value: latency.Milliseconds()}
+	return nil
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// readHealthcheck runs the healthcheck data source against the provider.
+func readHealthcheck(t *testing.T, p *provider, failOnUnreachable bool) (healthcheckDataSourceData, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	schema, _ := healthcheckDataSourceType{}.GetSchema(ctx)
+	ds, diags := healthcheckDataSourceType{}.NewDataSource(ctx, p)
+	if diags.HasError() {
+		t.Fatalf("NewDataSource: %v", diags)
+	}
+	config := objectValue(ctx, schema, map[string]tftypes.Value{
+		"fail_on_unreachable": tftypes.NewValue(tftypes.Bool, failOnUnreachable),
+	})
+	resp := tfsdk.ReadDataSourceResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)}}
+	ds.Read(ctx, tfsdk.ReadDataSourceRequest{Config: tfsdk.Config{Schema: schema, Raw: config}}, &resp)
+
+	var data healthcheckDataSourceData
+	if !resp.State.Raw.IsNull() {
+		if diags := resp.State.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("reading state: %v", diags)
+		}
+	}
+	return data, resp.Diagnostics
+}
+
+// closedAddress returns an address nothing listens on.
+func closedAddress(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	return addr
+}
+
+func TestHealthcheckReachable(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	data, diags := readHealthcheck(t, p, false)
+	if diags.HasError() || len(diags) != 0 {
+		t.Fatalf("Read: %v", diags)
+	}
+	if !data.Reachable.Value || data.LatencyMs.Value < 0 {
+		t.Errorf("reachable = %t, latency_ms = %d", data.Reachable.Value, data.LatencyMs.Value)
+	}
+}
+
+func TestHealthcheckUnreachable(t *testing.T) {
+	p := configureProvider(t, closedAddress(t), nil)
+
+	data, diags := readHealthcheck(t, p, false)
+	if len(diags) != 1 || diags[0].Severity() != diag.SeverityWarning {
+		t.Fatalf("diags = %v, want one warning", diags)
+	}
+	if data.Reachable != (types.Bool{Value: false}) || data.LatencyMs.Value != 0 {
+		t.Errorf("reachable = %v, latency_ms = %d", data.Reachable, data.LatencyMs.Value)
+	}
+
+	if _, diags := readHealthcheck(t, p, true); !diags.HasError() {
+		t.Errorf("fail_on_unreachable: diags = %v, want an error", diags)
+	}
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = itemFrameResourceType{}
+var _ tfsdk.Resource = itemFrameResource{}
+
+// ---------- Resource Type ----------
+
+type itemFrameResourceType struct{}
+
+func (t itemFrameResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "An item frame, optionally holding an item. Changing `rotation` turns the item in place; everything else replaces the frame.",
+		Attributes: map[string]tfsdk.Attribute{
+			"position": {
+				MarkdownDescription: "Where the frame hangs. It needs a solid block behind it (opposite `facing`).",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"facing": {
+				MarkdownDescription: "Direction the frame faces: `north`, `south`, `east`, `west`, `up` or `down`.",
+				Required:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("north", "south", "east", "west", "up", "down"),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"item": {
+				MarkdownDescription: "Item shown in the frame, e.g. `minecraft:diamond_sword`. Leave unset for an empty frame.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"glow": {
+				MarkdownDescription: "If true, summons a glow item frame. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"rotation": {
+				MarkdownDescription: "Item rotation in 45 degree steps, `0` to `7`. Defaults to `0`. Updated in place.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Stable UUID used as the entity's CustomName/tag.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t itemFrameResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return itemFrameResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type itemFrameResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Position struct {
+		X int64 `tfsdk:"x"`
+		Y int64 `tfsdk:"y"`
+		Z int64 `tfsdk:"z"`
+	} `tfsdk:"position"`
+	Facing   string       `tfsdk:"facing"`
+	Item     types.String `tfsdk:"item"`
+	Glow     types.Bool   `tfsdk:"glow"`
+	Rotation types.Int64  `tfsdk:"rotation"`
+}
+
+// applyDefaults fills unset optional values and validates the result.
+func (d *itemFrameResourceData) applyDefaults() error {
+	if d.Glow.Null || d.Glow.Unknown {
+		d.Glow = types.Bool{Value: false}
+	}
+	if d.Rotation.Null || d.Rotation.Unknown {
+		d.Rotation = types.Int64{Value: 0}
+	}
+	if d.Rotation.Value < 0 || d.Rotation.Value > 7 {
+		return fmt.Errorf("rotation must be between 0 and 7 (got %d)", d.Rotation.Value)
+	}
+	if !d.Item.Null && !resourceIDPattern.MatchString(d.Item.Value) {
+		return fmt.Errorf("item must be an item ID such as minecraft:diamond_sword (got %q)", d.Item.Value)
+	}
+	return nil
+}
+
+func (d itemFrameResourceData) entity() string {
+	if d.Glow.Value {
+		return "minecraft:glow_item_frame"
+	}
+	return "minecraft:item_frame"
+}
+
+// ---------- Resource Impl ----------
+
+type itemFrameResource struct {
+	provider provider
+}
+
+func (r itemFrameResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data itemFrameResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.applyDefaults(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	id := uuid.NewString()
+	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+
+	err = client.CreateItemFrame(ctx, pos, id, strings.ToLower(data.Facing), data.Item.Value,
+		int(data.Rotation.Value), data.Glow.Value, r.provider.useItemComponents())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon item frame: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: id}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemFrameResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data itemFrameResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemFrameResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Only rotation changes in place; the rest is ForceNew.
+	var data itemFrameResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.applyDefaults(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SetItemFrameRotation(ctx, data.Id.Value, int(data.Rotation.Value)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate item frame: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemFrameResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data itemFrameResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("item frame at %s", pos)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.DeleteEntity(ctx, data.entity(), pos, data.Id.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete item frame: %s", err))
+		return
+	}
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMarkerSummonAndDelete(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := markerResourceType{}.GetSchema(ctx)
+	state, diags := createResource(t, p, markerResourceType{}, map[string]tftypes.Value{
+		"position": xyzValue(ctx, schema, "position", 4, 70, -8),
+		"data":     tftypes.NewValue(tftypes.String, `{role:"spawn",team:1}`),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	id := stateString(t, state, "id")
+	if diags := deleteResource(t, p, markerResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+
+	want := []string{
+		fmt.Sprintf(`summon minecraft:marker 4 70 -8 {Tags:["%s"],CustomName:'{"text":"%s"}',data:{role:"spawn",team:1}}`, id, id),
+		fmt.Sprintf("kill @e[type=minecraft:marker,tag=%s]", id),
+		fmt.Sprintf(`kill @e[type=minecraft:marker,nbt={CustomName:'{"text":"%s"}'}]`, id),
+	}
+	if got := server.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestMarkerRejectsInvalidData(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := markerResourceType{}.GetSchema(ctx)
+	_, diags := createResource(t, p, markerResourceType{}, map[string]tftypes.Value{
+		"position": xyzValue(ctx, schema, "position", 0, 64, 0),
+		"data":     tftypes.NewValue(tftypes.String, `{role:"spawn"`),
+	})
+	if !diags.HasError() {
+		t.Fatal("Create accepted unbalanced data")
+	}
+	if sent := server.sent(); len(sent) != 0 {
+		t.Errorf("sent %q", sent)
+	}
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure types satisfy framework interfaces
+var _ tfsdk.ResourceType = maxPlayersResourceType{}
+var _ tfsdk.Resource = maxPlayersResource{}
+var _ tfsdk.ResourceWithImportState = maxPlayersResource{}
+
+// -------- Resource Type --------
+
+type maxPlayersResourceType struct{}
+
+func (t maxPlayersResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Sets the server's maximum player count via `setmaxplayers` (Bukkit/Paper only; vanilla servers don't have this command).",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Resource ID. Always `\"default\"` for this global server setting.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"max_players": {
+				Type:                types.Int64Type,
+				Required:            true,
+				MarkdownDescription: "Maximum number of players allowed online. Must be positive.",
+			},
+			"default_max_players": {
+				Type:                types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Value restored when the resource is destroyed. Defaults to `20`.",
+			},
+		},
+	}, nil
+}
+
+func (t maxPlayersResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return maxPlayersResource{provider: p}, diags
+}
+
+// -------- Data & Resource --------
+
+type maxPlayersResourceData struct {
+	ID                types.String `tfsdk:"id"`
+	MaxPlayers        types.Int64  `tfsdk:"max_players"`
+	DefaultMaxPlayers types.Int64  `tfsdk:"default_max_players"`
+}
+
+type maxPlayersResource struct {
+	provider provider
+}
+
+// Vanilla server.properties default for max-players.
+const defaultMaxPlayers = 20
+
+// -------- CRUD --------
+
+func (r maxPlayersResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan maxPlayersResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.MaxPlayers.Value <= 0 {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("max_players must be positive (got %d)", plan.MaxPlayers.Value))
+		return
+	}
+	if plan.DefaultMaxPlayers.Null || plan.DefaultMaxPlayers.Unknown {
+		plan.DefaultMaxPlayers = types.Int64{Value: defaultMaxPlayers}
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SetMaxPlayers(ctx, int(plan.MaxPlayers.Value)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set max players: %s", err))
+		return
+	}
+
+	plan.ID = types.String{Value: "default"}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r maxPlayersResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state maxPlayersResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	_, max, _, err := client.ListPlayers(ctx)
+	if errors.Is(err, minecraft.ErrUnsupported) {
+		// A list reply in an unknown wording; keep the last known value.
+		resp.Diagnostics.AddWarning("Max Players Unknown", fmt.Sprintf("Could not read max players, keeping the value in state: %s", err))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read max players: %s", err))
+		return
+	}
+
+	state.MaxPlayers = types.Int64{Value: int64(max)}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r maxPlayersResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan maxPlayersResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.MaxPlayers.Value <= 0 {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("max_players must be positive (got %d)", plan.MaxPlayers.Value))
+		return
+	}
+	if plan.DefaultMaxPlayers.Null || plan.DefaultMaxPlayers.Unknown {
+		plan.DefaultMaxPlayers = types.Int64{Value: defaultMaxPlayers}
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SetMaxPlayers(ctx, int(plan.MaxPlayers.Value)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set max players: %s", err))
+		return
+	}
+
+	plan.ID = types.String{Value: "default"}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r maxPlayersResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state maxPlayersResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	restore := int(state.DefaultMaxPlayers.Value)
+	if restore <= 0 {
+		restore = defaultMaxPlayers
+	}
+
+	// Best-effort restore; the setting is global so there's nothing else to remove.
+	if err := client.SetMaxPlayers(ctx, restore); err != nil {
+		resp.Diagnostics.AddWarning("Delete Warning", fmt.Sprintf("Failed to restore max players to %d: %s", restore, err))
+	}
+}
+
+func (r maxPlayersResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// Allow: terraform import minecraft_max_players.this default
+	if req.ID != "default" {
+		resp.Diagnostics.AddError("Import Error", "Expected import ID to be \"default\" for the global max players setting.")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), "default")...)
+}
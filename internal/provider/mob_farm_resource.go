@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = mobFarmResourceType{}
+var _ tfsdk.Resource = mobFarmResource{}
+
+type mobFarmResourceType struct{}
+
+func (t mobFarmResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A mob farm core: a spawner for the chosen mob, tuned for farming, with a collection hopper directly below it. Both blocks are placed together; if either fails, the ones already placed are cleared.",
+		Attributes: map[string]tfsdk.Attribute{
+			"mob": {
+				MarkdownDescription: "Entity the spawner produces (e.g. `minecraft:zombie`, `minecraft:blaze`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"position": {
+				MarkdownDescription: "The position of the spawner. The hopper goes one block below.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"min_delay": {
+				MarkdownDescription: "Minimum ticks between spawns. Defaults to `100` (vanilla is 200).",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"max_delay": {
+				MarkdownDescription: "Maximum ticks between spawns. Defaults to `200` (vanilla is 800).",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"spawn_range": {
+				MarkdownDescription: "Horizontal radius mobs spawn in, keeping them near the hopper. Defaults to `2` (vanilla is 4).",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"player_range": {
+				MarkdownDescription: "How close a player must be for the spawner to run. Defaults to `32` (vanilla is 16).",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "ID of the farm",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t mobFarmResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return mobFarmResource{provider: provider}, diags
+}
+
+type mobFarmResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Mob      string       `tfsdk:"mob"`
+	Position struct {
+		X int `tfsdk:"x"`
+		Y int `tfsdk:"y"`
+		Z int `tfsdk:"z"`
+	} `tfsdk:"position"`
+	MinDelay    types.Int64 `tfsdk:"min_delay"`
+	MaxDelay    types.Int64 `tfsdk:"max_delay"`
+	SpawnRange  types.Int64 `tfsdk:"spawn_range"`
+	PlayerRange types.Int64 `tfsdk:"player_range"`
+}
+
+type mobFarmResource struct {
+	provider provider
+}
+
+// Farm-friendly spawner defaults.
+const (
+	defaultFarmMinDelay    = 100
+	defaultFarmMaxDelay    = 200
+	defaultFarmSpawnRange  = 2
+	defaultFarmPlayerRange = 32
+)
+
+// Spawner fields are NBT shorts.
+const maxSpawnerValue = 32767
+
+// applyDefaults fills unset tuning values and validates the result.
+func (d *mobFarmResourceData) applyDefaults() (minecraft.SpawnerConfig, error) {
+	for _, f := range []struct {
+		v   *types.Int64
+		def int64
+	}{
+		{&d.MinDelay, defaultFarmMinDelay},
+		{&d.MaxDelay, defaultFarmMaxDelay},
+		{&d.SpawnRange, defaultFarmSpawnRange},
+		{&d.PlayerRange, defaultFarmPlayerRange},
+	} {
+		if f.v.Null || f.v.Unknown {
+			*f.v = types.Int64{Value: f.def}
+		}
+	}
+
+	cfg := minecraft.SpawnerConfig{
+		MinDelay:    int(d.MinDelay.Value),
+		MaxDelay:    int(d.MaxDelay.Value),
+		SpawnRange:  int(d.SpawnRange.Value),
+		PlayerRange: int(d.PlayerRange.Value),
+	}
+
+	if !resourceIDPattern.MatchString(d.Mob) {
+		return cfg, fmt.Errorf("mob must be an entity ID such as minecraft:zombie (got %q)", d.Mob)
+	}
+	if strings.TrimPrefix(d.Mob, "minecraft:") == "player" {
+		return cfg, fmt.Errorf("spawners can't spawn players")
+	}
+	if cfg.MinDelay < 1 || cfg.MaxDelay > maxSpawnerValue || cfg.MaxDelay < cfg.MinDelay {
+		return cfg, fmt.Errorf("delays must satisfy 1 <= min_delay <= max_delay <= %d (got %d and %d)", maxSpawnerValue, cfg.MinDelay, cfg.MaxDelay)
+	}
+	if cfg.SpawnRange < 1 || cfg.SpawnRange > maxSpawnerValue {
+		return cfg, fmt.Errorf("spawn_range must be between 1 and %d (got %d)", maxSpawnerValue, cfg.SpawnRange)
+	}
+	if cfg.PlayerRange < 1 || cfg.PlayerRange > maxSpawnerValue {
+		return cfg, fmt.Errorf("player_range must be between 1 and %d (got %d)", maxSpawnerValue, cfg.PlayerRange)
+	}
+	return cfg, nil
+}
+
+func (r mobFarmResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data mobFarmResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := data.applyDefaults()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	x, y, z := data.Position.X, data.Position.Y, data.Position.Z
+	err = client.PlaceBlocks(ctx, []minecraft.BlockPlacement{
+		{X: x, Y: y - 1, Z: z, Block: "minecraft:hopper[facing=down]"},
+		{X: x, Y: y, Z: z, Block: minecraft.SpawnerBlock(data.Mob, cfg)},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to build mob farm, got error: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf("mob-farm-%d-%d-%d", x, y, z)}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r mobFarmResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data mobFarmResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r mobFarmResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Only the spawner tuning changes in place; mob and position are ForceNew.
+	var data mobFarmResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cfg, err := data.applyDefaults()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	if err := client.UpdateSpawner(ctx, data.Position.X, data.Position.Y, data.Position.Z, data.Mob, cfg); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update spawner, got error: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r mobFarmResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data mobFarmResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	x, y, z := data.Position.X, data.Position.Y, data.Position.Z
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("mob farm at %d,%d,%d", x, y, z)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	for _, dy := range []int{0, -1} {
+		if err := client.DeleteBlock(ctx, x, y+dy, z); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove mob farm, got error: %s", err))
+			return
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMobFarmPlacesHopperThenSpawner(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := mobFarmResourceType{}.GetSchema(ctx)
+	_, diags := createResource(t, p, mobFarmResourceType{}, map[string]tftypes.Value{
+		"mob":      tftypes.NewValue(tftypes.String, "minecraft:zombie"),
+		"position": xyzValue(ctx, schema, "position", 10, 64, -3),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+
+	sent := server.sent()
+	if len(sent) != 2 {
+		t.Fatalf("sent %q, want a hopper and a spawner", sent)
+	}
+	if want := "setblock 10 63 -3 minecraft:hopper[facing=down] replace"; sent[0] != want {
+		t.Errorf("sent[0] = %q, want %q", sent[0], want)
+	}
+	wantSpawner := `setblock 10 64 -3 minecraft:spawner{SpawnData:{entity:{id:"minecraft:zombie"}},SpawnPotentials:[],Delay:100s,MinSpawnDelay:100s,MaxSpawnDelay:200s,SpawnRange:2s,RequiredPlayerRange:32s} replace`
+	if sent[1] != wantSpawner {
+		t.Errorf("sent[1] = %q, want %q", sent[1], wantSpawner)
+	}
+}
+
+func TestMobFarmRejectsInvalidMob(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := mobFarmResourceType{}.GetSchema(ctx)
+	for _, mob := range []string{"Zombie!", "minecraft:player"} {
+		_, diags := createResource(t, p, mobFarmResourceType{}, map[string]tftypes.Value{
+			"mob":      tftypes.NewValue(tftypes.String, mob),
+			"position": xyzValue(ctx, schema, "position", 0, 64, 0),
+		})
+		if !diags.HasError() || diags[0].Summary() != "Validation Error" {
+			t.Errorf("mob %q: diags = %v, want a validation error", mob, diags)
+		}
+	}
+	if sent := server.sent(); len(sent) != 0 {
+		t.Errorf("sent %q for invalid mobs", sent)
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure types satisfy framework interfaces
+var _ tfsdk.ResourceType = motdResourceType{}
+var _ tfsdk.Resource = motdResource{}
+
+// -------- Resource Type --------
+
+type motdResourceType struct{}
+
+func (t motdResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Sets the server list message (MOTD) via `setmotd`. Requires a Bukkit/Paper plugin that provides the command; vanilla reads the MOTD from `server.properties` and cannot change it over RCON, so applying against vanilla fails. Destroying the resource leaves the current MOTD in place.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Resource ID. Always `\"default\"` for this global server setting.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"motd": {
+				Type:                types.StringType,
+				Required:            true,
+				MarkdownDescription: "Message shown in the multiplayer server list. Formatting codes are passed through to the plugin; raw line breaks are rejected.",
+			},
+		},
+	}, nil
+}
+
+func (t motdResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return motdResource{provider: p}, diags
+}
+
+// -------- Data & Resource --------
+
+type motdResourceData struct {
+	ID   types.String `tfsdk:"id"`
+	Motd types.String `tfsdk:"motd"`
+}
+
+type motdResource struct {
+	provider provider
+}
+
+func (r motdResource) apply(ctx context.Context, plan *motdResourceData, diags *diag.Diagnostics) {
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SetMOTD(ctx, plan.Motd.Value); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set MOTD: %s", err))
+		return
+	}
+
+	plan.ID = types.String{Value: "default"}
+}
+
+// -------- CRUD --------
+
+func (r motdResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan motdResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r motdResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// There is no RCON command to read the MOTD back, so keep state as-is.
+	var state motdResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r motdResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan motdResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r motdResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The previous MOTD isn't known, so there's nothing sensible to restore.
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = moveResourceType{}
+var _ tfsdk.Resource = moveResource{}
+
+// ---------- Resource Type ----------
+
+type moveResourceType struct{}
+
+func (t moveResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Moves the blocks between `from` and `to` so their lowest corner lands at `destination`, leaving air behind (`clone ... move`). Unlike a copy, the source is emptied; destroying the resource moves the blocks back. Source and destination must not overlap.",
+		Attributes: map[string]tfsdk.Attribute{
+			"from": {
+				MarkdownDescription: "One corner of the source region.",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(cornerAttributes()),
+			},
+			"to": {
+				MarkdownDescription: "The opposite corner of the source region.",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(cornerAttributes()),
+			},
+			"destination": {
+				MarkdownDescription: "Where the lowest corner of the moved region ends up.",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(cornerAttributes()),
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this move.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t moveResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return moveResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type moveResourceData struct {
+	Id          types.String `tfsdk:"id"`
+	From        cornerModel  `tfsdk:"from"`
+	To          cornerModel  `tfsdk:"to"`
+	Destination cornerModel  `tfsdk:"destination"`
+}
+
+// source returns the lowest and highest corners of the source region.
+func (d moveResourceData) source() (lo, hi [3]int) {
+	from, to := d.From.coords(), d.To.coords()
+	for i := range from {
+		lo[i] = minInt(from[i], to[i])
+		hi[i] = lo[i] + absInt(to[i]-from[i])
+	}
+	return lo, hi
+}
+
+// target returns the lowest and highest corners of the destination region.
+func (d moveResourceData) target() (lo, hi [3]int) {
+	srcLo, srcHi := d.source()
+	lo = d.Destination.coords()
+	for i := range lo {
+		hi[i] = lo[i] + srcHi[i] - srcLo[i]
+	}
+	return lo, hi
+}
+
+// validate rejects moves whose source and destination share a block.
+func (d moveResourceData) validate() error {
+	srcLo, srcHi := d.source()
+	dstLo, dstHi := d.target()
+	for i := range srcLo {
+		if !spansOverlap(srcLo[i], srcHi[i], dstLo[i], dstHi[i]) {
+			return nil
+		}
+	}
+	return fmt.Errorf("destination %v..%v overlaps the source region %v..%v; the game can't move a region onto itself", dstLo, dstHi, srcLo, srcHi)
+}
+
+// ---------- Resource Impl ----------
+
+type moveResource struct {
+	provider provider
+}
+
+func (r moveResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data moveResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	srcLo, srcHi := data.source()
+	if err := client.MoveRegion(ctx, srcLo, srcHi, data.Destination.coords()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move region: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r moveResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data moveResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r moveResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data moveResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r moveResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data moveResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dstLo, dstHi := data.target()
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("region moved to %v", dstLo)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	srcLo, _ := data.source()
+	if err := client.MoveRegion(ctx, dstLo, dstHi, srcLo); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move region back: %s", err))
+		return
+	}
+}
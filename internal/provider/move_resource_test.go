@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMoveRegionCommands(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := moveResourceType{}.GetSchema(ctx)
+	state, diags := createResource(t, p, moveResourceType{}, map[string]tftypes.Value{
+		"from":        xyzValue(ctx, schema, "from", 4, 64, 2),
+		"to":          xyzValue(ctx, schema, "to", 0, 60, 0),
+		"destination": xyzValue(ctx, schema, "destination", 10, 60, 0),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	if diags := deleteResource(t, p, moveResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+
+	want := []string{
+		"clone 0 60 0 4 64 2 10 60 0 replace move",
+		"clone 10 60 0 14 64 2 0 60 0 replace move",
+	}
+	if got := server.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestMoveOverlapValidation(t *testing.T) {
+	corner := func(x, y, z int64) cornerModel { return cornerModel{X: x, Y: y, Z: z} }
+	tests := []struct {
+		name    string
+		dest    cornerModel
+		wantErr bool
+	}{
+		{name: "beside", dest: corner(5, 60, 0)},
+		{name: "above", dest: corner(0, 65, 0)},
+		{name: "touching corner", dest: corner(4, 64, 2), wantErr: true},
+		{name: "shifted by one", dest: corner(1, 60, 0), wantErr: true},
+		{name: "onto itself", dest: corner(0, 60, 0), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := moveResourceData{From: corner(4, 64, 2), To: corner(0, 60, 0), Destination: tt.dest}
+			if err := d.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() = %v, wantErr %t", err, tt.wantErr)
+			}
+		})
+	}
+}
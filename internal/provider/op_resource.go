@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
 )
 
 // Ensure types satisfy framework interfaces
@@ -101,18 +102,40 @@ func (r opResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest,
 }
 
 func (r opResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
-	// No straightforward, portable RCON query to verify op list in this minimal version.
-	// Keep state as-is; drift detection can be added later if you expose an API to list ops.
+	// RCON can't list ops, so drift is only detected from ops.json when
+	// server_data_dir is set; otherwise state is kept as-is.
 	var state opResourceData
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if r.provider.serverDataDir != "" {
+		ops, err := minecraft.ReadOpsFile(r.provider.serverDataDir)
+		if err != nil {
+			resp.Diagnostics.AddError("Read Error", fmt.Sprintf("Unable to read ops.json: %s", err))
+			return
+		}
+		if !isOp(ops, strings.TrimSpace(state.Player.Value)) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+	}
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
+// isOp reports whether player appears in ops (names are case-insensitive).
+func isOp(ops []minecraft.OpEntry, player string) bool {
+	for _, op := range ops {
+		if strings.EqualFold(op.Name, player) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r opResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
 	// No updatable attributes; `player` is ForceNew. Just keep plan as state.
 	var plan opResourceData
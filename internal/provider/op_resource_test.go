@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOpReadWithoutDataDirKeepsState(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+	state, diags := createResource(t, p, opResourceType{}, map[string]tftypes.Value{
+		"player": tftypes.NewValue(tftypes.String, "Steve"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	created := len(server.sent())
+
+	got, diags := readResource(t, p, opResourceType{}, state)
+	if diags.HasError() {
+		t.Fatalf("Read: %v", diags)
+	}
+	if got.Raw.IsNull() || stateString(t, got, "player") != "Steve" {
+		t.Errorf("Read dropped or changed state: %v", got.Raw)
+	}
+	if sent := server.sent()[created:]; len(sent) != 0 {
+		t.Errorf("Read sent %q; op status can't be checked without changing it", sent)
+	}
+}
+
+func TestOpReadFromOpsFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "ops.json"), []byte(`[{"uuid":"u-1","name":"Alex","level":4,"bypassesPlayerLimit":false}]`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, map[string]tftypes.Value{
+		"server_data_dir": tftypes.NewValue(tftypes.String, dir),
+	})
+	for player, kept := range map[string]bool{"Alex": true, "Steve": false} {
+		state, diags := createResource(t, p, opResourceType{}, map[string]tftypes.Value{
+			"player": tftypes.NewValue(tftypes.String, player),
+		})
+		if diags.HasError() {
+			t.Fatalf("Create: %v", diags)
+		}
+		got, diags := readResource(t, p, opResourceType{}, state)
+		if diags.HasError() {
+			t.Fatalf("Read: %v", diags)
+		}
+		if got.Raw.IsNull() == kept {
+			t.Errorf("%s: kept in state = %t, want %t", player, !got.Raw.IsNull(), kept)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package provider
+
+import "testing"
+
+func TestProjectileMotionLimit(t *testing.T) {
+	var d projectileResourceData
+	d.Entity = "minecraft:snowball"
+	d.Motion.X, d.Motion.Y, d.Motion.Z = 6, 0, 8
+	if err := d.validate(); err != nil {
+		t.Errorf("speed 10: %s", err)
+	}
+	d.Motion.Y = 0.5
+	if err := d.validate(); err == nil {
+		t.Error("speed above 10 accepted")
+	}
+}
@@ -3,8 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,16 +19,63 @@ import (
 var _ tfsdk.Provider = &provider{}
 
 type provider struct {
-	address  string
-	password string
+	address        string
+	password       string
+	commandTimeout time.Duration
+	commandRetries int
+	stagingOrigin  *stagingOrigin
+
+	// limiter is shared by every client the provider hands out.
+	limiter *minecraft.RateLimiter
+	// clients holds the connections this provider has opened. Each
+	// configured provider, aliases included, gets its own.
+	clients *clientCache
+
+	preventDestructiveDelete bool
+	idempotentWrites         bool
+	checkSpawnProtection     bool
+	serverVersion            string
+	serverDataDir            string
+
+	// fillRegions holds the fill regions planned so far, to warn about overlaps.
+	fillRegions *fillRegionRegistry
+	// fillSnapshots tracks the staging slots handed out to fill snapshots.
+	fillSnapshots *snapshotAllocator
+	// spawnArea caches the world spawn and protection radius across resources.
+	spawnArea *spawnArea
+	// detectedVersion caches the release asked of the server when
+	// server_version is unset.
+	detectedVersion *detectedVersion
+	// entityIDs derives entity ids from deterministic_ids; nil means random.
+	entityIDs *entityIDGenerator
 
 	configured bool
 	version    string
 }
 
 type providerData struct {
-	Address  types.String `tfsdk:"address"`
-	Password types.String `tfsdk:"password"`
+	Address           types.String   `tfsdk:"address"`
+	Password          types.String   `tfsdk:"password"`
+	CommandTimeout    types.String   `tfsdk:"command_timeout"`
+	CommandRetries    types.Int64    `tfsdk:"command_retries"`
+	CommandsPerSecond types.Float64  `tfsdk:"commands_per_second"`
+	StagingOrigin     *stagingOrigin `tfsdk:"staging_origin"`
+	DeterministicIDs  *struct {
+		Seed string `tfsdk:"seed"`
+	} `tfsdk:"deterministic_ids"`
+
+	PreventDestructiveDelete types.Bool   `tfsdk:"prevent_destructive_delete"`
+	IdempotentWrites         types.Bool   `tfsdk:"idempotent_writes"`
+	CheckSpawnProtection     types.Bool   `tfsdk:"check_spawn_protection"`
+	ServerVersion            types.String `tfsdk:"server_version"`
+	ServerDataDir            types.String `tfsdk:"server_data_dir"`
+}
+
+// stagingOrigin is the corner of the out-of-the-way area used to hold fill snapshots.
+type stagingOrigin struct {
+	X int64 `tfsdk:"x"`
+	Y int64 `tfsdk:"y"`
+	Z int64 `tfsdk:"z"`
 }
 
 func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
@@ -65,20 +117,355 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 		return
 	}
 
+	var commandTimeout time.Duration
+	if !data.CommandTimeout.Null && data.CommandTimeout.Value != "" {
+		d, err := time.ParseDuration(data.CommandTimeout.Value)
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddError(
+				"Invalid command timeout",
+				fmt.Sprintf("command_timeout must be a positive duration such as \"30s\" (got %q)", data.CommandTimeout.Value),
+			)
+			return
+		}
+		commandTimeout = d
+	}
+
+	if data.CommandRetries.Value < 0 {
+		resp.Diagnostics.AddError(
+			"Invalid command retries",
+			fmt.Sprintf("command_retries must not be negative (got %d)", data.CommandRetries.Value),
+		)
+		return
+	}
+
+	if data.CommandsPerSecond.Value < 0 {
+		resp.Diagnostics.AddError(
+			"Invalid commands per second",
+			fmt.Sprintf("commands_per_second must not be negative (got %g)", data.CommandsPerSecond.Value),
+		)
+		return
+	}
+
 	p.address = address
 	p.password = password
+	p.commandTimeout = commandTimeout
+	p.commandRetries = int(data.CommandRetries.Value)
+	p.limiter = minecraft.NewRateLimiter(data.CommandsPerSecond.Value)
+	p.clients = newClientCache()
+	p.stagingOrigin = data.StagingOrigin
+	p.preventDestructiveDelete = data.PreventDestructiveDelete.Value
+	p.fillRegions = &fillRegionRegistry{}
+	p.fillSnapshots = newSnapshotAllocator()
+	p.idempotentWrites = data.IdempotentWrites.Value
+	p.checkSpawnProtection = data.CheckSpawnProtection.Value
+	p.spawnArea = &spawnArea{}
+	p.serverDataDir = data.ServerDataDir.Value
+
+	if !data.ServerVersion.Null && data.ServerVersion.Value != "" {
+		if _, err := parseServerVersion(data.ServerVersion.Value); err != nil {
+			resp.Diagnostics.AddError("Invalid server version", err.Error())
+			return
+		}
+		p.serverVersion = data.ServerVersion.Value
+	}
+	p.detectedVersion = &detectedVersion{}
+	p.entityIDs = nil
+	if data.DeterministicIDs != nil {
+		p.entityIDs = newEntityIDGenerator(data.DeterministicIDs.Seed)
+	}
+
 	p.configured = true
 }
 
+// parseServerVersion splits a release such as "1.20.4" into numeric parts.
+func parseServerVersion(v string) ([3]int, error) {
+	parts, err := minecraft.ParseVersion(v)
+	if err != nil {
+		return parts, fmt.Errorf("server_version must look like 1.20 or 1.20.4 (got %q)", v)
+	}
+	return parts, nil
+}
+
+// detectedVersion is the release the server reports, asked once per provider.
+type detectedVersion struct {
+	once    sync.Once
+	version string
+}
+
+// release returns the server_version. When it isn't configured the server
+// is asked the first time a resource needs it, not in Configure, so a plan
+// that never talks to the server doesn't dial it. "" means unknown.
+func (p *provider) release() string {
+	if p.serverVersion != "" || p.detectedVersion == nil {
+		return p.serverVersion
+	}
+	p.detectedVersion.once.Do(func() {
+		p.detectedVersion.version = p.detectServerVersion(context.Background())
+	})
+	return p.detectedVersion.version
+}
+
+// detectServerVersion asks the server for its release. Servers without a
+// `version` command, or that can't be reached, give "", which assumes a
+// current release.
+func (p *provider) detectServerVersion(ctx context.Context) string {
+	client, err := p.dial()
+	if err != nil {
+		return ""
+	}
+	v, err := client.GetVersion(ctx)
+	if err != nil {
+		return ""
+	}
+	if _, err := parseServerVersion(v); err != nil {
+		return ""
+	}
+	return v
+}
+
+// serverAtLeast reports whether the server_version, configured or detected,
+// is min or newer. Without one we assume a current server.
+func (p *provider) serverAtLeast(min [3]int) bool {
+	release := p.release()
+	if release == "" {
+		return true
+	}
+	v, err := parseServerVersion(release)
+	if err != nil {
+		return true
+	}
+	return minecraft.CompareVersions(v, min) >= 0
+}
+
+// useItemComponents reports whether item arguments should use the component
+// syntax (1.20.5+).
+func (p *provider) useItemComponents() bool {
+	return p.serverAtLeast([3]int{1, 20, 5})
+}
+
+// useResourceLocationModifiers reports whether attribute modifiers are keyed
+// by resource location (1.21+) rather than UUID and name.
+func (p *provider) useResourceLocationModifiers() bool {
+	return p.serverAtLeast([3]int{1, 21, 0})
+}
+
+// maxHealthAttribute returns the max health attribute ID, which lost its
+// "generic." prefix in 1.21.2.
+func (p *provider) maxHealthAttribute() string {
+	if p.serverAtLeast([3]int{1, 21, 2}) {
+		return "minecraft:max_health"
+	}
+	return "minecraft:generic.max_health"
+}
+
+// buildLimits returns the lowest and highest Y blocks can be placed at, which
+// grew from 0..255 to -64..319 in 1.18.
+func (p *provider) buildLimits() [2]int {
+	if p.serverAtLeast([3]int{1, 18, 0}) {
+		return [2]int{-64, 319}
+	}
+	return [2]int{0, 255}
+}
+
 func (p *provider) GetClient(ctx context.Context) (*minecraft.Client, error) {
-	client, err := minecraft.New(p.address, p.password)
+	client, err := p.dial()
 	if err != nil {
 		return nil, err
 	}
+	if v, err := parseServerVersion(p.release()); err == nil {
+		client.SetVersion(v)
+	}
 
 	return client, nil
 }
 
+// dial returns a client with the provider's command settings but no server
+// version, which GetClient adds. The first call connects; later ones share
+// that connection until it breaks.
+func (p *provider) dial() (*minecraft.Client, error) {
+	if p.clients == nil {
+		return nil, fmt.Errorf("provider is not configured")
+	}
+	return p.clients.get(p.address, func() (*minecraft.Client, error) {
+		client, err := minecraft.New(p.address, p.password)
+		if err != nil {
+			return nil, err
+		}
+		client.SetCommandTimeout(p.commandTimeout)
+		client.SetCommandRetries(p.commandRetries)
+		client.SetRateLimiter(p.limiter)
+		return client, nil
+	})
+}
+
+// clientCache keeps one connection per server, keyed by host:port, so
+// resources don't each open their own. A Client serializes its commands, so
+// sharing one is safe. A connection that breaks, e.g. because the server
+// restarted, is replaced on the next get.
+type clientCache struct {
+	mu    sync.Mutex
+	conns map[string]*minecraft.Client
+}
+
+func newClientCache() *clientCache {
+	return &clientCache{conns: map[string]*minecraft.Client{}}
+}
+
+// get returns a copy of the client for address, connecting with connect the
+// first time and whenever the cached connection is broken. Copies share the
+// connection, its lock and its broken state, but not their settings, so
+// callers may change them. Failed connections aren't cached.
+//
+// connect runs without the lock held, so a slow server doesn't hold up
+// lookups for the others. If two callers connect at once, the first to
+// finish wins and the other's connection is dropped.
+func (c *clientCache) get(address string, connect func() (*minecraft.Client, error)) (*minecraft.Client, error) {
+	host, port, err := minecraft.SplitAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	key := net.JoinHostPort(host, strconv.Itoa(port))
+
+	c.mu.Lock()
+	client, ok := c.conns[key]
+	c.mu.Unlock()
+	if !ok || client.Broken() {
+		fresh, err := connect()
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		if client, ok = c.conns[key]; !ok || client.Broken() {
+			client = fresh
+			c.conns[key] = client
+		}
+		c.mu.Unlock()
+	}
+	copied := *client
+	return &copied, nil
+}
+
+// entityIDGenerator derives entity ids from a seed and each resource's type
+// and position, so the same configuration gets the same ids on every run.
+// Resources sharing a type and position are numbered in the order they are
+// created within the run.
+type entityIDGenerator struct {
+	namespace uuid.UUID
+
+	mu   sync.Mutex
+	seen map[string]int
+}
+
+func newEntityIDGenerator(seed string) *entityIDGenerator {
+	return &entityIDGenerator{namespace: uuid.NewSHA1(uuid.NameSpaceOID, []byte(seed)), seen: map[string]int{}}
+}
+
+func (g *entityIDGenerator) next(key string) string {
+	g.mu.Lock()
+	n := g.seen[key]
+	g.seen[key]++
+	g.mu.Unlock()
+	if n > 0 {
+		key = fmt.Sprintf("%s#%d", key, n)
+	}
+	return uuid.NewSHA1(g.namespace, []byte(key)).String()
+}
+
+// newEntityID returns the id (tag and CustomName) for a summoned entity of
+// kind at position: a random UUID, or one derived from them under
+// deterministic_ids.
+func (p *provider) newEntityID(kind, position string) string {
+	if p.entityIDs == nil {
+		return uuid.NewString()
+	}
+	return p.entityIDs.next(kind + "@" + position)
+}
+
+// keepWorldOnDelete reports whether a Delete should only drop the resource from
+// state, leaving the world untouched. It adds a warning naming what was kept.
+func (p *provider) keepWorldOnDelete(diags *diag.Diagnostics, what string) bool {
+	if !p.preventDestructiveDelete {
+		return false
+	}
+	diags.AddWarning(
+		"Destructive Delete Prevented",
+		fmt.Sprintf("prevent_destructive_delete is set: %s was removed from state but left in the world.", what),
+	)
+	return true
+}
+
+// spawnArea is the spawn protection zone, looked up once per provider.
+type spawnArea struct {
+	once   sync.Once
+	spawn  [3]int
+	radius int
+	err    error
+}
+
+// load fetches the world spawn and reads the radius from server.properties,
+// falling back to the vanilla default without a server_data_dir.
+func (a *spawnArea) load(ctx context.Context, p *provider) error {
+	a.once.Do(func() {
+		a.radius = minecraft.DefaultSpawnProtection
+		if p.serverDataDir != "" {
+			if a.radius, a.err = minecraft.ReadSpawnProtection(p.serverDataDir); a.err != nil {
+				return
+			}
+		}
+		client, err := p.GetClient(ctx)
+		if err != nil {
+			a.err = err
+			return
+		}
+		a.spawn, a.err = client.GetWorldSpawn(ctx)
+	})
+	return a.err
+}
+
+// inSpawnProtection reports whether any column of the x/z box lies within
+// radius of spawn, using the game's square (Chebyshev) distance. A radius of
+// 0 or less disables protection.
+func inSpawnProtection(spawn [3]int, radius int, x1, z1, x2, z2 int) bool {
+	if radius <= 0 {
+		return false
+	}
+	return spanDistance(spawn[0], x1, x2) <= radius && spanDistance(spawn[2], z1, z2) <= radius
+}
+
+// spanDistance is how far v lies outside the inclusive range a..b (in either order).
+func spanDistance(v, a, b int) int {
+	if a > b {
+		a, b = b, a
+	}
+	switch {
+	case v < a:
+		return a - v
+	case v > b:
+		return v - b
+	}
+	return 0
+}
+
+// warnSpawnProtection adds a warning when check_spawn_protection is set and
+// the x/z box overlaps spawn protection. A failed lookup is only a warning too.
+func (p *provider) warnSpawnProtection(ctx context.Context, diags *diag.Diagnostics, what string, x1, z1, x2, z2 int) {
+	if !p.checkSpawnProtection {
+		return
+	}
+	if err := p.spawnArea.load(ctx, p); err != nil {
+		diags.AddWarning("Spawn Protection Check Failed", fmt.Sprintf("Unable to look up spawn protection: %s", err))
+		return
+	}
+	a := p.spawnArea
+	if inSpawnProtection(a.spawn, a.radius, x1, z1, x2, z2) {
+		diags.AddWarning(
+			"Inside Spawn Protection",
+			fmt.Sprintf("%s is within %d blocks of the world spawn at %d %d %d. Non-op players can't break, place or use blocks there, which often looks like nothing happened.", what, a.radius, a.spawn[0], a.spawn[1], a.spawn[2]),
+		)
+	}
+}
+
 func (p *provider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
 		"minecraft_block":       blockResourceType{},
@@ -95,11 +482,69 @@ func (p *provider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceT
 		"minecraft_daylock": 	 daylockResourceType{},
 		"minecraft_sheep": 		 sheepResourceType{},
 		"minecraft_zombie":  	 zombieResourceType{},
+		"minecraft_max_players": maxPlayersResourceType{},
+		"minecraft_scoreboard_display": scoreboardDisplayResourceType{},
+		"minecraft_block_display": blockDisplayResourceType{},
+		"minecraft_mob_group": mobGroupResourceType{},
+		"minecraft_forceload": forceloadResourceType{},
+		"minecraft_lightning": lightningResourceType{},
+		"minecraft_block_rotation": blockRotationResourceType{},
+		"minecraft_trigger_objective": triggerObjectiveResourceType{},
+		"minecraft_falling_block": fallingBlockResourceType{},
+		"minecraft_armor_stand_pose": armorStandPoseResourceType{},
+		"minecraft_team_roster": teamRosterResourceType{},
+		"minecraft_world_normalize": worldNormalizeResourceType{},
+		"minecraft_give_enchanted": giveEnchantedResourceType{},
+		"minecraft_biome": biomeResourceType{},
+		"minecraft_damage": damageResourceType{},
+		"minecraft_score_operation": scoreOperationResourceType{},
+		"minecraft_attribute_modifier": attributeModifierResourceType{},
+		"minecraft_execute": executeResourceType{},
+		"minecraft_ban": banResourceType{},
+		"minecraft_motd": motdResourceType{},
+		"minecraft_sign": signResourceType{},
+		"minecraft_structure_save": structureSaveResourceType{},
+		"minecraft_mob_farm": mobFarmResourceType{},
+		"minecraft_command_block": commandBlockResourceType{},
+		"minecraft_projectile": projectileResourceType{},
+		"minecraft_difficulty": difficultyResourceType{},
+		"minecraft_item_frame": itemFrameResourceType{},
+		"minecraft_custom_item": customItemResourceType{},
+		"minecraft_marker": markerResourceType{},
+		"minecraft_scoreboard_objective": scoreboardObjectiveResourceType{},
+		"minecraft_dropped_item": droppedItemResourceType{},
+		"minecraft_entity_stack": entityStackResourceType{},
+		"minecraft_move": moveResourceType{},
+		"minecraft_world_settings": worldSettingsResourceType{},
+		"minecraft_effect_cloud": effectCloudResourceType{},
+		"minecraft_purge_entities": purgeEntitiesResourceType{},
+		"minecraft_save": saveResourceType{},
+		"minecraft_reload": reloadResourceType{},
+		"minecraft_datapack": datapackResourceType{},
+		"minecraft_relative_block": relativeBlockResourceType{},
+		"minecraft_worldborder": worldBorderResourceType{},
+		"minecraft_xp_orb": xpOrbResourceType{},
+		"minecraft_capture": captureResourceType{},
+		"minecraft_title": titleResourceType{},
+		"minecraft_entity_appearance": entityAppearanceResourceType{},
+		"minecraft_block_item_slot": blockItemSlotResourceType{},
+		"minecraft_chunk": chunkResourceType{},
+		"minecraft_frame": frameResourceType{},
+		"minecraft_guardian": guardianResourceType{},
+		"minecraft_shulker": shulkerResourceType{},
 	}, nil
 }
 
 func (p *provider) GetDataSources(ctx context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
-	return map[string]tfsdk.DataSourceType{}, nil
+	return map[string]tfsdk.DataSourceType{
+		"minecraft_team_colors": teamColorsDataSourceType{},
+		"minecraft_region": regionDataSourceType{},
+		"minecraft_healthcheck": healthcheckDataSourceType{},
+		"minecraft_bans": bansDataSourceType{},
+		"minecraft_tps": tpsDataSourceType{},
+		"minecraft_connection": connectionDataSourceType{},
+		"minecraft_anchor": anchorDataSourceType{},
+	}, nil
 }
 
 func (p *provider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -115,6 +560,78 @@ func (p *provider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostic
 				Required:            true,
 				Type:                types.StringType,
 			},
+			"command_retries": {
+				MarkdownDescription: "How many times to retry a command the server refuses with \"Server is still starting\", or that timed out before it was sent, with exponential backoff. A command that reached the server is never retried, so nothing runs twice. Useful right after a server boots. Defaults to `0`.",
+				Optional:            true,
+				Type:                types.Int64Type,
+			},
+			"commands_per_second": {
+				MarkdownDescription: "Maximum RCON commands sent per second across all resources, so a large apply doesn't overwhelm a shared server. Fractions such as `0.5` are allowed. `0` (the default) disables throttling.",
+				Optional:            true,
+				Type:                types.Float64Type,
+			},
+			"command_timeout": {
+				MarkdownDescription: "Default deadline for each RCON command (e.g. `30s`), so a hung server fails the apply instead of blocking it. Must be positive; unset means no timeout.",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"prevent_destructive_delete": {
+				MarkdownDescription: "If true, destroying `minecraft_block`, `minecraft_block_display`, `minecraft_block_item_slot`, `minecraft_chunk`, `minecraft_command_block`, `minecraft_dropped_item`, `minecraft_effect_cloud`, `minecraft_entity`, `minecraft_entity_stack`, `minecraft_fill`, `minecraft_frame`, `minecraft_guardian`, `minecraft_item_frame`, `minecraft_marker`, `minecraft_mob_farm`, `minecraft_mob_group`, `minecraft_move`, `minecraft_relative_block`, `minecraft_sheep`, `minecraft_shulker`, `minecraft_sign` and `minecraft_zombie` resources only removes them from state; nothing in the world is cleared or killed. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"check_spawn_protection": {
+				MarkdownDescription: "If true, creating `minecraft_block`, `minecraft_fill`, `minecraft_entity`, `minecraft_effect_cloud`, `minecraft_relative_block` or `minecraft_frame` warns when it lands inside the server's spawn protection, where non-op players can't build or use blocks. The radius is read from `server.properties` under `server_data_dir` (vanilla default `16` otherwise); the world spawn is found by summoning a short-lived marker. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"idempotent_writes": {
+				MarkdownDescription: "If true, `minecraft_block` first tests the block with `execute if block` and skips the `setblock` when it already matches, cutting command spam on repeated applies. States left out of `material` match any value. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+			},
+			"server_data_dir": {
+				MarkdownDescription: "Path to the server's data directory (where `ops.json` and `server.properties` live), readable from where Terraform runs. When set, `minecraft_op` detects players who were de-opped outside Terraform and `check_spawn_protection` uses the configured radius.",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"server_version": {
+				MarkdownDescription: "Minecraft release the server runs (e.g. `1.20.4`). Used where command syntax changed between versions, such as item components in 1.20.5 and attribute modifier IDs in 1.21. When unset, the provider asks the server with `version` (Paper, Spigot and vanilla 1.21.6+) the first time a resource needs it, and otherwise assumes a current release.",
+				Optional:            true,
+				Type:                types.StringType,
+			},
+			"deterministic_ids": {
+				MarkdownDescription: "If set, `minecraft_entity`, `minecraft_zombie`, `minecraft_sheep`, `minecraft_mob_group`, `minecraft_entity_stack`, `minecraft_guardian` and `minecraft_shulker` derive their ids from `seed` and the resource's type and position instead of random UUIDs, so plans and imports are reproducible, e.g. for test fixtures. Resources with the same type and position are told apart by the order they are created in, which Terraform doesn't guarantee.",
+				Optional:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"seed": {
+						MarkdownDescription: "Seed the ids are derived from; a different seed gives different ids for the same configuration.",
+						Type:                types.StringType,
+						Required:            true,
+					},
+				}),
+			},
+			"staging_origin": {
+				MarkdownDescription: "Corner of an unused, force-loaded area where `minecraft_fill` and `minecraft_block` snapshots are stored. Required for `restore_mode = \"snapshot\"` and `restore_previous_on_destroy`.",
+				Optional:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+					},
+				}),
+			},
 		},
 	}, nil
 }
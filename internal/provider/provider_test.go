@@ -0,0 +1,707 @@
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeServer is a minimal RCON server on localhost. It accepts any password
+// and answers each command with reply, or "" when reply is nil.
+type fakeServer struct {
+	address string
+	reply   func(command string) string
+
+	mu       sync.Mutex
+	commands []string
+	conns    int
+	open     []net.Conn
+}
+
+func newFakeServer(t *testing.T, reply func(command string) string) *fakeServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	s := &fakeServer{address: l.Addr().String(), reply: reply}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.conns++
+			s.open = append(s.open, conn)
+			s.mu.Unlock()
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+// serve answers packets on one connection until it closes.
+func (s *fakeServer) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		var size, id, kind int32
+		if binary.Read(conn, binary.LittleEndian, &size) != nil ||
+			binary.Read(conn, binary.LittleEndian, &id) != nil ||
+			binary.Read(conn, binary.LittleEndian, &kind) != nil {
+			return
+		}
+		body := make([]byte, size-8)
+		if _, err := io.ReadFull(conn, body); err != nil {
+			return
+		}
+
+		out := ""
+		if kind == 2 { // command; 3 is authentication
+			command := strings.TrimRight(string(body), "\x00")
+			s.mu.Lock()
+			s.commands = append(s.commands, command)
+			s.mu.Unlock()
+			if s.reply != nil {
+				out = s.reply(command)
+			}
+		}
+
+		packet := make([]byte, 0, len(out)+14)
+		packet = appendInt32(packet, int32(len(out)+10))
+		packet = appendInt32(packet, id)
+		packet = appendInt32(packet, 0)
+		packet = append(packet, out...)
+		packet = append(packet, 0, 0)
+		if _, err := conn.Write(packet); err != nil {
+			return
+		}
+	}
+}
+
+func appendInt32(b []byte, v int32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], uint32(v))
+	return append(b, buf[:]...)
+}
+
+// sent returns the commands received so far.
+func (s *fakeServer) sent() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.commands...)
+}
+
+// connections returns how many connections were accepted.
+func (s *fakeServer) connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns
+}
+
+// dropConnections closes every connection accepted so far, as a restarting
+// server would.
+func (s *fakeServer) dropConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, conn := range s.open {
+		conn.Close()
+	}
+	s.open = nil
+}
+
+// objectValue builds a value of the schema's type from attrs, leaving every
+// other attribute null.
+func objectValue(ctx context.Context, schema tfsdk.Schema, attrs map[string]tftypes.Value) tftypes.Value {
+	typ := schema.TerraformType(ctx).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			vals[name] = v
+		} else {
+			vals[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+	return tftypes.NewValue(typ, vals)
+}
+
+// testServerVersion is the server_version tests configure unless they set
+// their own, so the provider never asks the server for it.
+const testServerVersion = "1.21.5"
+
+// configureProvider configures a new provider for the server at address,
+// with further provider attributes from attrs.
+func configureProvider(t *testing.T, address string, attrs map[string]tftypes.Value) *provider {
+	t.Helper()
+	p, diags := tryConfigureProvider(t, address, attrs)
+	if diags.HasError() {
+		t.Fatalf("Configure: %v", diags)
+	}
+	return p
+}
+
+// tryConfigureProvider is configureProvider for configurations that may be
+// rejected; it returns Configure's diagnostics instead of failing the test.
+func tryConfigureProvider(t *testing.T, address string, attrs map[string]tftypes.Value) (*provider, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	p := New("test")().(*provider)
+
+	schema, diags := p.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("GetSchema: %v", diags)
+	}
+	values := map[string]tftypes.Value{
+		"address":        tftypes.NewValue(tftypes.String, address),
+		"password":       tftypes.NewValue(tftypes.String, "secret"),
+		"server_version": tftypes.NewValue(tftypes.String, testServerVersion),
+	}
+	for name, v := range attrs {
+		values[name] = v
+	}
+
+	req := tfsdk.ConfigureProviderRequest{Config: tfsdk.Config{Schema: schema, Raw: objectValue(ctx, schema, values)}}
+	var resp tfsdk.ConfigureProviderResponse
+	p.Configure(ctx, req, &resp)
+	return p, resp.Diagnostics
+}
+
+// xyzValue builds the value of a nested x/y/z attribute of the schema.
+func xyzValue(ctx context.Context, schema tfsdk.Schema, name string, x, y, z interface{}) tftypes.Value {
+	typ := schema.TerraformType(ctx).(tftypes.Object).AttributeTypes[name]
+	return tftypes.NewValue(typ, map[string]tftypes.Value{
+		"x": tftypes.NewValue(tftypes.Number, x),
+		"y": tftypes.NewValue(tftypes.Number, y),
+		"z": tftypes.NewValue(tftypes.Number, z),
+	})
+}
+
+// createResource runs Create for a resource of type rt configured with attrs
+// and returns the new state and diagnostics. Computed attributes not in attrs
+// are planned as unknown.
+func createResource(t *testing.T, p *provider, rt tfsdk.ResourceType, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	schema, diags := rt.GetSchema(ctx)
+	if diags.HasError() {
+		t.Fatalf("GetSchema: %v", diags)
+	}
+	r, diags := rt.NewResource(ctx, p)
+	if diags.HasError() {
+		t.Fatalf("NewResource: %v", diags)
+	}
+
+	config := objectValue(ctx, schema, attrs)
+	planned := map[string]tftypes.Value{}
+	for name, attr := range schema.Attributes {
+		if _, ok := attrs[name]; !ok && attr.Computed {
+			planned[name] = tftypes.NewValue(schema.TerraformType(ctx).(tftypes.Object).AttributeTypes[name], tftypes.UnknownValue)
+		}
+	}
+	for name, v := range attrs {
+		planned[name] = v
+	}
+
+	typ := schema.TerraformType(ctx)
+	req := tfsdk.CreateResourceRequest{
+		Config: tfsdk.Config{Schema: schema, Raw: config},
+		Plan:   tfsdk.Plan{Schema: schema, Raw: objectValue(ctx, schema, planned)},
+	}
+	resp := tfsdk.CreateResourceResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(typ, nil)}}
+	r.Create(ctx, req, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+// updateResource runs Update for a resource of type rt from state to the
+// configuration in attrs. Computed attributes not in attrs keep their values
+// from state.
+func updateResource(t *testing.T, p *provider, rt tfsdk.ResourceType, state tfsdk.State, attrs map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	r, diags := rt.NewResource(ctx, p)
+	if diags.HasError() {
+		t.Fatalf("NewResource: %v", diags)
+	}
+
+	var prior map[string]tftypes.Value
+	if err := state.Raw.As(&prior); err != nil {
+		t.Fatalf("reading state: %v", err)
+	}
+	planned := map[string]tftypes.Value{}
+	for name, attr := range state.Schema.Attributes {
+		if _, ok := attrs[name]; !ok && attr.Computed {
+			planned[name] = prior[name]
+		}
+	}
+	for name, v := range attrs {
+		planned[name] = v
+	}
+
+	req := tfsdk.UpdateResourceRequest{
+		Config: tfsdk.Config{Schema: state.Schema, Raw: objectValue(ctx, state.Schema, attrs)},
+		Plan:   tfsdk.Plan{Schema: state.Schema, Raw: objectValue(ctx, state.Schema, planned)},
+		State:  state,
+	}
+	resp := tfsdk.UpdateResourceResponse{State: state}
+	r.Update(ctx, req, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+// deleteResource runs Delete for a resource of type rt in state.
+func deleteResource(t *testing.T, p *provider, rt tfsdk.ResourceType, state tfsdk.State) diag.Diagnostics {
+	t.Helper()
+	ctx := context.Background()
+	r, diags := rt.NewResource(ctx, p)
+	if diags.HasError() {
+		t.Fatalf("NewResource: %v", diags)
+	}
+	resp := tfsdk.DeleteResourceResponse{State: state}
+	r.Delete(ctx, tfsdk.DeleteResourceRequest{State: state}, &resp)
+	return resp.Diagnostics
+}
+
+// readResource runs Read for a resource of type rt in state and returns the
+// refreshed state and diagnostics.
+func readResource(t *testing.T, p *provider, rt tfsdk.ResourceType, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	r, diags := rt.NewResource(ctx, p)
+	if diags.HasError() {
+		t.Fatalf("NewResource: %v", diags)
+	}
+	resp := tfsdk.ReadResourceResponse{State: state}
+	r.Read(ctx, tfsdk.ReadResourceRequest{State: state}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+// stateString reads a string attribute from state; null reads as "".
+func stateString(t *testing.T, state tfsdk.State, name string) string {
+	t.Helper()
+	var v types.String
+	if diags := state.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName(name), &v); diags.HasError() {
+		t.Fatalf("reading %s: %v", name, diags)
+	}
+	return v.Value
+}
+
+// containsCommand reports whether command is among sent.
+func containsCommand(sent []string, command string) bool {
+	for _, s := range sent {
+		if s == command {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPreventDestructiveDelete(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, map[string]tftypes.Value{
+		"prevent_destructive_delete": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	schema, _ := fillResourceType{}.GetSchema(ctx)
+	state, diags := createResource(t, p, fillResourceType{}, map[string]tftypes.Value{
+		"material": tftypes.NewValue(tftypes.String, "minecraft:stone"),
+		"start":    xyzValue(ctx, schema, "start", 0, 60, 0),
+		"end":      xyzValue(ctx, schema, "end", 4, 64, 4),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	created := len(server.sent())
+
+	diags = deleteResource(t, p, fillResourceType{}, state)
+	if diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity() != diag.SeverityWarning {
+		t.Errorf("Delete gave %v, want one warning", diags)
+	}
+	if got := server.sent()[created:]; len(got) != 0 {
+		t.Errorf("Delete sent %q, want nothing", got)
+	}
+}
+
+func TestPreventDestructiveDeleteKeepsEntities(t *testing.T) {
+	ctx := context.Background()
+	// listOf builds a value for the list-nested attribute name of rt.
+	listOf := func(rt tfsdk.ResourceType, name string, elems ...map[string]tftypes.Value) tftypes.Value {
+		schema, _ := rt.GetSchema(ctx)
+		typ := schema.TerraformType(ctx).(tftypes.Object).AttributeTypes[name].(tftypes.List)
+		elemType := typ.ElementType.(tftypes.Object)
+		vals := make([]tftypes.Value, len(elems))
+		for i, attrs := range elems {
+			full := map[string]tftypes.Value{}
+			for attr, attrType := range elemType.AttributeTypes {
+				if v, ok := attrs[attr]; ok {
+					full[attr] = v
+				} else {
+					full[attr] = tftypes.NewValue(attrType, nil)
+				}
+			}
+			vals[i] = tftypes.NewValue(elemType, full)
+		}
+		return tftypes.NewValue(typ, vals)
+	}
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+	tests := []struct {
+		name   string
+		rt     tfsdk.ResourceType
+		config func(schema tfsdk.Schema) map[string]tftypes.Value
+	}{
+		{"zombie", zombieResourceType{}, func(schema tfsdk.Schema) map[string]tftypes.Value {
+			return map[string]tftypes.Value{"position": xyzValue(ctx, schema, "position", 0.5, 64, 0.5)}
+		}},
+		{"sheep", sheepResourceType{}, func(schema tfsdk.Schema) map[string]tftypes.Value {
+			return map[string]tftypes.Value{"position": xyzValue(ctx, schema, "position", 0.5, 64, 0.5)}
+		}},
+		{"mob group", mobGroupResourceType{}, func(schema tfsdk.Schema) map[string]tftypes.Value {
+			return map[string]tftypes.Value{
+				"type":     str("minecraft:cow"),
+				"count":    tftypes.NewValue(tftypes.Number, 3),
+				"position": xyzValue(ctx, schema, "position", 0, 64, 0),
+			}
+		}},
+		{"entity stack", entityStackResourceType{}, func(schema tfsdk.Schema) map[string]tftypes.Value {
+			return map[string]tftypes.Value{
+				"entities": listOf(entityStackResourceType{}, "entities",
+					map[string]tftypes.Value{"type": str("minecraft:spider")},
+					map[string]tftypes.Value{"type": str("minecraft:skeleton")},
+				),
+				"position": xyzValue(ctx, schema, "position", 0.5, 64, 0.5),
+			}
+		}},
+		{"marker", markerResourceType{}, func(schema tfsdk.Schema) map[string]tftypes.Value {
+			return map[string]tftypes.Value{"position": xyzValue(ctx, schema, "position", 0, 64, 0)}
+		}},
+		{"block display", blockDisplayResourceType{}, func(schema tfsdk.Schema) map[string]tftypes.Value {
+			return map[string]tftypes.Value{
+				"position":    xyzValue(ctx, schema, "position", 0, 64, 0),
+				"block_state": str("minecraft:stone"),
+			}
+		}},
+		{"effect cloud", effectCloudResourceType{}, func(schema tfsdk.Schema) map[string]tftypes.Value {
+			return map[string]tftypes.Value{
+				"position": xyzValue(ctx, schema, "position", 0.5, 64, 0.5),
+				"radius":   tftypes.NewValue(tftypes.Number, 3),
+				"effects": listOf(effectCloudResourceType{}, "effects", map[string]tftypes.Value{
+					"effect":   str("minecraft:poison"),
+					"duration": tftypes.NewValue(tftypes.Number, 100),
+				}),
+			}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeServer(t, nil)
+			p := configureProvider(t, server.address, map[string]tftypes.Value{
+				"prevent_destructive_delete": tftypes.NewValue(tftypes.Bool, true),
+			})
+
+			schema, _ := tt.rt.GetSchema(ctx)
+			state, diags := createResource(t, p, tt.rt, tt.config(schema))
+			if diags.HasError() {
+				t.Fatalf("Create: %v", diags)
+			}
+			created := len(server.sent())
+
+			diags = deleteResource(t, p, tt.rt, state)
+			if len(diags) != 1 || diags[0].Severity() != diag.SeverityWarning {
+				t.Errorf("Delete gave %v, want one warning", diags)
+			}
+			if got := server.sent()[created:]; len(got) != 0 {
+				t.Errorf("Delete sent %q, want nothing", got)
+			}
+		})
+	}
+}
+
+func TestInSpawnProtection(t *testing.T) {
+	spawn := [3]int{100, 64, -50}
+	tests := []struct {
+		name           string
+		radius         int
+		x1, z1, x2, z2 int
+		want           bool
+	}{
+		{name: "at spawn", radius: 16, x1: 100, z1: -50, x2: 100, z2: -50, want: true},
+		{name: "on the edge", radius: 16, x1: 116, z1: -34, x2: 116, z2: -34, want: true},
+		{name: "just outside", radius: 16, x1: 117, z1: -50, x2: 117, z2: -50},
+		{name: "diagonal corner is square distance", radius: 16, x1: 84, z1: -66, x2: 84, z2: -66, want: true},
+		{name: "box reaching into the area", radius: 16, x1: 200, z1: -40, x2: 110, z2: -45, want: true},
+		{name: "box beside the area", radius: 16, x1: 117, z1: -100, x2: 200, z2: 0},
+		{name: "protection disabled", radius: 0, x1: 100, z1: -50, x2: 100, z2: -50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inSpawnProtection(spawn, tt.radius, tt.x1, tt.z1, tt.x2, tt.z2); got != tt.want {
+				t.Errorf("inSpawnProtection = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpawnProtectionWarning(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "server.properties"), []byte("motd=hi\nspawn-protection=8\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	server := newFakeServer(t, func(command string) string {
+		if strings.HasPrefix(command, "data get entity ") {
+			return "Marker has the following entity data: [10.5d, 70.0d, 10.5d]"
+		}
+		return ""
+	})
+	p := configureProvider(t, server.address, map[string]tftypes.Value{
+		"check_spawn_protection": tftypes.NewValue(tftypes.Bool, true),
+		"server_data_dir":        tftypes.NewValue(tftypes.String, dir),
+	})
+
+	for _, tt := range []struct {
+		pos  [3]int
+		warn bool
+	}{
+		{pos: [3]int{18, 64, 2}, warn: true},
+		{pos: [3]int{19, 64, 10}},
+	} {
+		_, diags := createResource(t, p, blockResourceType{}, blockAttrs(t, "minecraft:stone", tt.pos))
+		if diags.HasError() {
+			t.Fatalf("Create: %v", diags)
+		}
+		warned := len(diags) == 1 && diags[0].Summary() == "Inside Spawn Protection"
+		if warned != tt.warn || (!tt.warn && len(diags) != 0) {
+			t.Errorf("block at %v: diags = %v, want warning %t", tt.pos, diags, tt.warn)
+		}
+	}
+
+	probes := 0
+	for _, command := range server.sent() {
+		if strings.HasPrefix(command, "summon minecraft:marker") {
+			probes++
+		}
+	}
+	if probes != 1 {
+		t.Errorf("world spawn looked up %d times, want once", probes)
+	}
+}
+
+func TestConfigureDetectsServerVersion(t *testing.T) {
+	paper := "This server is running Paper version git-Paper-496 (MC: 1.20.4) (Implementing API version 1.20.4-R0.1-SNAPSHOT)"
+	tests := []struct {
+		name       string
+		configured string
+		reply      string
+		want       string
+		probed     bool
+	}{
+		{name: "detected", reply: paper, want: "1.20.4", probed: true},
+		{name: "no version command", reply: "Unknown or incomplete command, see below for error", want: "", probed: true},
+		{name: "configured", configured: "1.19.4", reply: paper, want: "1.19.4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeServer(t, func(command string) string {
+				if command == "version" {
+					return tt.reply
+				}
+				return ""
+			})
+			p := configureProvider(t, server.address, map[string]tftypes.Value{
+				"server_version": tftypes.NewValue(tftypes.String, nilIfEmpty(tt.configured)),
+			})
+			if n := server.connections(); n != 0 {
+				t.Fatalf("Configure made %d connections, want none", n)
+			}
+
+			// Asking twice probes at most once.
+			for i := 0; i < 2; i++ {
+				if got := p.release(); got != tt.want {
+					t.Errorf("release() = %q, want %q", got, tt.want)
+				}
+			}
+			var want []string
+			if tt.probed {
+				want = []string{"version"}
+			}
+			if got := server.sent(); !reflect.DeepEqual(got, want) {
+				t.Errorf("sent %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// nilIfEmpty turns "" into nil, for null string attribute values.
+func nilIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func TestCommandTimeoutMustBePositive(t *testing.T) {
+	for _, timeout := range []string{"0s", "-5s", "soon"} {
+		_, diags := tryConfigureProvider(t, "127.0.0.1:25575", map[string]tftypes.Value{
+			"command_timeout": tftypes.NewValue(tftypes.String, timeout),
+		})
+		if !diags.HasError() {
+			t.Errorf("command_timeout = %q accepted", timeout)
+		}
+	}
+}
+
+func TestDeterministicEntityIDs(t *testing.T) {
+	ctx := context.Background()
+	providerSchema, _ := New("test")().GetSchema(ctx)
+	schema, _ := entityResourceType{}.GetSchema(ctx)
+	seeded := func(seed string) map[string]tftypes.Value {
+		typ := providerSchema.TerraformType(ctx).(tftypes.Object).AttributeTypes["deterministic_ids"]
+		return map[string]tftypes.Value{
+			"deterministic_ids": tftypes.NewValue(typ, map[string]tftypes.Value{
+				"seed": tftypes.NewValue(tftypes.String, seed),
+			}),
+		}
+	}
+	// summon creates a pig at x with a freshly configured provider, as a
+	// new run would, and returns its id.
+	summon := func(attrs map[string]tftypes.Value, x float64) string {
+		server := newFakeServer(t, nil)
+		p := configureProvider(t, server.address, attrs)
+		state, diags := createResource(t, p, entityResourceType{}, map[string]tftypes.Value{
+			"type":     tftypes.NewValue(tftypes.String, "minecraft:pig"),
+			"position": xyzValue(ctx, schema, "position", x, 64, 0.5),
+		})
+		if diags.HasError() {
+			t.Fatalf("Create: %v", diags)
+		}
+		return stateString(t, state, "id")
+	}
+
+	first := summon(seeded("fixtures"), 0.5)
+	if again := summon(seeded("fixtures"), 0.5); again != first {
+		t.Errorf("same seed and config gave ids %q and %q", first, again)
+	}
+	if other := summon(seeded("fixtures"), 1.5); other == first {
+		t.Errorf("a different position reused id %q", first)
+	}
+	if other := summon(seeded("staging"), 0.5); other == first {
+		t.Errorf("a different seed reused id %q", first)
+	}
+	if random := summon(nil, 0.5); random == summon(nil, 0.5) {
+		t.Errorf("without deterministic_ids two entities got id %q", random)
+	}
+
+	// Two identical entities in one run still get distinct ids.
+	g := newEntityIDGenerator("fixtures")
+	if a, b := g.next("minecraft:pig@0.5 64 0.5"), g.next("minecraft:pig@0.5 64 0.5"); a == b {
+		t.Errorf("duplicate config got the same id %q twice", a)
+	}
+}
+
+func TestAliasedProvidersKeepSeparateConnections(t *testing.T) {
+	ctx := context.Background()
+	east := newFakeServer(t, nil)
+	west := newFakeServer(t, nil)
+	providers := map[*fakeServer]*provider{
+		east: configureProvider(t, east.address, nil),
+		west: configureProvider(t, west.address, nil),
+	}
+	if providers[east].clients == providers[west].clients {
+		t.Fatal("both providers share one client cache")
+	}
+
+	// Resources of both providers run in parallel during an apply.
+	const perProvider = 5
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*perProvider)
+	for _, p := range providers {
+		for i := 0; i < perProvider; i++ {
+			wg.Add(1)
+			go func(p *provider) {
+				defer wg.Done()
+				client, err := p.GetClient(ctx)
+				if err == nil {
+					_, err = client.Ping(ctx)
+				}
+				errs <- err
+			}(p)
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for server, p := range providers {
+		if n := server.connections(); n != 1 {
+			t.Errorf("%s: %d connections, want one shared by its provider", server.address, n)
+		}
+		if n := len(server.sent()); n != perProvider {
+			t.Errorf("%s: got %d commands, want the %d its provider sent", server.address, n, perProvider)
+		}
+		if len(p.clients.conns) != 1 {
+			t.Errorf("%s: provider caches %d clients, want 1", server.address, len(p.clients.conns))
+		}
+	}
+}
+
+func TestBrokenConnectionIsReplaced(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	client, err := p.GetClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Ping(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	server.dropConnections()
+	if _, err := client.Ping(ctx); err == nil {
+		t.Fatal("Ping succeeded over a dropped connection")
+	}
+
+	client, err = p.GetClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Ping(ctx); err != nil {
+		t.Fatalf("Ping after reconnecting: %v", err)
+	}
+	if n := server.connections(); n != 2 {
+		t.Errorf("%d connections, want a second one after the first broke", n)
+	}
+}
+
+func TestFailedConnectionIsNotCached(t *testing.T) {
+	p := configureProvider(t, closedAddress(t), nil)
+	for i := 0; i < 2; i++ {
+		if _, err := p.GetClient(context.Background()); err == nil {
+			t.Fatal("GetClient succeeded against a closed port")
+		}
+	}
+	if n := len(p.clients.conns); n != 0 {
+		t.Errorf("cached %d clients after failed connections", n)
+	}
+}
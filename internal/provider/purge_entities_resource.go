@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = purgeEntitiesResourceType{}
+var _ tfsdk.Resource = purgeEntitiesResource{}
+
+// ---------- Resource Type ----------
+
+type purgeEntitiesResourceType struct{}
+
+func (t purgeEntitiesResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "One-shot trigger that kills every entity of a type when created, e.g. to clear stray mobs or dropped items. Set `center` and `radius` to only purge nearby entities. Players are never killed. Change `triggers` to purge again; destroying it does nothing in game.",
+		Attributes: map[string]tfsdk.Attribute{
+			"entity_type": {
+				MarkdownDescription: "Namespaced entity type to kill, e.g. `minecraft:zombie`. `minecraft:player` is refused.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"center": {
+				MarkdownDescription: "Center of the purge. Requires `radius`.",
+				Optional:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Int64Type,
+						Required:            true,
+					},
+				}),
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"radius": {
+				MarkdownDescription: "Only kill entities within this many blocks of `center`. Requires `center`; without both, the whole world is purged.",
+				Optional:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, purge again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"killed": {
+				Computed:            true,
+				MarkdownDescription: "How many entities were killed, or null if the server's reply couldn't be read.",
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this purge.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t purgeEntitiesResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return purgeEntitiesResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type purgeCenter struct {
+	X int64 `tfsdk:"x"`
+	Y int64 `tfsdk:"y"`
+	Z int64 `tfsdk:"z"`
+}
+
+type purgeEntitiesResourceData struct {
+	Id         types.String `tfsdk:"id"`
+	EntityType string       `tfsdk:"entity_type"`
+	Center     *purgeCenter `tfsdk:"center"`
+	Radius     types.Int64  `tfsdk:"radius"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+	Killed     types.Int64  `tfsdk:"killed"`
+}
+
+// Entity types and loot tables must carry their namespace, e.g. minecraft:zombie.
+var namespacedIDPattern = regexp.MustCompile(`^[a-z0-9_.-]+:[a-z0-9_./-]+$`)
+
+// scope validates the settings and returns the purge area, or nils for the
+// whole world.
+func (d purgeEntitiesResourceData) scope() (center *[3]int, radius *int, err error) {
+	if !namespacedIDPattern.MatchString(d.EntityType) {
+		return nil, nil, fmt.Errorf("entity_type must be a namespaced entity ID such as minecraft:zombie (got %q)", d.EntityType)
+	}
+	if d.EntityType == "minecraft:player" {
+		return nil, nil, fmt.Errorf("entity_type must not be minecraft:player; players are never purged")
+	}
+	if (d.Center == nil) != d.Radius.Null {
+		return nil, nil, fmt.Errorf("center and radius must be set together")
+	}
+	if d.Center == nil {
+		return nil, nil, nil
+	}
+	if d.Radius.Value <= 0 {
+		return nil, nil, fmt.Errorf("radius must be positive (got %d)", d.Radius.Value)
+	}
+	r := int(d.Radius.Value)
+	return &[3]int{int(d.Center.X), int(d.Center.Y), int(d.Center.Z)}, &r, nil
+}
+
+// ---------- Resource Impl ----------
+
+type purgeEntitiesResource struct {
+	provider provider
+}
+
+func (r purgeEntitiesResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data purgeEntitiesResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	center, radius, err := data.scope()
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	n, ok, err := client.KillByType(ctx, data.EntityType, center, radius)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to purge %s: %s", data.EntityType, err))
+		return
+	}
+
+	data.Killed = types.Int64{Null: true}
+	if ok {
+		data.Killed = types.Int64{Value: int64(n)}
+	}
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r purgeEntitiesResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Nothing persists in game; keep state as-is.
+	var data purgeEntitiesResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r purgeEntitiesResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data purgeEntitiesResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r purgeEntitiesResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Killed entities can't be brought back; just drop it from state.
+}
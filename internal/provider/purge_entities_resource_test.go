@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPurgeEntitiesScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    purgeEntitiesResourceData
+		scoped  bool
+		wantErr bool
+	}{
+		{name: "whole world", data: purgeEntitiesResourceData{EntityType: "minecraft:zombie", Radius: types.Int64{Null: true}}},
+		{name: "scoped", data: purgeEntitiesResourceData{EntityType: "minecraft:item", Center: &purgeCenter{Y: 64}, Radius: types.Int64{Value: 8}}, scoped: true},
+		{name: "not namespaced", data: purgeEntitiesResourceData{EntityType: "zombie", Radius: types.Int64{Null: true}}, wantErr: true},
+		{name: "player", data: purgeEntitiesResourceData{EntityType: "minecraft:player", Radius: types.Int64{Null: true}}, wantErr: true},
+		{name: "radius without center", data: purgeEntitiesResourceData{EntityType: "minecraft:zombie", Radius: types.Int64{Value: 8}}, wantErr: true},
+		{name: "negative radius", data: purgeEntitiesResourceData{EntityType: "minecraft:zombie", Center: &purgeCenter{}, Radius: types.Int64{Value: -1}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		center, radius, err := tt.data.scope()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: err = %v, wantErr %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if scoped := center != nil && radius != nil; scoped != tt.scoped {
+			t.Errorf("%s: scoped = %t, want %t", tt.name, scoped, tt.scoped)
+		}
+	}
+}
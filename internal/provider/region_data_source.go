@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = regionDataSourceType{}
+var _ tfsdk.DataSource = regionDataSource{}
+
+type regionDataSourceType struct{}
+
+func (t regionDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	corner := func() map[string]tfsdk.Attribute {
+		attrs := map[string]tfsdk.Attribute{}
+		for _, axis := range []string{"x", "y", "z"} {
+			attrs[axis] = tfsdk.Attribute{
+				MarkdownDescription: fmt.Sprintf("%s coordinate.", strings.ToUpper(axis)),
+				Type:                types.Int64Type,
+				Required:            true,
+			}
+		}
+		return attrs
+	}
+
+	return tfsdk.Schema{
+		MarkdownDescription: fmt.Sprintf("Counts the blocks of a given material in a region, e.g. to verify a build. Every block is tested with its own command, so the region is capped at %d blocks.", minecraft.MaxScanVolume),
+		Attributes: map[string]tfsdk.Attribute{
+			"from": {
+				MarkdownDescription: "One corner of the region (inclusive).",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(corner()),
+			},
+			"to": {
+				MarkdownDescription: "The opposite corner of the region (inclusive).",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(corner()),
+			},
+			"material": {
+				MarkdownDescription: "Block to count, optionally with block states (e.g. `minecraft:stone`, `minecraft:oak_stairs[half=top]`). States that are left out match any value.",
+				Required:            true,
+				Type:                types.StringType,
+			},
+			"count": {
+				Computed:            true,
+				MarkdownDescription: "Number of matching blocks in the region.",
+				Type:                types.Int64Type,
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "The material and both corners.",
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t regionDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return regionDataSource{provider: provider}, diags
+}
+
+type regionDataSourceData struct {
+	Id       types.String `tfsdk:"id"`
+	From     cornerModel  `tfsdk:"from"`
+	To       cornerModel  `tfsdk:"to"`
+	Material string       `tfsdk:"material"`
+	Count    types.Int64  `tfsdk:"count"`
+}
+
+type regionDataSource struct {
+	provider provider
+}
+
+func (d regionDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data regionDataSourceData
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateBlockState(data.Material); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	from, to := data.From.coords(), data.To.coords()
+	if v := minecraft.RegionVolume(from, to); v > minecraft.MaxScanVolume {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("region holds %d blocks; at most %d can be scanned", v, minecraft.MaxScanVolume))
+		return
+	}
+
+	client, err := d.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	count, err := client.CountBlocks(ctx, data.Material, from, to)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to count blocks, got error: %s", err))
+		return
+	}
+
+	data.Count = types.Int64{Value: int64(count)}
+	data.Id = types.String{Value: fmt.Sprintf("%s@%d,%d,%d:%d,%d,%d", data.Material, from[0], from[1], from[2], to[0], to[1], to[2])}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
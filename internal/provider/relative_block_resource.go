@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = relativeBlockResourceType{}
+var _ tfsdk.Resource = relativeBlockResource{}
+
+// ---------- Resource Type ----------
+
+type relativeBlockResourceType struct{}
+
+func (t relativeBlockResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Places a block at an offset from an entity's current position, e.g. a beacon under a `minecraft_marker`. The entity's position is read when the block is created; the block does not move with it afterwards. Use `replace_triggered_by` to place it again after the entity moves.",
+		Attributes: map[string]tfsdk.Attribute{
+			"material": {
+				MarkdownDescription: "The material of the block, e.g. `minecraft:glowstone`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"entity": {
+				MarkdownDescription: "CustomName of the entity to place the block relative to, e.g. `minecraft_marker.spawn.id`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"offset": {
+				MarkdownDescription: fmt.Sprintf("Offset in blocks from the block the entity is in. Each axis must be within ±%d.", maxRelativeOffset),
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X offset",
+						Type:                types.Int64Type,
+						Required:            true,
+					},
+					"y": {
+						MarkdownDescription: "Y offset",
+						Type:                types.Int64Type,
+						Required:            true,
+					},
+					"z": {
+						MarkdownDescription: "Z offset",
+						Type:                types.Int64Type,
+						Required:            true,
+					},
+				}),
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"x": {
+				Computed:            true,
+				MarkdownDescription: "X coordinate the block was placed at.",
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"y": {
+				Computed:            true,
+				MarkdownDescription: "Y coordinate the block was placed at.",
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"z": {
+				Computed:            true,
+				MarkdownDescription: "Z coordinate the block was placed at.",
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "ID of the block (`block-x-y-z`).",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t relativeBlockResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return relativeBlockResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type relativeBlockResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Material string       `tfsdk:"material"`
+	Entity   string       `tfsdk:"entity"`
+	Offset   struct {
+		X int64 `tfsdk:"x"`
+		Y int64 `tfsdk:"y"`
+		Z int64 `tfsdk:"z"`
+	} `tfsdk:"offset"`
+	X types.Int64 `tfsdk:"x"`
+	Y types.Int64 `tfsdk:"y"`
+	Z types.Int64 `tfsdk:"z"`
+}
+
+// maxRelativeOffset keeps the block close to the entity it follows.
+const maxRelativeOffset = 64
+
+func (d relativeBlockResourceData) validate() error {
+	if d.Entity == "" {
+		return fmt.Errorf("entity must not be empty")
+	}
+	for _, axis := range []struct {
+		name string
+		v    int64
+	}{{"x", d.Offset.X}, {"y", d.Offset.Y}, {"z", d.Offset.Z}} {
+		if axis.v < -maxRelativeOffset || axis.v > maxRelativeOffset {
+			return fmt.Errorf("offset.%s must be between -%d and %d (got %d)", axis.name, maxRelativeOffset, maxRelativeOffset, axis.v)
+		}
+	}
+	return nil
+}
+
+// ---------- Resource Impl ----------
+
+type relativeBlockResource struct {
+	provider provider
+}
+
+func (r relativeBlockResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data relativeBlockResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	pos, err := client.GetEntityPos(ctx, data.Entity)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the position of %s: %s", data.Entity, err))
+		return
+	}
+	block := minecraft.OffsetBlockPos(pos, [3]int{int(data.Offset.X), int(data.Offset.Y), int(data.Offset.Z)})
+
+	r.provider.warnSpawnProtection(ctx, &resp.Diagnostics, fmt.Sprintf("Block at %d %d %d", block[0], block[1], block[2]),
+		block[0], block[2], block[0], block[2])
+
+	if err := client.CreateBlock(ctx, data.Material, block[0], block[1], block[2], ""); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create block: %s", err))
+		return
+	}
+
+	data.X = types.Int64{Value: int64(block[0])}
+	data.Y = types.Int64{Value: int64(block[1])}
+	data.Z = types.Int64{Value: int64(block[2])}
+	data.Id = types.String{Value: fmt.Sprintf("block-%d-%d-%d", block[0], block[1], block[2])}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r relativeBlockResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// The block stays where it was placed even if the entity moves; keep state as-is.
+	var data relativeBlockResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r relativeBlockResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data relativeBlockResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r relativeBlockResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data relativeBlockResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("block at %d,%d,%d", data.X.Value, data.Y.Value, data.Z.Value)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.DeleteBlock(ctx, int(data.X.Value), int(data.Y.Value), int(data.Z.Value)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove block: %s", err))
+		return
+	}
+}
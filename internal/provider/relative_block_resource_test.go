@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRelativeBlockPlacedAtOffset(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := relativeBlockResourceType{}.GetSchema(ctx)
+	server := newFakeServer(t, func(command string) string {
+		if strings.HasPrefix(command, "data get entity ") {
+			return "Marker has the following entity data: [-0.5d, 64.0d, 10.25d]"
+		}
+		return ""
+	})
+	p := configureProvider(t, server.address, nil)
+	state, diags := createResource(t, p, relativeBlockResourceType{}, map[string]tftypes.Value{
+		"material": tftypes.NewValue(tftypes.String, "minecraft:lantern"),
+		"entity":   tftypes.NewValue(tftypes.String, "anchor"),
+		"offset":   xyzValue(ctx, schema, "offset", 2, 1, -3),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	if !containsCommand(server.sent(), "setblock 1 65 7 minecraft:lantern replace") {
+		t.Errorf("sent %q, want the lantern at 1 65 7", server.sent())
+	}
+	if id := stateString(t, state, "id"); id != "block-1-65-7" {
+		t.Errorf("id = %q", id)
+	}
+}
+
+func TestRelativeBlockOffsetLimit(t *testing.T) {
+	d := relativeBlockResourceData{Entity: "anchor"}
+	d.Offset.Y = maxRelativeOffset
+	if err := d.validate(); err != nil {
+		t.Errorf("offset %d rejected: %v", maxRelativeOffset, err)
+	}
+	d.Offset.X = -maxRelativeOffset - 1
+	if err := d.validate(); err == nil {
+		t.Errorf("offset %d accepted", d.Offset.X)
+	}
+}
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = saveResourceType{}
+var _ tfsdk.Resource = saveResource{}
+
+// ---------- Resource Type ----------
+
+type saveResourceType struct{}
+
+func (t saveResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "One-shot trigger that saves the world to disk with `save-all` when created, e.g. before a snapshot or backup. Use `depends_on` so it runs after the resources whose changes should be saved. Change `triggers` to save again. With `pause_autosave`, automatic saving stays off until the resource is destroyed.",
+		Attributes: map[string]tfsdk.Attribute{
+			"flush": {
+				MarkdownDescription: "Wait until every chunk is written (`save-all flush`). Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"pause_autosave": {
+				MarkdownDescription: "Run `save-off` after saving so the files on disk stay as saved, e.g. while a backup copies them. `save-on` runs when the resource is destroyed. Defaults to `false`.",
+				Optional:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, save again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this save.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t saveResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return saveResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type saveResourceData struct {
+	Id            types.String `tfsdk:"id"`
+	Flush         types.Bool   `tfsdk:"flush"`
+	PauseAutosave types.Bool   `tfsdk:"pause_autosave"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+}
+
+func (d *saveResourceData) applyDefaults() {
+	if d.Flush.Null || d.Flush.Unknown {
+		d.Flush = types.Bool{Value: true}
+	}
+}
+
+// ---------- Resource Impl ----------
+
+type saveResource struct {
+	provider provider
+}
+
+func (r saveResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data saveResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.applyDefaults()
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SaveAll(ctx, data.Flush.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to save the world: %s", err))
+		return
+	}
+	if data.PauseAutosave.Value {
+		if err := client.SaveOff(ctx); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to turn off automatic saving: %s", err))
+			return
+		}
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r saveResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// RCON can't tell whether automatic saving is on; keep state as-is.
+	var data saveResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r saveResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data saveResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.applyDefaults()
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r saveResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// A save can't be undone; only automatic saving is turned back on.
+	var data saveResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.PauseAutosave.Value {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SaveOn(ctx); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to turn automatic saving back on: %s", err))
+		return
+	}
+}
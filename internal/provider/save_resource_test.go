@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSavePausesAutosaveUntilDestroyed(t *testing.T) {
+	tests := []struct {
+		name                string
+		attrs               map[string]tftypes.Value
+		wantCreate, wantDel []string
+	}{
+		{
+			name:       "defaults",
+			attrs:      map[string]tftypes.Value{},
+			wantCreate: []string{"save-all flush"},
+		},
+		{
+			name:       "no flush",
+			attrs:      map[string]tftypes.Value{"flush": tftypes.NewValue(tftypes.Bool, false)},
+			wantCreate: []string{"save-all"},
+		},
+		{
+			name:       "pause autosave",
+			attrs:      map[string]tftypes.Value{"pause_autosave": tftypes.NewValue(tftypes.Bool, true)},
+			wantCreate: []string{"save-all flush", "save-off"},
+			wantDel:    []string{"save-on"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeServer(t, func(command string) string {
+				if command == "save-all" || command == "save-all flush" {
+					return "Saved the game"
+				}
+				return ""
+			})
+			p := configureProvider(t, server.address, nil)
+			state, diags := createResource(t, p, saveResourceType{}, tt.attrs)
+			if diags.HasError() {
+				t.Fatalf("Create: %v", diags)
+			}
+			if got := server.sent(); !reflect.DeepEqual(got, tt.wantCreate) {
+				t.Errorf("create sent %q, want %q", got, tt.wantCreate)
+			}
+			if diags := deleteResource(t, p, saveResourceType{}, state); diags.HasError() {
+				t.Fatalf("Delete: %v", diags)
+			}
+			if got := server.sent()[len(tt.wantCreate):]; len(got)+len(tt.wantDel) > 0 && !reflect.DeepEqual(got, tt.wantDel) {
+				t.Errorf("delete sent %q, want %q", got, tt.wantDel)
+			}
+		})
+	}
+}
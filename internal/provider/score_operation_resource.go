@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = scoreOperationResourceType{}
+var _ tfsdk.Resource = scoreOperationResource{}
+
+// ---------- Resource Type ----------
+
+type scoreOperationResourceType struct{}
+
+func (t scoreOperationResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Trigger that runs `scoreboard players operation` when created. Change `triggers` to run it again; destroying it does nothing in game.",
+		Attributes: map[string]tfsdk.Attribute{
+			"target": {
+				MarkdownDescription: "Score holder to update (player name, selector or fake player like `#total`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"target_objective": {
+				MarkdownDescription: "Objective of the target score.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"operation": {
+				MarkdownDescription: "One of `+=`, `-=`, `*=`, `/=`, `%=`, `=` (assign), `<` (min), `>` (max) or `><` (swap).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"source": {
+				MarkdownDescription: "Score holder read from.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"source_objective": {
+				MarkdownDescription: "Objective of the source score.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, run the operation again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this run.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t scoreOperationResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return scoreOperationResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type scoreOperationResourceData struct {
+	Id              types.String `tfsdk:"id"`
+	Target          string       `tfsdk:"target"`
+	TargetObjective string       `tfsdk:"target_objective"`
+	Operation       string       `tfsdk:"operation"`
+	Source          string       `tfsdk:"source"`
+	SourceObjective string       `tfsdk:"source_objective"`
+	Triggers        types.Map    `tfsdk:"triggers"`
+}
+
+// ---------- Resource Impl ----------
+
+type scoreOperationResource struct {
+	provider provider
+}
+
+func (r scoreOperationResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data scoreOperationResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !minecraft.IsScoreOperator(data.Operation) {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("operation must be one of +=, -=, *=, /=, %%=, =, <, > or >< (got %q)", data.Operation))
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.ScoreOperation(ctx, data.Target, data.TargetObjective, data.Operation, data.Source, data.SourceObjective); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run score operation: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r scoreOperationResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Nothing persists in game; keep state as-is.
+	var data scoreOperationResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r scoreOperationResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data scoreOperationResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r scoreOperationResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Scores stay as they are; just drop it from state.
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure types satisfy framework interfaces
+var _ tfsdk.ResourceType = scoreboardDisplayResourceType{}
+var _ tfsdk.Resource = scoreboardDisplayResource{}
+var _ tfsdk.ResourceWithImportState = scoreboardDisplayResource{}
+
+// -------- Resource Type --------
+
+type scoreboardDisplayResourceType struct{}
+
+func (t scoreboardDisplayResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Shows a scoreboard objective in a display slot (wraps `/scoreboard objectives setdisplay`). The slot is cleared on destroy.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Resource ID (same as `slot`).",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"slot": {
+				Type:                types.StringType,
+				Required:            true,
+				MarkdownDescription: "Display slot. One of `list`, `sidebar`, `belowName`, or `sidebar.team.<color>` (e.g. `sidebar.team.red`).",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(), // a different slot is a different display
+				},
+			},
+			"objective": {
+				Type:                types.StringType,
+				Required:            true,
+				MarkdownDescription: "Name of the objective to display.",
+			},
+		},
+	}, nil
+}
+
+func (t scoreboardDisplayResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return scoreboardDisplayResource{provider: p}, diags
+}
+
+// -------- Data & Resource --------
+
+type scoreboardDisplayResourceData struct {
+	ID        types.String `tfsdk:"id"`
+	Slot      types.String `tfsdk:"slot"`
+	Objective types.String `tfsdk:"objective"`
+}
+
+type scoreboardDisplayResource struct {
+	provider provider
+}
+
+// -------- CRUD --------
+
+func (r scoreboardDisplayResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan scoreboardDisplayResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slot := strings.TrimSpace(plan.Slot.Value)
+	if err := validateDisplaySlot(slot); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SetObjectiveDisplay(ctx, slot, plan.Objective.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to display objective in %q: %s", slot, err))
+		return
+	}
+
+	plan.ID = types.String{Value: slot}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r scoreboardDisplayResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// No RCON query for display slots; keep state as-is.
+	var state scoreboardDisplayResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r scoreboardDisplayResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Only `objective` can change in place; `slot` is ForceNew.
+	var plan scoreboardDisplayResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	slot := strings.TrimSpace(plan.Slot.Value)
+	if err := client.SetObjectiveDisplay(ctx, slot, plan.Objective.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to display objective in %q: %s", slot, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r scoreboardDisplayResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state scoreboardDisplayResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	slot := strings.TrimSpace(state.Slot.Value)
+	if err := client.ClearObjectiveDisplay(ctx, slot); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear display slot %q: %s", slot, err))
+		return
+	}
+}
+
+func (r scoreboardDisplayResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// Import by slot name; config supplies the objective.
+	slot := strings.TrimSpace(req.ID)
+	if err := validateDisplaySlot(slot); err != nil {
+		resp.Diagnostics.AddError("Import Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), slot)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("slot"), slot)...)
+}
+
+// -------- Helpers --------
+
+func validateDisplaySlot(slot string) error {
+	switch slot {
+	case "list", "sidebar", "belowName":
+		return nil
+	}
+	if color := strings.TrimPrefix(slot, "sidebar.team."); color != slot {
+		// sidebar.team.<color> takes the formatting colors only, not "reset".
+		for _, c := range minecraft.ValidTeamColors {
+			if c == color && c != "reset" {
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown team color %q in slot %q", color, slot)
+	}
+	return fmt.Errorf("slot must be one of: list, sidebar, belowName, sidebar.team.<color> (got %q)", slot)
+}
@@ -0,0 +1,16 @@
+package provider
+
+import "testing"
+
+func TestValidateDisplaySlot(t *testing.T) {
+	for _, slot := range []string{"list", "sidebar", "belowName", "sidebar.team.red", "sidebar.team.light_purple"} {
+		if err := validateDisplaySlot(slot); err != nil {
+			t.Errorf("validateDisplaySlot(%q): %v", slot, err)
+		}
+	}
+	for _, slot := range []string{"", "side", "belowname", "sidebar.team.", "sidebar.team.pink", "sidebar.team.reset"} {
+		if err := validateDisplaySlot(slot); err == nil {
+			t.Errorf("validateDisplaySlot(%q) accepted an invalid slot", slot)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure types satisfy framework interfaces
+var _ tfsdk.ResourceType = scoreboardObjectiveResourceType{}
+var _ tfsdk.Resource = scoreboardObjectiveResource{}
+
+// -------- Resource Type --------
+
+type scoreboardObjectiveResourceType struct{}
+
+func (t scoreboardObjectiveResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A scoreboard objective. `display_name` and `render_type` are changed in place with `scoreboard objectives modify`; a new `name` or `criterion` recreates the objective (and drops its scores).",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Resource ID (same as `name`).",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:                types.StringType,
+				Required:            true,
+				MarkdownDescription: "Objective name.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"criterion": {
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Criterion tracked by the objective, e.g. `deathCount`, `teamkill.red` or `minecraft.killed:minecraft.zombie`. Checked at plan time; criteria are case-sensitive. Defaults to `dummy`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					objectiveCriterionValidator{},
+				},
+			},
+			"display_name": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Display name shown in UI (defaults to `name`).",
+			},
+			"render_type": {
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "How scores show in the tab list: `integer` (default) or `hearts`.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("integer", "hearts"),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t scoreboardObjectiveResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return scoreboardObjectiveResource{provider: p}, diags
+}
+
+// -------- Data & Resource --------
+
+type scoreboardObjectiveResourceData struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Criterion   types.String `tfsdk:"criterion"`
+	DisplayName types.String `tfsdk:"display_name"`
+	RenderType  types.String `tfsdk:"render_type"`
+}
+
+type scoreboardObjectiveResource struct {
+	provider provider
+}
+
+func (d *scoreboardObjectiveResourceData) applyDefaults() {
+	if d.Criterion.Null || d.Criterion.Unknown {
+		d.Criterion = types.String{Value: "dummy"}
+	}
+	if d.RenderType.Null || d.RenderType.Unknown {
+		d.RenderType = types.String{Value: "integer"}
+	}
+	d.RenderType.Value = strings.ToLower(d.RenderType.Value)
+}
+
+// objectiveCriterionValidator catches criterion typos at plan time; the server
+// would only reject them during apply.
+type objectiveCriterionValidator struct{}
+
+func (v objectiveCriterionValidator) Description(ctx context.Context) string {
+	return "value must be a scoreboard criterion such as dummy, teamkill.red or minecraft.killed:minecraft.zombie"
+}
+
+func (v objectiveCriterionValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a scoreboard criterion such as `dummy`, `teamkill.red` or `minecraft.killed:minecraft.zombie`"
+}
+
+func (v objectiveCriterionValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := req.AttributeConfig.(types.String)
+	if !ok || s.Null || s.Unknown {
+		return
+	}
+	if err := minecraft.ValidateObjectiveCriterion(strings.TrimSpace(s.Value)); err != nil {
+		resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid Criterion", err.Error())
+	}
+}
+
+// -------- CRUD --------
+
+func (r scoreboardObjectiveResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan scoreboardObjectiveResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.applyDefaults()
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	name := strings.TrimSpace(plan.Name.Value)
+	if err := client.CreateObjective(ctx, name, strings.TrimSpace(plan.Criterion.Value), plan.DisplayName.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create objective %q: %s", name, err))
+		return
+	}
+
+	// New objectives render as integers; only hearts needs an extra command.
+	if plan.RenderType.Value != "integer" {
+		if err := client.ModifyObjectiveRenderType(ctx, name, plan.RenderType.Value); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set render type of objective %q: %s", name, err))
+			return
+		}
+	}
+
+	plan.ID = types.String{Value: name}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r scoreboardObjectiveResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Objective settings can't be queried over RCON; keep state as-is.
+	var state scoreboardObjectiveResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r scoreboardObjectiveResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Only display_name and render_type change in place.
+	var plan, state scoreboardObjectiveResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.applyDefaults()
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	name := strings.TrimSpace(plan.Name.Value)
+	if !plan.DisplayName.Equal(state.DisplayName) {
+		// A removed display_name falls back to the objective name, as on create.
+		display := plan.DisplayName.Value
+		if plan.DisplayName.Null {
+			display = name
+		}
+		if err := client.ModifyObjectiveDisplayName(ctx, name, display); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change display name of objective %q: %s", name, err))
+			return
+		}
+	}
+	if plan.RenderType.Value != state.RenderType.Value {
+		if err := client.ModifyObjectiveRenderType(ctx, name, plan.RenderType.Value); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set render type of objective %q: %s", name, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r scoreboardObjectiveResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state scoreboardObjectiveResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	name := strings.TrimSpace(state.Name.Value)
+	if err := client.RemoveObjective(ctx, name); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove objective %q: %s", name, err))
+		return
+	}
+}
@@ -5,11 +5,11 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -26,12 +26,12 @@ func (t sheepResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Di
 		MarkdownDescription: "Summon and manage a Minecraft sheep with color and sheared state.",
 		Attributes: map[string]tfsdk.Attribute{
 			"position": {
-				MarkdownDescription: "Where to summon the sheep.",
+				MarkdownDescription: "Where to summon the sheep. Use `.5` to center it on a block.",
 				Required:            true,
 				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
 					"x": {
 						MarkdownDescription: "X coordinate",
-						Type:                types.Int64Type,
+						Type:                types.Float64Type,
 						Required:            true,
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							tfsdk.RequiresReplace(),
@@ -39,7 +39,7 @@ func (t sheepResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Di
 					},
 					"y": {
 						MarkdownDescription: "Y coordinate",
-						Type:                types.Int64Type,
+						Type:                types.Float64Type,
 						Required:            true,
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							tfsdk.RequiresReplace(),
@@ -47,7 +47,7 @@ func (t sheepResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Di
 					},
 					"z": {
 						MarkdownDescription: "Z coordinate",
-						Type:                types.Int64Type,
+						Type:                types.Float64Type,
 						Required:            true,
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							tfsdk.RequiresReplace(),
@@ -72,6 +72,45 @@ func (t sheepResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Di
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"name": mobNameAttribute("sheep"),
+			"health": {
+				MarkdownDescription: "Sheep health (float), above 0 and at most `max_health`, or `8.0` without it. Defaults to `max_health` when that is set, otherwise `8.0`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Float64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"max_health": {
+				MarkdownDescription: "Base value of the sheep's max health attribute, applied right after summoning so `health` above 8 isn't clamped. Must be between `health` and 1024.",
+				Optional:            true,
+				Type:                types.Float64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"no_gravity": {
+				MarkdownDescription: "If true, the sheep floats in place instead of falling. Defaults to `false` if not set.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"invulnerable": {
+				MarkdownDescription: "If true, the sheep can't be hurt by players, mobs or the environment; destroying the resource still removes it. Defaults to `false` if not set.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"silent":           silentAttribute("sheep"),
+			"team":             summonTeamAttribute("sheep"),
+			"death_loot_table": deathLootTableAttribute("sheep"),
 			"id": {
 				Computed:            true,
 				MarkdownDescription: "Stable UUID used as the entity's CustomName/tag.",
@@ -94,12 +133,20 @@ func (t sheepResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (
 type sheepResourceData struct {
 	Id       types.String `tfsdk:"id"`
 	Position struct {
-		X int64 `tfsdk:"x"`
-		Y int64 `tfsdk:"y"`
-		Z int64 `tfsdk:"z"`
+		X float64 `tfsdk:"x"`
+		Y float64 `tfsdk:"y"`
+		Z float64 `tfsdk:"z"`
 	} `tfsdk:"position"`
-	Color   string     `tfsdk:"color"`
-	Sheared types.Bool `tfsdk:"sheared"`
+	Color          string        `tfsdk:"color"`
+	Sheared        types.Bool    `tfsdk:"sheared"`
+	Name           types.String  `tfsdk:"name"`
+	Health         types.Float64 `tfsdk:"health"`
+	MaxHealth      types.Float64 `tfsdk:"max_health"`
+	NoGravity      types.Bool    `tfsdk:"no_gravity"`
+	Invulnerable   types.Bool    `tfsdk:"invulnerable"`
+	Silent         types.Bool    `tfsdk:"silent"`
+	Team           types.String  `tfsdk:"team"`
+	DeathLootTable types.String  `tfsdk:"death_loot_table"`
 }
 
 // ---------- Resource Impl ----------
@@ -116,6 +163,16 @@ func (r sheepResource) Create(ctx context.Context, req tfsdk.CreateResourceReque
 		return
 	}
 
+	if err := validateEntityName(data.Name.Value); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	// Default health to full (max_health, or 8.0) when null/unknown
+	if err := resolveMobHealth(&data.Health, data.MaxHealth, sheepMaxHealth); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
 	client, err := r.provider.GetClient(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
@@ -126,16 +183,34 @@ func (r sheepResource) Create(ctx context.Context, req tfsdk.CreateResourceReque
 	if data.Sheared.Null || data.Sheared.Unknown {
 		data.Sheared = types.Bool{Value: false}
 	}
+	if data.NoGravity.Null || data.NoGravity.Unknown {
+		data.NoGravity = types.Bool{Value: false}
+	}
+	if data.Invulnerable.Null || data.Invulnerable.Unknown {
+		data.Invulnerable = types.Bool{Value: false}
+	}
 
-	id := uuid.NewString()
-	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
+	id := r.provider.newEntityID("minecraft:sheep", pos)
 
 	// Use the specialized client method to include sheep-specific NBT
-	if err := client.CreateSheep(ctx, pos, id, strings.ToLower(data.Color), data.Sheared.Value); err != nil {
+	if err := client.CreateSheep(ctx, pos, id, data.Name.Value, strings.ToLower(data.Color), data.Sheared.Value, float32(data.Health.Value), data.NoGravity.Value, data.Invulnerable.Value, data.Silent.Value, data.DeathLootTable.Value); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon sheep: %s", err))
 		return
 	}
 
+	if !data.MaxHealth.Null && !data.MaxHealth.Unknown {
+		r.provider.raiseMaxHealth(ctx, client, "minecraft:sheep", id, data.Health.Value, data.MaxHealth.Value, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	joinSummonedTeam(ctx, client, "minecraft:sheep", pos, id, data.Team, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.Id = types.String{Value: id}
 
 	diags = resp.State.Set(ctx, &data)
@@ -172,13 +247,17 @@ func (r sheepResource) Delete(ctx context.Context, req tfsdk.DeleteResourceReque
 		return
 	}
 
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("minecraft:sheep %s", data.Id.Value)) {
+		return
+	}
+
 	client, err := r.provider.GetClient(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
 		return
 	}
 
-	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
 	if err := client.DeleteEntity(ctx, "minecraft:sheep", pos, data.Id.Value); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sheep: %s", err))
 		return
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = shulkerResourceType{}
+var _ tfsdk.Resource = shulkerResource{}
+var _ tfsdk.ResourceWithImportState = shulkerResource{}
+
+// ---------- Resource Type ----------
+
+type shulkerResourceType struct{}
+
+func (t shulkerResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Summon a shulker, e.g. as a colored turret in an End city build. Shulkers teleport when their block is removed, so `position` is where it was summoned, not necessarily where it is now.",
+		Attributes: map[string]tfsdk.Attribute{
+			"position": {
+				MarkdownDescription: "Where to summon the shulker. Shulkers snap to the block they are in.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"color": {
+				MarkdownDescription: "Dye color of the shell (e.g. `light_blue`), or `default` for the undyed purple. Defaults to `default`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOfExact(append(append([]string{}, minecraft.DyeColors...), "default")...),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"attach_face": {
+				MarkdownDescription: "Face of the neighboring block the shulker attaches to: `down` (the block below), `up`, `north`, `south`, `west` or `east`. Defaults to `down`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOfExact(minecraft.ShulkerFaces...),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"peek": {
+				MarkdownDescription: fmt.Sprintf("How far the shell starts open, from 0 (closed) to %d (fully open). The shulker opens and closes on its own after spawning. Defaults to `0`.", minecraft.MaxShulkerPeek),
+				Optional:            true,
+				Computed:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Stable UUID used as the entity's CustomName/tag.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t shulkerResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return shulkerResource{provider: provider}, diags
+}
+
+// ---------- Resource Data ----------
+
+type shulkerResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Position struct {
+		X float64 `tfsdk:"x"`
+		Y float64 `tfsdk:"y"`
+		Z float64 `tfsdk:"z"`
+	} `tfsdk:"position"`
+	Color      types.String `tfsdk:"color"`
+	AttachFace types.String `tfsdk:"attach_face"`
+	Peek       types.Int64  `tfsdk:"peek"`
+}
+
+// ---------- Resource Impl ----------
+
+type shulkerResource struct {
+	provider provider
+}
+
+func (r shulkerResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data shulkerResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Color.Null || data.Color.Unknown {
+		data.Color = types.String{Value: "default"}
+	}
+	if data.AttachFace.Null || data.AttachFace.Unknown {
+		data.AttachFace = types.String{Value: "down"}
+	}
+	if data.Peek.Null || data.Peek.Unknown {
+		data.Peek = types.Int64{Value: 0}
+	}
+	if data.Peek.Value < 0 || data.Peek.Value > minecraft.MaxShulkerPeek {
+		resp.Diagnostics.AddError("Validation Error", fmt.Sprintf("peek must be between 0 and %d (got %d)", minecraft.MaxShulkerPeek, data.Peek.Value))
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
+	id := r.provider.newEntityID("minecraft:shulker", pos)
+
+	if err := client.CreateShulker(ctx, pos, id, data.Color.Value, data.AttachFace.Value, byte(data.Peek.Value)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon shulker: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: id}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r shulkerResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data shulkerResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // no live read yet
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r shulkerResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data shulkerResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r shulkerResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data shulkerResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("minecraft:shulker %s", data.Id.Value)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
+	if err := client.DeleteEntity(ctx, "minecraft:shulker", pos, data.Id.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete shulker: %s", err))
+		return
+	}
+}
+
+func (r shulkerResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// Import by UUID (id). Config must specify matching position.
+	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestShulkerColorFaceAndPeek(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := shulkerResourceType{}.GetSchema(ctx)
+	for _, tt := range []struct {
+		config map[string]tftypes.Value
+		want   string
+	}{
+		{nil, "Color:16b,AttachFace:0b,Peek:0b"},
+		{map[string]tftypes.Value{
+			"color":       tftypes.NewValue(tftypes.String, "red"),
+			"attach_face": tftypes.NewValue(tftypes.String, "east"),
+			"peek":        tftypes.NewValue(tftypes.Number, 100),
+		}, "Color:14b,AttachFace:5b,Peek:100b"},
+	} {
+		server := newFakeServer(t, nil)
+		p := configureProvider(t, server.address, nil)
+
+		config := map[string]tftypes.Value{"position": xyzValue(ctx, schema, "position", 0.5, 64, 0.5)}
+		for k, v := range tt.config {
+			config[k] = v
+		}
+		state, diags := createResource(t, p, shulkerResourceType{}, config)
+		if diags.HasError() {
+			t.Fatalf("Create: %v", diags)
+		}
+		sent := server.sent()
+		if len(sent) != 1 || !strings.HasPrefix(sent[0], "summon minecraft:shulker 0.5 64 0.5 {") || !strings.Contains(sent[0], tt.want) {
+			t.Fatalf("sent %q, want one summon with %s", sent, tt.want)
+		}
+
+		if diags := deleteResource(t, p, shulkerResourceType{}, state); diags.HasError() {
+			t.Fatalf("Delete: %v", diags)
+		}
+		want := "kill @e[type=minecraft:shulker,tag=" + stateString(t, state, "id") + "]"
+		if !containsCommand(server.sent()[1:], want) {
+			t.Errorf("delete sent %q, want %q", server.sent()[1:], want)
+		}
+	}
+}
+
+func TestShulkerPeekOutOfRange(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := shulkerResourceType{}.GetSchema(ctx)
+	_, diags := createResource(t, p, shulkerResourceType{}, map[string]tftypes.Value{
+		"position": xyzValue(ctx, schema, "position", 0, 64, 0),
+		"peek":     tftypes.NewValue(tftypes.Number, 101),
+	})
+	if !diags.HasError() || !strings.Contains(diags[0].Detail(), "between 0 and 100") {
+		t.Errorf("diags = %v, want a range error", diags)
+	}
+	if sent := server.sent(); len(sent) != 0 {
+		t.Errorf("sent %q for an invalid peek", sent)
+	}
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = signResourceType{}
+var _ tfsdk.Resource = signResource{}
+
+type signResourceType struct{}
+
+func (t signResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A Minecraft sign with text on its front and back (1.20+ double-sided signs). Changing the text edits the sign in place instead of replacing the block.",
+		Attributes: map[string]tfsdk.Attribute{
+			"material": {
+				MarkdownDescription: "The sign block, optionally with block states (e.g. `minecraft:oak_sign[rotation=8]`, `minecraft:spruce_wall_sign[facing=north]`).",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"position": {
+				MarkdownDescription: "The position of the sign.",
+				Required:            true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"y": {
+						MarkdownDescription: "Y coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate of the block",
+						Type:                types.NumberType,
+						Required:            true,
+						PlanModifiers: tfsdk.AttributePlanModifiers{
+							tfsdk.RequiresReplace(),
+						},
+					},
+				}),
+			},
+			"front_lines": {
+				MarkdownDescription: "Up to 4 lines of text on the front of the sign.",
+				Optional:            true,
+				Type:                types.ListType{ElemType: types.StringType},
+			},
+			"back_lines": {
+				MarkdownDescription: "Up to 4 lines of text on the back of the sign.",
+				Optional:            true,
+				Type:                types.ListType{ElemType: types.StringType},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "ID of the block",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Type: types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t signResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return signResource{provider: provider}, diags
+}
+
+type signResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Material string       `tfsdk:"material"`
+	Position struct {
+		X int `tfsdk:"x"`
+		Y int `tfsdk:"y"`
+		Z int `tfsdk:"z"`
+	} `tfsdk:"position"`
+	FrontLines []string `tfsdk:"front_lines"`
+	BackLines  []string `tfsdk:"back_lines"`
+}
+
+type signResource struct {
+	provider provider
+}
+
+// signLines pads a side's lines to the four a sign holds.
+func signLines(side string, lines []string) ([4]string, error) {
+	var out [4]string
+	if len(lines) > len(out) {
+		return out, fmt.Errorf("%s may hold at most %d lines (got %d)", side, len(out), len(lines))
+	}
+	copy(out[:], lines)
+	return out, nil
+}
+
+func (d signResourceData) validate() error {
+	if err := validateBlockState(d.Material); err != nil {
+		return err
+	}
+	name := d.Material
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	if !strings.HasSuffix(name, "_sign") {
+		return fmt.Errorf("material must be a sign block (e.g. minecraft:oak_sign), got %q", d.Material)
+	}
+	if _, err := signLines("front_lines", d.FrontLines); err != nil {
+		return err
+	}
+	_, err := signLines("back_lines", d.BackLines)
+	return err
+}
+
+func (r signResource) writeText(ctx context.Context, data signResourceData, diags *diag.Diagnostics) {
+	front, _ := signLines("front_lines", data.FrontLines)
+	back, _ := signLines("back_lines", data.BackLines)
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	if err := client.EditSignText(ctx, data.Position.X, data.Position.Y, data.Position.Z, front, back); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to edit sign text, got error: %s", err))
+	}
+}
+
+func (r signResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data signResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	// Place and write in one go; a sign whose text can't be written is removed again.
+	front, _ := signLines("front_lines", data.FrontLines)
+	back, _ := signLines("back_lines", data.BackLines)
+	err = client.SetBlockWithNBT(ctx, data.Material, data.Position.X, data.Position.Y, data.Position.Z, nil, minecraft.SignTextNBT(front, back))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to place sign, got error: %s", err))
+		return
+	}
+
+	data.Id = types.String{Value: fmt.Sprintf("sign-%d-%d-%d", data.Position.X, data.Position.Y, data.Position.Z)}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r signResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data signResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r signResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Only the text can change in place; material and position are ForceNew.
+	var data signResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	r.writeText(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r signResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data signResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("sign at %d,%d,%d", data.Position.X, data.Position.Y, data.Position.Z)) {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client, got error: %s", err))
+		return
+	}
+
+	if err := client.DeleteBlock(ctx, data.Position.X, data.Position.Y, data.Position.Z); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove sign, got error: %s", err))
+		return
+	}
+}
@@ -78,6 +78,11 @@ func (t stairsResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 				Optional:            true,
 				Type:                types.BoolType,
 			},
+			"mode": {
+				MarkdownDescription: "Placement mode: `replace` (default) overwrites the existing block, `keep` only places where there is air, `destroy` breaks the existing block first (dropping it as an item). With `keep`, creation fails when the position isn't air, so the resource never owns (or later clears) a block it didn't place.",
+				Optional:            true,
+				Type:                types.StringType,
+			},
 
 			"id": {
 				Computed:            true,
@@ -105,10 +110,11 @@ type stairsResourceData struct {
 		Z int `tfsdk:"z"`
 	} `tfsdk:"position"`
 
-	Facing      string `tfsdk:"facing"`      // north|south|east|west
-	Half        string `tfsdk:"half"`        // top|bottom
-	Shape       string `tfsdk:"shape"`       // straight|inner_left|inner_right|outer_left|outer_right
-	Waterlogged *bool  `tfsdk:"waterlogged"` // optional
+	Facing      string  `tfsdk:"facing"`      // north|south|east|west
+	Half        string  `tfsdk:"half"`        // top|bottom
+	Shape       string  `tfsdk:"shape"`       // straight|inner_left|inner_right|outer_left|outer_right
+	Waterlogged *bool   `tfsdk:"waterlogged"` // optional
+	Mode        *string `tfsdk:"mode"`        // optional: replace|keep|destroy
 }
 
 type stairsResource struct {
@@ -134,6 +140,12 @@ func (r stairsResource) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		water = *data.Waterlogged
 	}
 
+	mode, err := setblockModeFromConfig(data.Mode)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
 	// Optional: guard materials if you want
 	// if !strings.HasSuffix(data.Material, "_stairs") {
 	// 	resp.Diagnostics.AddError("Validation Error", "material must be a *_stairs block")
@@ -149,6 +161,7 @@ func (r stairsResource) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		data.Half,
 		data.Shape,
 		water,
+		mode,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create stairs, got error: %s", err))
@@ -191,6 +204,13 @@ func (r stairsResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequ
 		water = *data.Waterlogged
 	}
 
+	mode, err := setblockModeFromConfig(data.Mode)
+	if err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	mode = ownedSetblockMode(mode)
+
 	err = client.CreateStairs(
 		ctx,
 		data.Material,
@@ -199,6 +219,7 @@ func (r stairsResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequ
 		data.Half,
 		data.Shape,
 		water,
+		mode,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update stairs, got error: %s", err))
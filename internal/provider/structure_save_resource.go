@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = structureSaveResourceType{}
+var _ tfsdk.Resource = structureSaveResource{}
+
+// ---------- Resource Type ----------
+
+type structureSaveResourceType struct{}
+
+func (t structureSaveResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: fmt.Sprintf("Saves a region as a named structure when created, so it can be loaded again with `/place template`. Java has no save command, so a structure block and a redstone block are briefly placed in the two blocks above the region's low corner column; both positions are left as air. Each edge may be at most %d blocks. Change `triggers` to save again. Destroying the resource keeps the structure file, since RCON can't delete it.", minecraft.MaxStructureSize),
+		Attributes: map[string]tfsdk.Attribute{
+			"name": {
+				MarkdownDescription: "Structure name, e.g. `terraform:house`. Without a namespace, `minecraft:` is used.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"from": {
+				MarkdownDescription: "One corner of the region (inclusive).",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(cornerAttributes()),
+			},
+			"to": {
+				MarkdownDescription: "The opposite corner of the region (inclusive).",
+				Required:            true,
+				Attributes:          tfsdk.SingleNestedAttributes(cornerAttributes()),
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, save the region again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "The structure name.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t structureSaveResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return structureSaveResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type structureSaveResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Name     string       `tfsdk:"name"`
+	From     cornerModel  `tfsdk:"from"`
+	To       cornerModel  `tfsdk:"to"`
+	Triggers types.Map    `tfsdk:"triggers"`
+}
+
+func (d structureSaveResourceData) validate() error {
+	if !resourceIDPattern.MatchString(d.Name) {
+		return fmt.Errorf("name must be a structure ID such as terraform:house (got %q)", d.Name)
+	}
+	from, to := d.From.coords(), d.To.coords()
+	for i, axis := range []string{"x", "y", "z"} {
+		size := from[i] - to[i]
+		if size < 0 {
+			size = -size
+		}
+		if size+1 > minecraft.MaxStructureSize {
+			return fmt.Errorf("region is %d blocks along %s; at most %d can be saved", size+1, axis, minecraft.MaxStructureSize)
+		}
+	}
+	return nil
+}
+
+// ---------- Resource Impl ----------
+
+type structureSaveResource struct {
+	provider provider
+}
+
+func (r structureSaveResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data structureSaveResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SaveStructure(ctx, data.Name, data.From.coords(), data.To.coords()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to save structure %q: %s", data.Name, err))
+		return
+	}
+
+	data.Id = types.String{Value: data.Name}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r structureSaveResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// RCON can't list saved structures; keep state as-is.
+	var data structureSaveResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r structureSaveResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data structureSaveResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r structureSaveResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// The structure file stays on the server; there is no RCON command to remove it.
+}
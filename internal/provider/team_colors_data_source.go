@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = teamColorsDataSourceType{}
+var _ tfsdk.DataSource = teamColorsDataSource{}
+
+type teamColorsDataSourceType struct{}
+
+func (t teamColorsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Lists the colors accepted by `minecraft_team.color`. Needs no server connection.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Always `team_colors`.",
+				Type:                types.StringType,
+			},
+			"colors": {
+				Computed:            true,
+				MarkdownDescription: "The 16 formatting colors followed by `reset`.",
+				Type:                types.ListType{ElemType: types.StringType},
+			},
+		},
+	}, nil
+}
+
+func (t teamColorsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return teamColorsDataSource{}, nil
+}
+
+type teamColorsDataSourceData struct {
+	Id     types.String `tfsdk:"id"`
+	Colors []string     `tfsdk:"colors"`
+}
+
+type teamColorsDataSource struct{}
+
+func (d teamColorsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	data := teamColorsDataSourceData{
+		Id:     types.String{Value: "team_colors"},
+		Colors: append([]string(nil), minecraft.ValidTeamColors...),
+	}
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
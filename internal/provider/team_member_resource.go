@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
 )
 
 // Ensure framework interfaces
@@ -65,6 +66,11 @@ func (t teamMemberResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, di
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"require_match": {
+				Type:                types.BoolType,
+				Optional:            true,
+				MarkdownDescription: "If true, fail when the player/selector/entity matched nothing instead of silently succeeding.",
+			},
 		},
 	}, nil
 }
@@ -77,11 +83,12 @@ func (t teamMemberResourceType) NewResource(ctx context.Context, in tfsdk.Provid
 // ----- Data Model -----
 
 type teamMemberData struct {
-	ID       types.String `tfsdk:"id"`
-	Team     types.String `tfsdk:"team"`
-	Player   types.String `tfsdk:"player"`
-	Selector types.String `tfsdk:"selector"`
-	EntityID types.String `tfsdk:"entity_id"`
+	ID           types.String `tfsdk:"id"`
+	Team         types.String `tfsdk:"team"`
+	Player       types.String `tfsdk:"player"`
+	Selector     types.String `tfsdk:"selector"`
+	EntityID     types.String `tfsdk:"entity_id"`
+	RequireMatch types.Bool   `tfsdk:"require_match"`
 }
 
 type teamMemberResource struct {
@@ -111,6 +118,30 @@ func (r teamMemberResource) Create(ctx context.Context, req tfsdk.CreateResource
 
 	team := strings.TrimSpace(plan.Team.Value)
 
+	if plan.RequireMatch.Value {
+		target := val
+		if kind == "entity" {
+			target = minecraft.SelectorByCustomName(val)
+		}
+		n, ok, err := client.JoinTeamTargetsCounted(ctx, team, target)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add %s %q to team %q: %s", kind, val, team, err))
+			return
+		}
+		if ok && n == 0 {
+			resp.Diagnostics.AddError(
+				"No Match",
+				fmt.Sprintf("The %s %q matched no entities, so nothing joined team %q. Check the target or unset `require_match`.", kind, val, team),
+			)
+			return
+		}
+
+		plan.ID = types.String{Value: fmt.Sprintf("%s|%s|%s", team, kind, val)}
+		diags = resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	switch kind {
 	case "player":
 		if err := client.JoinTeamPlayers(ctx, team, val); err != nil {
@@ -270,3 +301,32 @@ func parseIDFallback(id string) (kind, value string) {
 	}
 	return "", ""
 }
+
+// summonTeamAttribute is the `team` attribute of resources that summon a
+// tagged entity and can join it to a team straight away.
+func summonTeamAttribute(what string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		MarkdownDescription: fmt.Sprintf("Team the %s joins right after it is summoned, saving a separate `minecraft_team_member`. The team must already exist; killing the %s on destroy ends the membership.", what, what),
+		Optional:            true,
+		Type:                types.StringType,
+		Validators: []tfsdk.AttributeValidator{
+			teamName(),
+		},
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			tfsdk.RequiresReplace(),
+		},
+	}
+}
+
+// joinSummonedTeam joins the entity tagged id to team, if one is set. If the
+// join fails the entity is killed again, so it isn't left behind outside the
+// team that was asked for.
+func joinSummonedTeam(ctx context.Context, client *minecraft.Client, entity, pos, id string, team types.String, diags *diag.Diagnostics) {
+	if team.Null || team.Value == "" {
+		return
+	}
+	if err := client.JoinTeamEntitiesByTag(ctx, team.Value, id); err != nil {
+		_ = client.DeleteEntity(ctx, entity, pos, id)
+		diags.AddError("Client Error", fmt.Sprintf("Unable to join %s to team %q: %s", entity, team.Value, err))
+	}
+}
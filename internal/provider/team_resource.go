@@ -3,12 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
 )
 
 // Ensure types satisfy framework interfaces
@@ -48,7 +50,20 @@ func (t teamResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Dia
 			"color": {
 				Type:                types.StringType,
 				Optional:            true,
-				MarkdownDescription: "Team color (e.g. `red`, `blue`, `gold`, `dark_purple`, etc.).",
+				MarkdownDescription: "Team color (e.g. `red`, `blue`, `gold`, `dark_purple`, etc., or `reset`). See the `minecraft_team_colors` data source for the full list.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf(minecraft.ValidTeamColors...),
+				},
+			},
+			"prefix": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Text shown before member names, e.g. `[Red] `. Removing it resets the prefix.",
+			},
+			"suffix": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Text shown after member names. Removing it resets the suffix.",
 			},
 			"friendly_fire": {
 				Type:                types.BoolType,
@@ -64,11 +79,17 @@ func (t teamResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Dia
 				Type:                types.StringType,
 				Optional:            true,
 				MarkdownDescription: "One of `always`, `never`, `hideForOtherTeams`, `hideForOwnTeam`.",
+				Validators: []tfsdk.AttributeValidator{
+					teamNametagVisibility(),
+				},
 			},
 			"collision_rule": {
 				Type:                types.StringType,
 				Optional:            true,
 				MarkdownDescription: "One of `always`, `never`, `pushOtherTeams`, `pushOwnTeam`.",
+				Validators: []tfsdk.AttributeValidator{
+					teamCollisionRule(),
+				},
 			},
 		},
 	}, nil
@@ -86,6 +107,8 @@ type teamResourceData struct {
 	Name                  types.String `tfsdk:"name"`
 	DisplayName           types.String `tfsdk:"display_name"`
 	Color                 types.String `tfsdk:"color"`
+	Prefix                types.String `tfsdk:"prefix"`
+	Suffix                types.String `tfsdk:"suffix"`
 	FriendlyFire          types.Bool   `tfsdk:"friendly_fire"`
 	SeeFriendlyInvisibles types.Bool   `tfsdk:"see_friendly_invisibles"`
 	NametagVisibility     types.String `tfsdk:"nametag_visibility"`
@@ -135,13 +158,33 @@ func (r teamResource) Create(ctx context.Context, req tfsdk.CreateResourceReques
 }
 
 func (r teamResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
-	// Minimal read; keep state as-is. (Add drift detection later by parsing `/team list`.)
+	// A team removed in game is dropped from state so it gets recreated.
+	// RCON can't read a team's options back, so the last applied values are
+	// kept rather than guessing at drift.
 	var state teamResourceData
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	name := strings.TrimSpace(state.Name.Value)
+	exists, err := client.TeamExists(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team %q: %s", name, err))
+		return
+	}
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -176,6 +219,11 @@ func (r teamResource) Update(ctx context.Context, req tfsdk.UpdateResourceReques
 		}
 	}
 
+	// Options removed from config => back to the vanilla default
+	if err := resetTeamOptions(ctx, client, name, plan, state, &resp.Diagnostics); err != nil {
+		return
+	}
+
 	// Apply (or re-apply) the rest of the options
 	if err := applyTeamOptions(ctx, client, name, plan, &resp.Diagnostics); err != nil {
 		return
@@ -223,10 +271,13 @@ func equalString(a, b types.String) bool {
 type teamOptionClient interface {
 	SetTeamDisplayName(ctx context.Context, name, display string) error
 	SetTeamColor(ctx context.Context, name, color string) error
+	SetTeamPrefix(ctx context.Context, name, prefix string) error
+	SetTeamSuffix(ctx context.Context, name, suffix string) error
 	SetTeamFriendlyFire(ctx context.Context, name string, enabled bool) error
 	SetTeamSeeFriendlyInvisibles(ctx context.Context, name string, enabled bool) error
 	SetTeamNametagVisibility(ctx context.Context, name, mode string) error
 	SetTeamCollisionRule(ctx context.Context, name, rule string) error
+	ResetTeamOption(ctx context.Context, name, option string) error
 	CreateTeam(ctx context.Context, name, display string) error
 	DeleteTeam(ctx context.Context, name string) error
 }
@@ -239,6 +290,19 @@ func applyTeamOptions(ctx context.Context, c teamOptionClient, name string, d te
 			return err
 		}
 	}
+	// prefix / suffix
+	if !d.Prefix.Null {
+		if err := c.SetTeamPrefix(ctx, name, d.Prefix.Value); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set prefix: %s", err))
+			return err
+		}
+	}
+	if !d.Suffix.Null {
+		if err := c.SetTeamSuffix(ctx, name, d.Suffix.Value); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set suffix: %s", err))
+			return err
+		}
+	}
 	// friendlyFire
 	if !d.FriendlyFire.Null {
 		if err := c.SetTeamFriendlyFire(ctx, name, d.FriendlyFire.Value); err != nil {
@@ -269,3 +333,114 @@ func applyTeamOptions(ctx context.Context, c teamOptionClient, name string, d te
 	}
 	return nil
 }
+
+// resetTeamOptions resets every option that is set in state but no longer in
+// the plan, so removing an attribute doesn't leave the old value behind.
+func resetTeamOptions(ctx context.Context, c teamOptionClient, name string, plan, state teamResourceData, diags *diag.Diagnostics) error {
+	for _, o := range []struct {
+		option  string
+		was, is bool
+	}{
+		{"color", !state.Color.Null, !plan.Color.Null},
+		{"prefix", !state.Prefix.Null, !plan.Prefix.Null},
+		{"suffix", !state.Suffix.Null, !plan.Suffix.Null},
+		{"friendlyFire", !state.FriendlyFire.Null, !plan.FriendlyFire.Null},
+		{"seeFriendlyInvisibles", !state.SeeFriendlyInvisibles.Null, !plan.SeeFriendlyInvisibles.Null},
+		{"nametagVisibility", !state.NametagVisibility.Null, !plan.NametagVisibility.Null},
+		{"collisionRule", !state.CollisionRule.Null, !plan.CollisionRule.Null},
+	} {
+		if !o.was || o.is {
+			continue
+		}
+		if err := c.ResetTeamOption(ctx, name, o.option); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to reset %s: %s", o.option, err))
+			return err
+		}
+	}
+	return nil
+}
+
+// Team names are unquoted command words.
+var teamNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.+-]+$`)
+
+// teamNameValidator rejects names the team commands can't take unquoted.
+type teamNameValidator struct{}
+
+func teamName() tfsdk.AttributeValidator {
+	return teamNameValidator{}
+}
+
+func (v teamNameValidator) Description(ctx context.Context) string {
+	return "value must be a team name made of letters, digits and _ . + -"
+}
+
+func (v teamNameValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a team name made of letters, digits and `_ . + -`"
+}
+
+func (v teamNameValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := req.AttributeConfig.(types.String)
+	if !ok || s.Null || s.Unknown {
+		return
+	}
+	if !teamNamePattern.MatchString(s.Value) {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Team Name",
+			fmt.Sprintf("%q is not valid; %s.", s.Value, v.Description(ctx)),
+		)
+	}
+}
+
+// teamNametagVisibility accepts the values of the nametagVisibility team option.
+func teamNametagVisibility() tfsdk.AttributeValidator {
+	return stringOneOfExact("always", "never", "hideForOtherTeams", "hideForOwnTeam")
+}
+
+// teamCollisionRule accepts the values of the collisionRule team option.
+func teamCollisionRule() tfsdk.AttributeValidator {
+	return stringOneOfExact("always", "never", "pushOtherTeams", "pushOwnTeam")
+}
+
+// stringOneOfValidator rejects string values outside a fixed set (case-insensitive
+// unless caseSensitive) at plan time, instead of letting the server refuse them
+// during apply.
+type stringOneOfValidator struct {
+	values        []string
+	caseSensitive bool
+}
+
+func stringOneOf(values ...string) tfsdk.AttributeValidator {
+	return stringOneOfValidator{values: values}
+}
+
+// stringOneOfExact is stringOneOf for values the server matches case-sensitively,
+// such as the camelCase team options.
+func stringOneOfExact(values ...string) tfsdk.AttributeValidator {
+	return stringOneOfValidator{values: values, caseSensitive: true}
+}
+
+func (v stringOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be one of: `%s`", strings.Join(v.values, "`, `"))
+}
+
+func (v stringOneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := req.AttributeConfig.(types.String)
+	if !ok || s.Null || s.Unknown {
+		return
+	}
+	for _, allowed := range v.values {
+		if s.Value == allowed || (!v.caseSensitive && strings.EqualFold(s.Value, allowed)) {
+			return
+		}
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.AttributePath,
+		"Invalid Value",
+		fmt.Sprintf("%q is not valid; %s.", s.Value, v.Description(ctx)),
+	)
+}
@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// validateString runs v against a string attribute value.
+func validateString(v tfsdk.AttributeValidator, value types.String) tfsdk.ValidateAttributeResponse {
+	req := tfsdk.ValidateAttributeRequest{
+		AttributePath:   tftypes.NewAttributePath().WithAttributeName("color"),
+		AttributeConfig: value,
+	}
+	var resp tfsdk.ValidateAttributeResponse
+	v.Validate(context.Background(), req, &resp)
+	return resp
+}
+
+func TestTeamColorValidator(t *testing.T) {
+	v := stringOneOf(minecraft.ValidTeamColors...)
+	if len(minecraft.ValidTeamColors) != 17 {
+		t.Fatalf("ValidTeamColors has %d entries, want 16 colors plus reset", len(minecraft.ValidTeamColors))
+	}
+	for _, color := range append(minecraft.ValidTeamColors, "Dark_Red") {
+		if resp := validateString(v, types.String{Value: color}); resp.Diagnostics.HasError() {
+			t.Errorf("%q rejected: %v", color, resp.Diagnostics)
+		}
+	}
+	for _, color := range []string{"pink", "", "dark red", "#ff0000"} {
+		if resp := validateString(v, types.String{Value: color}); !resp.Diagnostics.HasError() {
+			t.Errorf("%q accepted", color)
+		}
+	}
+	for _, value := range []types.String{{Null: true}, {Unknown: true}} {
+		if resp := validateString(v, value); resp.Diagnostics.HasError() {
+			t.Errorf("%v rejected: %v", value, resp.Diagnostics)
+		}
+	}
+}
+
+func TestResetTeamOptionsRemovedFromConfig(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+	client, err := p.GetClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	null := types.String{Null: true}
+	state := teamResourceData{
+		Color:                 types.String{Value: "red"},
+		Prefix:                types.String{Value: "[R] "},
+		Suffix:                null,
+		FriendlyFire:          types.Bool{Value: false},
+		SeeFriendlyInvisibles: types.Bool{Null: true},
+		NametagVisibility:     null,
+		CollisionRule:         types.String{Value: "never"},
+	}
+	plan := teamResourceData{
+		Color:                 null,
+		Prefix:                null,
+		Suffix:                null,
+		FriendlyFire:          types.Bool{Null: true},
+		SeeFriendlyInvisibles: types.Bool{Null: true},
+		NametagVisibility:     null,
+		CollisionRule:         types.String{Value: "pushOtherTeams"},
+	}
+	var diags diag.Diagnostics
+	if err := resetTeamOptions(ctx, client, "red", plan, state, &diags); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"team modify red color reset",
+		`team modify red prefix {"text":""}`,
+		"team modify red friendlyFire true",
+	}
+	if got := server.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q, want %q", got, want)
+	}
+}
+
+func TestTeamBoolOptionTransitions(t *testing.T) {
+	null := types.Bool{Null: true}
+	tests := []struct {
+		name        string
+		state, plan types.Bool
+		want        []string
+	}{
+		{name: "set true", state: null, plan: types.Bool{Value: true}, want: []string{"team modify red friendlyFire true"}},
+		{name: "set false", state: types.Bool{Value: true}, plan: types.Bool{Value: false}, want: []string{"team modify red friendlyFire false"}},
+		{name: "unset", state: types.Bool{Value: false}, plan: null, want: []string{"team modify red friendlyFire true"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := newFakeServer(t, nil)
+			p := configureProvider(t, server.address, nil)
+			client, err := p.GetClient(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state, plan := nullTeamData(), nullTeamData()
+			state.FriendlyFire, plan.FriendlyFire = tt.state, tt.plan
+			var diags diag.Diagnostics
+			// As in Update: reset what was removed, then apply what is set.
+			if err := resetTeamOptions(ctx, client, "red", plan, state, &diags); err != nil {
+				t.Fatal(err)
+			}
+			if err := applyTeamOptions(ctx, client, "red", plan, &diags); err != nil {
+				t.Fatal(err)
+			}
+			if got := server.sent(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sent %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// nullTeamData returns team data with every option unset.
+func nullTeamData() teamResourceData {
+	null := types.String{Null: true}
+	return teamResourceData{
+		Color:                 null,
+		Prefix:                null,
+		Suffix:                null,
+		FriendlyFire:          types.Bool{Null: true},
+		SeeFriendlyInvisibles: types.Bool{Null: true},
+		NametagVisibility:     null,
+		CollisionRule:         null,
+	}
+}
+
+func TestTeamReadDropsRemovedTeam(t *testing.T) {
+	for _, tt := range []struct {
+		reply string
+		gone  bool
+	}{
+		{reply: "There are no members on team [red]"},
+		{reply: "Team [red] has 1 members: Steve"},
+		{reply: "Unknown team 'red'", gone: true},
+	} {
+		listReply := ""
+		server := newFakeServer(t, func(command string) string {
+			if strings.HasPrefix(command, "team list ") {
+				return listReply
+			}
+			return ""
+		})
+		p := configureProvider(t, server.address, nil)
+		state, diags := createResource(t, p, teamResourceType{}, map[string]tftypes.Value{
+			"name":  tftypes.NewValue(tftypes.String, "red"),
+			"color": tftypes.NewValue(tftypes.String, "red"),
+		})
+		if diags.HasError() {
+			t.Fatalf("Create: %v", diags)
+		}
+
+		listReply = tt.reply
+		got, diags := readResource(t, p, teamResourceType{}, state)
+		if diags.HasError() {
+			t.Fatalf("%q: Read: %v", tt.reply, diags)
+		}
+		if gone := got.Raw.IsNull(); gone != tt.gone {
+			t.Errorf("%q: removed from state = %t, want %t", tt.reply, gone, tt.gone)
+		}
+		if !tt.gone && stateString(t, got, "color") != "red" {
+			t.Errorf("%q: color = %q, want the applied value kept", tt.reply, stateString(t, got, "color"))
+		}
+	}
+}
+
+func TestTeamNameValidator(t *testing.T) {
+	v := teamName()
+	for _, name := range []string{"red", "Team_1", "a.b+c-d"} {
+		if resp := validateString(v, types.String{Value: name}); resp.Diagnostics.HasError() {
+			t.Errorf("%q rejected: %v", name, resp.Diagnostics)
+		}
+	}
+	for _, name := range []string{"", "red team", "red@", `"red"`} {
+		if resp := validateString(v, types.String{Value: name}); !resp.Diagnostics.HasError() {
+			t.Errorf("%q accepted", name)
+		}
+	}
+}
+
+// summonTagsPattern picks the Tags list out of a summon command's NBT.
+var summonTagsPattern = regexp.MustCompile(`^summon .*Tags:\[([^\]]*)\]`)
+
+func TestSummonJoinsTeam(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name  string
+		rt    tfsdk.ResourceType
+		attrs func(schema tfsdk.Schema) map[string]tftypes.Value
+	}{
+		{
+			name: "entity",
+			rt:   entityResourceType{},
+			attrs: func(schema tfsdk.Schema) map[string]tftypes.Value {
+				return map[string]tftypes.Value{
+					"type":     tftypes.NewValue(tftypes.String, "minecraft:pig"),
+					"position": xyzValue(ctx, schema, "position", 0, 64, 0),
+				}
+			},
+		},
+		{
+			name: "zombie",
+			rt:   zombieResourceType{},
+			attrs: func(schema tfsdk.Schema) map[string]tftypes.Value {
+				return map[string]tftypes.Value{"position": xyzValue(ctx, schema, "position", 0.5, 64, 0.5)}
+			},
+		},
+		{
+			name: "sheep",
+			rt:   sheepResourceType{},
+			attrs: func(schema tfsdk.Schema) map[string]tftypes.Value {
+				return map[string]tftypes.Value{
+					"position": xyzValue(ctx, schema, "position", 0, 64, 0),
+					"color":    tftypes.NewValue(tftypes.String, "white"),
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The server only lets entities join when an earlier summon
+			// gave them the tag the join selects.
+			var mu sync.Mutex
+			var tags []string
+			schema, _ := tt.rt.GetSchema(ctx)
+			server := newFakeServer(t, func(command string) string {
+				mu.Lock()
+				defer mu.Unlock()
+				if m := summonTagsPattern.FindStringSubmatch(command); m != nil {
+					tags = append(tags, m[1])
+				}
+				if strings.HasPrefix(command, "team join red @e[tag=") {
+					tag := strings.TrimSuffix(strings.TrimPrefix(command, "team join red @e[tag="), "]")
+					for _, list := range tags {
+						if strings.Contains(list, fmt.Sprintf("%q", tag)) {
+							return "Added 1 entity to team red"
+						}
+					}
+					return "No entity was found"
+				}
+				return ""
+			})
+			p := configureProvider(t, server.address, nil)
+			attrs := tt.attrs(schema)
+			attrs["team"] = tftypes.NewValue(tftypes.String, "red")
+			state, diags := createResource(t, p, tt.rt, attrs)
+			if diags.HasError() {
+				t.Fatalf("Create: %v", diags)
+			}
+
+			sent := server.sent()
+			want := "team join red @e[tag=" + stateString(t, state, "id") + "]"
+			if len(sent) < 2 || !strings.HasPrefix(sent[0], "summon ") || sent[len(sent)-1] != want {
+				t.Errorf("sent %q, want the summon followed by %q", sent, want)
+			}
+		})
+	}
+}
+
+func TestTeamOptionValidators(t *testing.T) {
+	tests := []struct {
+		name            string
+		v               tfsdk.AttributeValidator
+		valid, rejected []string
+	}{
+		{
+			name:     "nametag_visibility",
+			v:        teamNametagVisibility(),
+			valid:    []string{"always", "never", "hideForOtherTeams", "hideForOwnTeam"},
+			rejected: []string{"hideforownteam", "hidden", "Always", ""},
+		},
+		{
+			name:     "collision_rule",
+			v:        teamCollisionRule(),
+			valid:    []string{"always", "never", "pushOtherTeams", "pushOwnTeam"},
+			rejected: []string{"pushotherteams", "push", "NEVER"},
+		},
+	}
+	for _, tt := range tests {
+		for _, value := range tt.valid {
+			if resp := validateString(tt.v, types.String{Value: value}); resp.Diagnostics.HasError() {
+				t.Errorf("%s: %q rejected: %v", tt.name, value, resp.Diagnostics)
+			}
+		}
+		for _, value := range tt.rejected {
+			resp := validateString(tt.v, types.String{Value: value})
+			if !resp.Diagnostics.HasError() {
+				t.Errorf("%s: %q accepted", tt.name, value)
+				continue
+			}
+			want := "value must be one of: " + strings.Join(tt.valid, ", ")
+			if detail := resp.Diagnostics[0].Detail(); !strings.Contains(detail, want) {
+				t.Errorf("%s: %q error %q, want it to list %q", tt.name, value, detail, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure framework interfaces
+var _ tfsdk.ResourceType = teamRosterResourceType{}
+var _ tfsdk.Resource = teamRosterResource{}
+var _ tfsdk.ResourceWithImportState = teamRosterResource{}
+
+// ----- Resource Type -----
+
+type teamRosterResourceType struct{}
+
+func (t teamRosterResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Manages the full player list of a Minecraft team. Players are joined and removed in batches; anyone else found on the team is reported as drift.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Same as `team`.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"team": {
+				Type:                types.StringType,
+				Required:            true,
+				MarkdownDescription: "Team whose roster is managed.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"players": {
+				Type:                types.ListType{ElemType: types.StringType},
+				Required:            true,
+				MarkdownDescription: "Player usernames on the team. Each player may appear only once.",
+			},
+		},
+	}, nil
+}
+
+func (t teamRosterResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return teamRosterResource{provider: p}, diags
+}
+
+// ----- Data Model -----
+
+type teamRosterData struct {
+	ID      types.String `tfsdk:"id"`
+	Team    types.String `tfsdk:"team"`
+	Players []string     `tfsdk:"players"`
+}
+
+type teamRosterResource struct {
+	provider provider
+}
+
+// ----- CRUD -----
+
+func (r teamRosterResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan teamRosterData
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateRoster(plan.Players); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	team := strings.TrimSpace(plan.Team.Value)
+	if err := client.JoinTeamPlayers(ctx, team, plan.Players...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add players to team %q: %s", team, err))
+		return
+	}
+
+	plan.ID = types.String{Value: team}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r teamRosterResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state teamRosterData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	team := strings.TrimSpace(state.Team.Value)
+	members, err := client.ListTeamMembers(ctx, team)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list members of team %q: %s", team, err))
+		return
+	}
+
+	state.Players = reconcileRoster(state.Players, members)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r teamRosterResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state teamRosterData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateRoster(plan.Players); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	team := strings.TrimSpace(plan.Team.Value)
+	added, removed := diffRoster(state.Players, plan.Players)
+	if err := client.LeaveTeamPlayers(ctx, removed...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove players from team %q: %s", team, err))
+		return
+	}
+	if err := client.JoinTeamPlayers(ctx, team, added...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add players to team %q: %s", team, err))
+		return
+	}
+
+	plan.ID = types.String{Value: team}
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r teamRosterResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state teamRosterData
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.LeaveTeamPlayers(ctx, state.Players...); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove players from team %q: %s", state.Team.Value, err))
+	}
+}
+
+func (r teamRosterResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// Import by team name; Read fills in the current members.
+	team := strings.TrimSpace(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), team)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("team"), team)...)
+}
+
+// ----- Helpers -----
+
+// validateRoster rejects empty and duplicate names. Minecraft usernames are
+// case-insensitive, so "Steve" and "steve" count as the same player.
+func validateRoster(players []string) error {
+	seen := map[string]bool{}
+	for _, p := range players {
+		key := strings.ToLower(strings.TrimSpace(p))
+		if key == "" {
+			return fmt.Errorf("players must not contain empty names")
+		}
+		if seen[key] {
+			return fmt.Errorf("player %q is listed more than once", p)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// diffRoster returns the players to join (in want but not have) and to remove
+// (in have but not want), each in list order.
+func diffRoster(have, want []string) (added, removed []string) {
+	inHave := map[string]bool{}
+	for _, p := range have {
+		inHave[strings.ToLower(p)] = true
+	}
+	inWant := map[string]bool{}
+	for _, p := range want {
+		inWant[strings.ToLower(p)] = true
+		if !inHave[strings.ToLower(p)] {
+			added = append(added, p)
+		}
+	}
+	for _, p := range have {
+		if !inWant[strings.ToLower(p)] {
+			removed = append(removed, p)
+		}
+	}
+	return added, removed
+}
+
+// reconcileRoster keeps the known order for players still on the team and
+// appends anyone else found there, sorted, so drift shows up as a plain diff.
+func reconcileRoster(known, members []string) []string {
+	onTeam := map[string]bool{}
+	for _, m := range members {
+		onTeam[strings.ToLower(m)] = true
+	}
+
+	players := []string{}
+	seen := map[string]bool{}
+	for _, p := range known {
+		if onTeam[strings.ToLower(p)] {
+			players = append(players, p)
+			seen[strings.ToLower(p)] = true
+		}
+	}
+
+	var extra []string
+	for _, m := range members {
+		if !seen[strings.ToLower(m)] {
+			extra = append(extra, m)
+		}
+	}
+	sort.Strings(extra)
+	return append(players, extra...)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffRoster(t *testing.T) {
+	tests := []struct {
+		name           string
+		have, want     []string
+		added, removed []string
+	}{
+		{name: "unchanged", have: []string{"Steve", "Alex"}, want: []string{"Alex", "Steve"}},
+		{name: "added", have: []string{"Steve"}, want: []string{"Steve", "Alex", "Notch"}, added: []string{"Alex", "Notch"}},
+		{name: "removed", have: []string{"Steve", "Alex"}, want: []string{"Alex"}, removed: []string{"Steve"}},
+		{name: "swapped", have: []string{"Steve", "Alex"}, want: []string{"Alex", "Notch"}, added: []string{"Notch"}, removed: []string{"Steve"}},
+		{name: "case only", have: []string{"steve"}, want: []string{"Steve"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := diffRoster(tt.have, tt.want)
+			if !reflect.DeepEqual(added, tt.added) || !reflect.DeepEqual(removed, tt.removed) {
+				t.Errorf("diffRoster(%q, %q) = %q, %q; want %q, %q", tt.have, tt.want, added, removed, tt.added, tt.removed)
+			}
+		})
+	}
+}
+
+func TestValidateRoster(t *testing.T) {
+	if err := validateRoster([]string{"Steve", "Alex"}); err != nil {
+		t.Error(err)
+	}
+	if err := validateRoster([]string{"Steve", "steve"}); err == nil {
+		t.Error("duplicate players differing only in case were accepted")
+	}
+	if err := validateRoster([]string{" "}); err == nil {
+		t.Error("an empty name was accepted")
+	}
+}
+
+func TestReconcileRoster(t *testing.T) {
+	got := reconcileRoster([]string{"Steve", "Alex"}, []string{"Zed", "alex", "Bob"})
+	if want := []string{"Alex", "Bob", "Zed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("reconcileRoster = %q, want %q", got, want)
+	}
+}
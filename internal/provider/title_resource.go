@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = titleResourceType{}
+var _ tfsdk.Resource = titleResource{}
+
+// ---------- Resource Type ----------
+
+type titleResourceType struct{}
+
+func (t titleResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Trigger that shows a title, subtitle or actionbar message to players when created, e.g. to announce a round. Change `triggers` to show it again. Destroying it clears the title from the screen (`title <target> clear`) and, if `fade_in`, `stay` or `fade_out` were set, resets the fade times.",
+		Attributes: map[string]tfsdk.Attribute{
+			"target": {
+				MarkdownDescription: "Player name or selector to show the text to, e.g. `@a`.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"type": {
+				MarkdownDescription: "Where the text appears: `title`, `subtitle` (shown under the next title) or `actionbar` (above the hotbar). Defaults to `title`.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.StringType,
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOfExact("title", "subtitle", "actionbar"),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"text": {
+				MarkdownDescription: "Text to show.",
+				Required:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"fade_in": {
+				MarkdownDescription: "Ticks the title takes to fade in. Defaults to the player's current setting (10 on a fresh server).",
+				Optional:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"stay": {
+				MarkdownDescription: "Ticks the title stays on screen. Defaults to the player's current setting (70 on a fresh server).",
+				Optional:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"fade_out": {
+				MarkdownDescription: "Ticks the title takes to fade out. Defaults to the player's current setting (20 on a fresh server).",
+				Optional:            true,
+				Type:                types.Int64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, show the text again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this title.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t titleResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return titleResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type titleResourceData struct {
+	Id       types.String `tfsdk:"id"`
+	Target   string       `tfsdk:"target"`
+	Type     types.String `tfsdk:"type"`
+	Text     string       `tfsdk:"text"`
+	FadeIn   types.Int64  `tfsdk:"fade_in"`
+	Stay     types.Int64  `tfsdk:"stay"`
+	FadeOut  types.Int64  `tfsdk:"fade_out"`
+	Triggers types.Map    `tfsdk:"triggers"`
+}
+
+func (d *titleResourceData) applyDefaults() {
+	if d.Type.Null || d.Type.Unknown {
+		d.Type = types.String{Value: "title"}
+	}
+}
+
+// hasTimes reports whether any fade time is set.
+func (d titleResourceData) hasTimes() bool {
+	return !d.FadeIn.Null || !d.Stay.Null || !d.FadeOut.Null
+}
+
+// times returns the fade times, filling unset ones with the vanilla defaults.
+func (d titleResourceData) times() (fadeIn, stay, fadeOut int, err error) {
+	pick := func(name string, v types.Int64, def int) (int, error) {
+		if v.Null {
+			return def, nil
+		}
+		if v.Value < 0 {
+			return 0, fmt.Errorf("%s must not be negative (got %d)", name, v.Value)
+		}
+		return int(v.Value), nil
+	}
+	if fadeIn, err = pick("fade_in", d.FadeIn, 10); err != nil {
+		return
+	}
+	if stay, err = pick("stay", d.Stay, 70); err != nil {
+		return
+	}
+	fadeOut, err = pick("fade_out", d.FadeOut, 20)
+	return
+}
+
+func (d titleResourceData) validate() error {
+	if !executeTargetPattern.MatchString(strings.TrimSpace(d.Target)) {
+		return fmt.Errorf("target must be a player name or target selector such as @a (got %q)", d.Target)
+	}
+	_, _, _, err := d.times()
+	return err
+}
+
+// ---------- Resource Impl ----------
+
+type titleResource struct {
+	provider provider
+}
+
+func (r titleResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data titleResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.applyDefaults()
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	target := strings.TrimSpace(data.Target)
+	if data.hasTimes() {
+		fadeIn, stay, fadeOut, _ := data.times()
+		if err := client.SetTitleTimes(ctx, target, fadeIn, stay, fadeOut); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set title times: %s", err))
+			return
+		}
+	}
+	if err := client.ShowTitle(ctx, target, data.Type.Value, data.Text); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to show %s: %s", data.Type.Value, err))
+		return
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r titleResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Titles fade on their own; keep state as-is.
+	var data titleResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r titleResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data titleResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.applyDefaults()
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r titleResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data titleResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	target := strings.TrimSpace(data.Target)
+	if err := client.ClearTitle(ctx, target); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear title: %s", err))
+		return
+	}
+	if data.hasTimes() {
+		if err := client.ResetTitleTimes(ctx, target); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset title times: %s", err))
+			return
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTitleCreateAndDestroy(t *testing.T) {
+	tests := []struct {
+		name                   string
+		attrs                  map[string]tftypes.Value
+		wantCreate, wantDelete []string
+	}{
+		{
+			name: "actionbar",
+			attrs: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "actionbar"),
+			},
+			wantCreate: []string{`title @a actionbar {"text":"Welcome"}`},
+			wantDelete: []string{"title @a clear"},
+		},
+		{
+			name: "with times",
+			attrs: map[string]tftypes.Value{
+				"stay": tftypes.NewValue(tftypes.Number, 100),
+			},
+			wantCreate: []string{"title @a times 10 100 20", `title @a title {"text":"Welcome"}`},
+			wantDelete: []string{"title @a clear", "title @a reset"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeServer(t, nil)
+			p := configureProvider(t, server.address, nil)
+			tt.attrs["target"] = tftypes.NewValue(tftypes.String, "@a")
+			tt.attrs["text"] = tftypes.NewValue(tftypes.String, "Welcome")
+			state, diags := createResource(t, p, titleResourceType{}, tt.attrs)
+			if diags.HasError() {
+				t.Fatalf("Create: %v", diags)
+			}
+			if got := server.sent(); !reflect.DeepEqual(got, tt.wantCreate) {
+				t.Errorf("create sent %q, want %q", got, tt.wantCreate)
+			}
+			if diags := deleteResource(t, p, titleResourceType{}, state); diags.HasError() {
+				t.Fatalf("Delete: %v", diags)
+			}
+			if got := server.sent()[len(tt.wantCreate):]; !reflect.DeepEqual(got, tt.wantDelete) {
+				t.Errorf("delete sent %q, want %q", got, tt.wantDelete)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = tpsDataSourceType{}
+var _ tfsdk.DataSource = tpsDataSource{}
+
+type tpsDataSourceType struct{}
+
+func (t tpsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Reads the server's ticks per second from the Paper/Spigot `tps` command, e.g. to hold back heavy builds while the server is struggling. 20 is a healthy server. Vanilla has no `tps` command: there `supported` is false, the figures are null and a warning is shown.",
+		Attributes: map[string]tfsdk.Attribute{
+			"supported": {
+				Computed:            true,
+				MarkdownDescription: "Whether the server answered `tps`.",
+				Type:                types.BoolType,
+			},
+			"tps_1m": {
+				Computed:            true,
+				MarkdownDescription: "Average ticks per second over the last minute.",
+				Type:                types.Float64Type,
+			},
+			"tps_5m": {
+				Computed:            true,
+				MarkdownDescription: "Average ticks per second over the last 5 minutes.",
+				Type:                types.Float64Type,
+			},
+			"tps_15m": {
+				Computed:            true,
+				MarkdownDescription: "Average ticks per second over the last 15 minutes.",
+				Type:                types.Float64Type,
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Always `tps`.",
+				Type:                types.StringType,
+			},
+		},
+	}, nil
+}
+
+func (t tpsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+	return tpsDataSource{provider: provider}, diags
+}
+
+type tpsDataSourceData struct {
+	Id        types.String  `tfsdk:"id"`
+	Supported types.Bool    `tfsdk:"supported"`
+	Tps1m     types.Float64 `tfsdk:"tps_1m"`
+	Tps5m     types.Float64 `tfsdk:"tps_5m"`
+	Tps15m    types.Float64 `tfsdk:"tps_15m"`
+}
+
+type tpsDataSource struct {
+	provider provider
+}
+
+func (d tpsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	client, err := d.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	data := tpsDataSourceData{
+		Id:        types.String{Value: "tps"},
+		Supported: types.Bool{Value: false},
+		Tps1m:     types.Float64{Null: true},
+		Tps5m:     types.Float64{Null: true},
+		Tps15m:    types.Float64{Null: true},
+	}
+
+	tps1, tps5, tps15, err := client.GetTPS(ctx)
+	switch {
+	case errors.Is(err, minecraft.ErrUnsupported):
+		resp.Diagnostics.AddWarning("TPS Unavailable", "The server has no `tps` command (it is provided by Paper and Spigot), so the figures are null.")
+	case err != nil:
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read TPS: %s", err))
+		return
+	default:
+		data.Supported = types.Bool{Value: true}
+		data.Tps1m = types.Float64{Value: tps1}
+		data.Tps5m = types.Float64{Value: tps5}
+		data.Tps15m = types.Float64{Value: tps15}
+	}
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
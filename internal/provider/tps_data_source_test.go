@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func readTPS(t *testing.T, p *provider) (tpsDataSourceData, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	schema, _ := tpsDataSourceType{}.GetSchema(ctx)
+	ds, diags := tpsDataSourceType{}.NewDataSource(ctx, p)
+	if diags.HasError() {
+		t.Fatalf("NewDataSource: %v", diags)
+	}
+	config := objectValue(ctx, schema, nil)
+	resp := tfsdk.ReadDataSourceResponse{State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.TerraformType(ctx), nil)}}
+	ds.Read(ctx, tfsdk.ReadDataSourceRequest{Config: tfsdk.Config{Schema: schema, Raw: config}}, &resp)
+
+	var data tpsDataSourceData
+	if !resp.State.Raw.IsNull() {
+		if diags := resp.State.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("reading state: %v", diags)
+		}
+	}
+	return data, resp.Diagnostics
+}
+
+func TestTPSUnsupportedWarns(t *testing.T) {
+	server := newFakeServer(t, func(string) string { return "Unknown or incomplete command, see below for error" })
+	data, diags := readTPS(t, configureProvider(t, server.address, nil))
+	if diags.HasError() || len(diags) != 1 {
+		t.Fatalf("diags = %v, want one warning", diags)
+	}
+	if data.Supported.Value || !data.Tps1m.Null || !data.Tps5m.Null || !data.Tps15m.Null {
+		t.Errorf("data = %+v, want unsupported with null figures", data)
+	}
+}
+
+func TestTPSSupported(t *testing.T) {
+	server := newFakeServer(t, func(string) string { return "§6TPS from last 1m, 5m, 15m: §a19.5, §a*20.0, §a20.0" })
+	data, diags := readTPS(t, configureProvider(t, server.address, nil))
+	if diags.HasError() || len(diags) != 0 {
+		t.Fatalf("diags = %v", diags)
+	}
+	if !data.Supported.Value || data.Tps1m.Value != 19.5 || data.Tps5m.Value != 20 || data.Tps15m.Value != 20 {
+		t.Errorf("data = %+v", data)
+	}
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure types satisfy framework interfaces
+var _ tfsdk.ResourceType = triggerObjectiveResourceType{}
+var _ tfsdk.Resource = triggerObjectiveResource{}
+var _ tfsdk.ResourceWithImportState = triggerObjectiveResource{}
+
+// -------- Resource Type --------
+
+type triggerObjectiveResourceType struct{}
+
+func (t triggerObjectiveResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A scoreboard objective with the `trigger` criterion, enabled for the given targets so players can run `/trigger <name>` (e.g. from clickable chat menus).",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Resource ID (same as `name`).",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				Type:                types.StringType,
+				Required:            true,
+				MarkdownDescription: "Objective name.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"display_name": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Display name shown in UI (defaults to `name`). Changed in place.",
+			},
+			"targets": {
+				Type:                types.ListType{ElemType: types.StringType},
+				Required:            true,
+				MarkdownDescription: "Players or selectors allowed to use the trigger (e.g. `[\"@a\"]`).",
+			},
+			"values": {
+				Type:                types.MapType{ElemType: types.Int64Type},
+				Computed:            true,
+				MarkdownDescription: "Current score per named target. Selectors and players without a score are omitted.",
+			},
+		},
+	}, nil
+}
+
+func (t triggerObjectiveResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return triggerObjectiveResource{provider: p}, diags
+}
+
+// -------- Data & Resource --------
+
+type triggerObjectiveResourceData struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Targets     []string     `tfsdk:"targets"`
+	Values      types.Map    `tfsdk:"values"`
+}
+
+type triggerObjectiveResource struct {
+	provider provider
+}
+
+// Minimal client surface we need
+type triggerObjectiveClient interface {
+	EnableTrigger(ctx context.Context, target, objective string) error
+	GetScore(ctx context.Context, target, objective string) (int, error)
+}
+
+// -------- CRUD --------
+
+func (r triggerObjectiveResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan triggerObjectiveResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	name := strings.TrimSpace(plan.Name.Value)
+	if err := client.CreateObjective(ctx, name, "trigger", plan.DisplayName.Value); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create objective %q: %s", name, err))
+		return
+	}
+
+	if err := enableTriggers(ctx, client, name, plan.Targets); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	plan.ID = types.String{Value: name}
+	plan.Values = readTriggerValues(ctx, client, name, plan.Targets)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r triggerObjectiveResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var state triggerObjectiveResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	state.Values = readTriggerValues(ctx, client, strings.TrimSpace(state.Name.Value), state.Targets)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r triggerObjectiveResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// `display_name` and `targets` change in place: rename the objective if
+	// needed, then (re-)enable the trigger for every listed target.
+	var plan, state triggerObjectiveResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	name := strings.TrimSpace(plan.Name.Value)
+	if !plan.DisplayName.Equal(state.DisplayName) {
+		display := plan.DisplayName.Value
+		if plan.DisplayName.Null {
+			display = name
+		}
+		if err := client.ModifyObjectiveDisplayName(ctx, name, display); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change display name of objective %q: %s", name, err))
+			return
+		}
+	}
+
+	if err := enableTriggers(ctx, client, name, plan.Targets); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	plan.Values = readTriggerValues(ctx, client, name, plan.Targets)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r triggerObjectiveResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state triggerObjectiveResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	name := strings.TrimSpace(state.Name.Value)
+	if err := client.RemoveObjective(ctx, name); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove objective %q: %s", name, err))
+		return
+	}
+}
+
+func (r triggerObjectiveResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	// Import by objective name; config supplies targets.
+	name := strings.TrimSpace(req.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("id"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("name"), name)...)
+}
+
+// -------- Helpers --------
+
+func enableTriggers(ctx context.Context, c triggerObjectiveClient, objective string, targets []string) error {
+	for _, t := range targets {
+		if err := c.EnableTrigger(ctx, strings.TrimSpace(t), objective); err != nil {
+			return fmt.Errorf("unable to enable trigger %q for %q: %s", objective, t, err)
+		}
+	}
+	return nil
+}
+
+// readTriggerValues collects scores for named targets; selectors can't be read as one value.
+func readTriggerValues(ctx context.Context, c triggerObjectiveClient, objective string, targets []string) types.Map {
+	values := types.Map{ElemType: types.Int64Type, Elems: map[string]attr.Value{}}
+	for _, t := range targets {
+		t = strings.TrimSpace(t)
+		if strings.HasPrefix(t, "@") {
+			continue
+		}
+		if n, err := c.GetScore(ctx, t, objective); err == nil {
+			values.Elems[t] = types.Int64{Value: int64(n)}
+		}
+	}
+	return values
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTriggerObjectiveCreateEnablesTargets(t *testing.T) {
+	server := newFakeServer(t, func(command string) string {
+		if command == "scoreboard players get Steve menu" {
+			return "Steve has 2 [menu]"
+		}
+		return ""
+	})
+	p := configureProvider(t, server.address, nil)
+
+	state, diags := createResource(t, p, triggerObjectiveResourceType{}, map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "menu"),
+		"display_name": tftypes.NewValue(tftypes.String, "Menu"),
+		"targets": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "@a[team=red]"),
+			tftypes.NewValue(tftypes.String, "Steve"),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+
+	want := []string{
+		`scoreboard objectives add menu trigger {"text":"Menu"}`,
+		"scoreboard players enable @a[team=red] menu",
+		"scoreboard players enable Steve menu",
+		"scoreboard players get Steve menu",
+	}
+	if got := server.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %q\nwant %q", got, want)
+	}
+	if got := stateString(t, state, "id"); got != "menu" {
+		t.Errorf("id = %q, want menu", got)
+	}
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = worldNormalizeResourceType{}
+var _ tfsdk.Resource = worldNormalizeResource{}
+
+// ---------- Resource Type ----------
+
+type worldNormalizeResourceType struct{}
+
+func (t worldNormalizeResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "One-shot trigger that puts the world into a known state when created: time, then weather, then difficulty. Nothing is reverted on destroy. Change `triggers` to run again, e.g. `triggers = { run = timestamp() }` to normalize on every apply. Nothing is sent during `terraform plan`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"set_time": {
+				MarkdownDescription: "Time to set: `day`, `noon`, `night`, `midnight` or a tick count.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"set_weather": {
+				MarkdownDescription: "Weather to set: `clear`, `rain` or `thunder`.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"set_difficulty": {
+				MarkdownDescription: "Difficulty to set: `peaceful`, `easy`, `normal` or `hard`.",
+				Optional:            true,
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"triggers": {
+				MarkdownDescription: "Arbitrary map of values that, when changed, normalize the world again.",
+				Optional:            true,
+				Type:                types.MapType{ElemType: types.StringType},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"id": {
+				Computed:            true,
+				MarkdownDescription: "Random ID for this run.",
+				Type:                types.StringType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t worldNormalizeResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return worldNormalizeResource{provider: p}, diags
+}
+
+// ---------- Resource Data ----------
+
+type worldNormalizeResourceData struct {
+	Id            types.String `tfsdk:"id"`
+	SetTime       types.String `tfsdk:"set_time"`
+	SetWeather    types.String `tfsdk:"set_weather"`
+	SetDifficulty types.String `tfsdk:"set_difficulty"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+}
+
+func (d worldNormalizeResourceData) validate() error {
+	if !d.SetTime.Null {
+		if _, err := strconv.Atoi(d.SetTime.Value); err != nil {
+			switch strings.ToLower(d.SetTime.Value) {
+			case "day", "noon", "night", "midnight":
+			default:
+				return fmt.Errorf("set_time must be day|noon|night|midnight or a tick count (got %q)", d.SetTime.Value)
+			}
+		}
+	}
+	if !d.SetWeather.Null {
+		switch strings.ToLower(d.SetWeather.Value) {
+		case "clear", "rain", "thunder":
+		default:
+			return fmt.Errorf("set_weather must be one of clear|rain|thunder (got %q)", d.SetWeather.Value)
+		}
+	}
+	if !d.SetDifficulty.Null {
+		switch strings.ToLower(d.SetDifficulty.Value) {
+		case "peaceful", "easy", "normal", "hard":
+		default:
+			return fmt.Errorf("set_difficulty must be one of peaceful|easy|normal|hard (got %q)", d.SetDifficulty.Value)
+		}
+	}
+	return nil
+}
+
+// ---------- Resource Impl ----------
+
+type worldNormalizeResource struct {
+	provider provider
+}
+
+func (r worldNormalizeResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data worldNormalizeResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if !data.SetTime.Null {
+		if err := client.SetTime(ctx, strings.ToLower(data.SetTime.Value)); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set time to %q: %s", data.SetTime.Value, err))
+			return
+		}
+	}
+	if !data.SetWeather.Null {
+		if err := client.SetWeather(ctx, strings.ToLower(data.SetWeather.Value)); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set weather to %q: %s", data.SetWeather.Value, err))
+			return
+		}
+	}
+	if !data.SetDifficulty.Null {
+		if err := client.SetDifficulty(ctx, strings.ToLower(data.SetDifficulty.Value)); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set difficulty to %q: %s", data.SetDifficulty.Value, err))
+			return
+		}
+	}
+
+	data.Id = types.String{Value: uuid.NewString()}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r worldNormalizeResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Time and weather move on by themselves; the run itself is what's tracked.
+	var data worldNormalizeResourceData
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r worldNormalizeResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data worldNormalizeResourceData
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, &data) // all fields ForceNew; nothing in-place
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r worldNormalizeResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Nothing to undo; the world keeps whatever state it has now.
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWorldNormalizeRunsOnlyOnCreate(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	// Configuring the provider, as plan does, must leave the world alone.
+	if n := server.connections(); n != 0 {
+		t.Fatalf("configure made %d connections, want none", n)
+	}
+
+	state, diags := createResource(t, p, worldNormalizeResourceType{}, map[string]tftypes.Value{
+		"set_time":       tftypes.NewValue(tftypes.String, "Noon"),
+		"set_weather":    tftypes.NewValue(tftypes.String, "clear"),
+		"set_difficulty": tftypes.NewValue(tftypes.String, "hard"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	want := []string{"time set noon", "weather clear", "difficulty hard"}
+	if got := server.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("create sent %q, want %q", got, want)
+	}
+
+	if diags := deleteResource(t, p, worldNormalizeResourceType{}, state); diags.HasError() {
+		t.Fatalf("Delete: %v", diags)
+	}
+	if got := server.sent(); !reflect.DeepEqual(got, want) {
+		t.Errorf("after delete the server got %q, want only the create's %q", got, want)
+	}
+}
+
+func TestWorldNormalizeRejectsBadValues(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	for name, value := range map[string]string{"set_time": "dusk", "set_weather": "snow", "set_difficulty": "brutal"} {
+		_, diags := createResource(t, p, worldNormalizeResourceType{}, map[string]tftypes.Value{
+			name: tftypes.NewValue(tftypes.String, value),
+		})
+		if !diags.HasError() {
+			t.Errorf("Create with %s = %q succeeded, want a validation error", name, value)
+		}
+	}
+	if got := server.sent(); len(got) != 0 {
+		t.Errorf("server got %q, want nothing", got)
+	}
+}
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure types satisfy framework interfaces
+var _ tfsdk.ResourceType = worldSettingsResourceType{}
+var _ tfsdk.Resource = worldSettingsResource{}
+
+// Values a setting is reverted to when it is removed from the config or the
+// resource is destroyed; these match a fresh vanilla server.
+const (
+	defaultWorldDifficulty = "easy"
+	defaultWorldGameMode   = "survival"
+	defaultWorldTime       = "day"
+	defaultWorldWeather    = "clear"
+)
+
+// -------- Resource Type --------
+
+type worldSettingsResourceType struct{}
+
+func (t worldSettingsResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Sets up a world's difficulty, default game mode, time and weather in one block. Each setting is optional and only configured ones send commands. A setting removed from the config, or every set one on destroy, is reverted to the vanilla default: `easy`, `survival`, `day` and `clear`.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Resource ID. Always `\"default\"` for these global server settings.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"difficulty": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "One of `peaceful`, `easy`, `normal`, `hard`.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("peaceful", "easy", "normal", "hard"),
+				},
+			},
+			"default_gamemode": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Game mode for new players: `survival`, `creative`, `adventure` or `spectator`.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("survival", "creative", "adventure", "spectator"),
+				},
+			},
+			"time": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "`day`, `noon`, `night`, `midnight` or a tick count. Set once when applied; the clock keeps running afterwards.",
+			},
+			"weather": {
+				Type:                types.StringType,
+				Optional:            true,
+				MarkdownDescription: "One of `clear`, `rain`, `thunder`. Set once when applied; the weather keeps cycling afterwards.",
+				Validators: []tfsdk.AttributeValidator{
+					stringOneOf("clear", "rain", "thunder"),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t worldSettingsResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return worldSettingsResource{provider: p}, diags
+}
+
+// -------- Data & Resource --------
+
+type worldSettingsResourceData struct {
+	ID              types.String `tfsdk:"id"`
+	Difficulty      types.String `tfsdk:"difficulty"`
+	DefaultGameMode types.String `tfsdk:"default_gamemode"`
+	Time            types.String `tfsdk:"time"`
+	Weather         types.String `tfsdk:"weather"`
+}
+
+type worldSettingsResource struct {
+	provider provider
+}
+
+func (d worldSettingsResourceData) validate() error {
+	if d.Time.Null {
+		return nil
+	}
+	if _, err := strconv.Atoi(d.Time.Value); err == nil {
+		return nil
+	}
+	switch strings.ToLower(d.Time.Value) {
+	case "day", "noon", "night", "midnight":
+		return nil
+	}
+	return fmt.Errorf("time must be day|noon|night|midnight or a tick count (got %q)", d.Time.Value)
+}
+
+// noWorldSettings is the value Create syncs from and Delete syncs to.
+func noWorldSettings() worldSettingsResourceData {
+	return worldSettingsResourceData{
+		Difficulty:      types.String{Null: true},
+		DefaultGameMode: types.String{Null: true},
+		Time:            types.String{Null: true},
+		Weather:         types.String{Null: true},
+	}
+}
+
+// settingChange reports the value to send when a setting goes from old to
+// new: the new value if it changed, or def if it was removed.
+func settingChange(old, new types.String, def string) (string, bool) {
+	switch {
+	case !new.Null && (old.Null || !strings.EqualFold(old.Value, new.Value)):
+		return strings.ToLower(new.Value), true
+	case new.Null && !old.Null:
+		return def, true
+	}
+	return "", false
+}
+
+// sync sends commands only for the settings that differ between from and to.
+func (r worldSettingsResource) sync(ctx context.Context, from, to worldSettingsResourceData, diags *diag.Diagnostics) {
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if v, ok := settingChange(from.Difficulty, to.Difficulty, defaultWorldDifficulty); ok {
+		if err := client.SetDifficulty(ctx, v); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set difficulty to %q: %s", v, err))
+			return
+		}
+	}
+	if v, ok := settingChange(from.DefaultGameMode, to.DefaultGameMode, defaultWorldGameMode); ok {
+		if err := client.SetDefaultGameMode(ctx, v); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set default game mode to %q: %s", v, err))
+			return
+		}
+	}
+	if v, ok := settingChange(from.Time, to.Time, defaultWorldTime); ok {
+		if err := client.SetTime(ctx, v); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set time to %q: %s", v, err))
+			return
+		}
+	}
+	if v, ok := settingChange(from.Weather, to.Weather, defaultWorldWeather); ok {
+		if err := client.SetWeather(ctx, v); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set weather to %q: %s", v, err))
+			return
+		}
+	}
+}
+
+// -------- CRUD --------
+
+func (r worldSettingsResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan worldSettingsResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := plan.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	r.sync(ctx, noWorldSettings(), plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.String{Value: "default"}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r worldSettingsResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// Time and weather move on by themselves, so only difficulty and the
+	// default game mode are refreshed.
+	var state worldSettingsResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.Difficulty.Null && state.DefaultGameMode.Null {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if !state.Difficulty.Null {
+		current, err := client.GetDifficulty(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read difficulty: %s", err))
+			return
+		}
+		if !strings.EqualFold(current, state.Difficulty.Value) {
+			state.Difficulty = types.String{Value: current}
+		}
+	}
+	if !state.DefaultGameMode.Null {
+		current, err := client.GetDefaultGameMode(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read default game mode: %s", err))
+			return
+		}
+		if !strings.EqualFold(current, state.DefaultGameMode.Value) {
+			state.DefaultGameMode = types.String{Value: current}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r worldSettingsResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan, state worldSettingsResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := plan.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	r.sync(ctx, state, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.String{Value: "default"}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r worldSettingsResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var state worldSettingsResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.sync(ctx, state, noWorldSettings(), &resp.Diagnostics)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWorldSettingsOnlyConfiguredSettingsSendCommands(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs map[string]tftypes.Value
+		want  []string
+	}{
+		{
+			name:  "difficulty only",
+			attrs: map[string]tftypes.Value{"difficulty": tftypes.NewValue(tftypes.String, "hard")},
+			want:  []string{"difficulty hard"},
+		},
+		{
+			name: "time and weather",
+			attrs: map[string]tftypes.Value{
+				"time":    tftypes.NewValue(tftypes.String, "noon"),
+				"weather": tftypes.NewValue(tftypes.String, "rain"),
+			},
+			want: []string{"time set noon", "weather rain"},
+		},
+		{name: "nothing set"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeServer(t, nil)
+			p := configureProvider(t, server.address, nil)
+
+			if _, diags := createResource(t, p, worldSettingsResourceType{}, tt.attrs); diags.HasError() {
+				t.Fatalf("Create: %v", diags)
+			}
+			if got := server.sent(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sent %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
+)
+
+// Ensure types satisfy framework interfaces
+var _ tfsdk.ResourceType = worldBorderResourceType{}
+var _ tfsdk.Resource = worldBorderResource{}
+
+// -------- Resource Type --------
+
+type worldBorderResourceType struct{}
+
+func (t worldBorderResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Sets the world border. The first size is applied at once; later size changes move the border over `resize_time` seconds, e.g. for a shrinking-border minigame. On destroy the border is reset to a fresh world's, centered on 0,0.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:                types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Resource ID. Always `\"default\"` for this global server setting.",
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"size": {
+				Type:                types.Float64Type,
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("Border diameter in blocks, up to %d.", minecraft.DefaultWorldBorderSize),
+			},
+			"center": {
+				Optional:            true,
+				MarkdownDescription: "Center of the border. Defaults to 0,0.",
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"x": {
+						MarkdownDescription: "X coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+					},
+					"z": {
+						MarkdownDescription: "Z coordinate",
+						Type:                types.Float64Type,
+						Required:            true,
+					},
+				}),
+			},
+			"resize_time": {
+				Type:                types.Int64Type,
+				Optional:            true,
+				MarkdownDescription: "Seconds a size change on update takes to reach the new size. Unset or `0` moves the border at once. Creating the resource always sets the size at once.",
+			},
+		},
+	}, nil
+}
+
+func (t worldBorderResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	p, diags := convertProviderType(in)
+	return worldBorderResource{provider: p}, diags
+}
+
+// -------- Data & Resource --------
+
+type worldBorderCenter struct {
+	X float64 `tfsdk:"x"`
+	Z float64 `tfsdk:"z"`
+}
+
+type worldBorderResourceData struct {
+	ID         types.String       `tfsdk:"id"`
+	Size       float64            `tfsdk:"size"`
+	Center     *worldBorderCenter `tfsdk:"center"`
+	ResizeTime types.Int64        `tfsdk:"resize_time"`
+}
+
+func (d worldBorderResourceData) validate() error {
+	if d.Size < 1 || d.Size > minecraft.DefaultWorldBorderSize {
+		return fmt.Errorf("size must be between 1 and %d (got %v)", minecraft.DefaultWorldBorderSize, d.Size)
+	}
+	if !d.ResizeTime.Null && d.ResizeTime.Value < 0 {
+		return fmt.Errorf("resize_time must not be negative (got %d)", d.ResizeTime.Value)
+	}
+	return nil
+}
+
+// resizeSeconds returns how long an update takes to move the border, or nil
+// to move it at once.
+func (d worldBorderResourceData) resizeSeconds() *int {
+	if d.ResizeTime.Null || d.ResizeTime.Value == 0 {
+		return nil
+	}
+	s := int(d.ResizeTime.Value)
+	return &s
+}
+
+type worldBorderResource struct {
+	provider provider
+}
+
+// apply sets the center, then the size over seconds (nil for at once).
+func (r worldBorderResource) apply(ctx context.Context, data worldBorderResourceData, seconds *int, diags *diag.Diagnostics) {
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	center := worldBorderCenter{}
+	if data.Center != nil {
+		center = *data.Center
+	}
+	if err := client.SetWorldBorderCenter(ctx, center.X, center.Z); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to set world border center: %s", err))
+		return
+	}
+	if err := client.SetWorldBorderSize(ctx, data.Size, seconds); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Failed to set world border size: %s", err))
+		return
+	}
+}
+
+// -------- CRUD --------
+
+func (r worldBorderResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var plan worldBorderResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := plan.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	r.apply(ctx, plan, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.String{Value: "default"}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r worldBorderResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	// The border may still be moving, so its current size says little; keep state as-is.
+	var state worldBorderResourceData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r worldBorderResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var plan worldBorderResourceData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := plan.validate(); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+
+	r.apply(ctx, plan, plan.resizeSeconds(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ID.Null || plan.ID.Unknown {
+		plan.ID = types.String{Value: "default"}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r worldBorderResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// On delete, best-effort to restore a fresh world's border.
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	if err := client.SetWorldBorderCenter(ctx, 0, 0); err != nil {
+		resp.Diagnostics.AddWarning("Delete Warning", fmt.Sprintf("Failed to reset world border center during destroy: %s", err))
+	}
+	if err := client.SetWorldBorderSize(ctx, minecraft.DefaultWorldBorderSize, nil); err != nil {
+		resp.Diagnostics.AddWarning("Delete Warning", fmt.Sprintf("Failed to reset world border size during destroy: %s", err))
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWorldBorderResizeTimeOnlyOnUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		resizeTime interface{}
+		want       string
+	}{
+		{name: "timed", resizeTime: int64(60), want: "worldborder set 100 60"},
+		{name: "zero", resizeTime: int64(0), want: "worldborder set 100"},
+		{name: "unset", resizeTime: nil, want: "worldborder set 100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newFakeServer(t, nil)
+			p := configureProvider(t, server.address, nil)
+			attrs := map[string]tftypes.Value{
+				"size":        tftypes.NewValue(tftypes.Number, 500),
+				"resize_time": tftypes.NewValue(tftypes.Number, tt.resizeTime),
+			}
+			state, diags := createResource(t, p, worldBorderResourceType{}, attrs)
+			if diags.HasError() {
+				t.Fatalf("Create: %v", diags)
+			}
+			want := []string{"worldborder center 0 0", "worldborder set 500"}
+			if got := server.sent(); !reflect.DeepEqual(got, want) {
+				t.Errorf("create sent %q, want %q", got, want)
+			}
+
+			attrs["size"] = tftypes.NewValue(tftypes.Number, 100)
+			if _, diags := updateResource(t, p, worldBorderResourceType{}, state, attrs); diags.HasError() {
+				t.Fatalf("Update: %v", diags)
+			}
+			want = []string{"worldborder center 0 0", tt.want}
+			if got := server.sent()[2:]; !reflect.DeepEqual(got, want) {
+				t.Errorf("update sent %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWorldBorderRejectsNegativeResizeTime(t *testing.T) {
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+	_, diags := createResource(t, p, worldBorderResourceType{}, map[string]tftypes.Value{
+		"size":        tftypes.NewValue(tftypes.Number, 100),
+		"resize_time": tftypes.NewValue(tftypes.Number, -1),
+	})
+	if !diags.HasError() {
+		t.Error("negative resize_time accepted")
+	}
+	if sent := server.sent(); len(sent) != 0 {
+		t.Errorf("sent %q before validating", sent)
+	}
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestXPOrbSummon(t *testing.T) {
+	ctx := context.Background()
+	schema, _ := xpOrbResourceType{}.GetSchema(ctx)
+	for _, tt := range []struct {
+		value   int64
+		want    []string
+		wantErr bool
+	}{
+		{value: 50, want: []string{"summon minecraft:experience_orb 3 65 -7 {Value:50s}"}},
+		{value: 40000, wantErr: true},
+	} {
+		server := newFakeServer(t, nil)
+		p := configureProvider(t, server.address, nil)
+		_, diags := createResource(t, p, xpOrbResourceType{}, map[string]tftypes.Value{
+			"position": xyzValue(ctx, schema, "position", 3, 65, -7),
+			"value":    tftypes.NewValue(tftypes.Number, tt.value),
+		})
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("value %d: diags = %v, wantErr %t", tt.value, diags, tt.wantErr)
+		}
+		if got := server.sent(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("value %d: sent %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicraft/terraform-provider-minecraft/internal/minecraft"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -25,12 +25,12 @@ func (t zombieResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 		MarkdownDescription: "Summon and manage a Minecraft zombie with baby/door-breaking/loot/persistence options.",
 		Attributes: map[string]tfsdk.Attribute{
 			"position": {
-				MarkdownDescription: "Where to summon the zombie.",
+				MarkdownDescription: "Where to summon the zombie. Use `.5` to center it on a block.",
 				Required:            true,
 				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
 					"x": {
 						MarkdownDescription: "X coordinate",
-						Type:                types.Int64Type,
+						Type:                types.Float64Type,
 						Required:            true,
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							tfsdk.RequiresReplace(),
@@ -38,7 +38,7 @@ func (t zombieResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 					},
 					"y": {
 						MarkdownDescription: "Y coordinate",
-						Type:                types.Int64Type,
+						Type:                types.Float64Type,
 						Required:            true,
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							tfsdk.RequiresReplace(),
@@ -46,7 +46,7 @@ func (t zombieResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 					},
 					"z": {
 						MarkdownDescription: "Z coordinate",
-						Type:                types.Int64Type,
+						Type:                types.Float64Type,
 						Required:            true,
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							tfsdk.RequiresReplace(),
@@ -91,7 +91,7 @@ func (t zombieResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 				},
 			},
 			"health": {
-				MarkdownDescription: "Zombie health (float). Defaults to `20.0` if not set.",
+				MarkdownDescription: "Zombie health (float), above 0 and at most `max_health`, or `20.0` without it. Defaults to `max_health` when that is set, otherwise `20.0`.",
 				Optional:            true,
 				Computed:            true,
 				Type:                types.Float64Type,
@@ -99,6 +99,37 @@ func (t zombieResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 					tfsdk.RequiresReplace(),
 				},
 			},
+			"no_gravity": {
+				MarkdownDescription: "If true, the zombie floats in place instead of falling. Defaults to `false` if not set.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"invulnerable": {
+				MarkdownDescription: "If true, the zombie can't be hurt by players, mobs or the environment; destroying the resource still removes it. Defaults to `false` if not set.",
+				Optional:            true,
+				Computed:            true,
+				Type:                types.BoolType,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"max_health": {
+				MarkdownDescription: "Base value of the zombie's max health attribute, applied right after summoning so `health` above 20 isn't clamped. Must be between `health` and 1024.",
+				Optional:            true,
+				Type:                types.Float64Type,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"name":                 mobNameAttribute("zombie"),
+			"silent":               silentAttribute("zombie"),
+			"team":                 summonTeamAttribute("zombie"),
+			"death_loot_table":     deathLootTableAttribute("zombie"),
+			"equipment_loot_table": equipmentLootTableAttribute("zombie"),
 			"id": {
 				Computed:            true,
 				MarkdownDescription: "Stable UUID used as the entity's CustomName/tag.",
@@ -121,9 +152,9 @@ func (t zombieResourceType) NewResource(ctx context.Context, in tfsdk.Provider)
 type zombieResourceData struct {
 	Id       types.String `tfsdk:"id"`
 	Position struct {
-		X int64 `tfsdk:"x"`
-		Y int64 `tfsdk:"y"`
-		Z int64 `tfsdk:"z"`
+		X float64 `tfsdk:"x"`
+		Y float64 `tfsdk:"y"`
+		Z float64 `tfsdk:"z"`
 	} `tfsdk:"position"`
 
 	IsBaby             types.Bool   `tfsdk:"is_baby"`
@@ -131,6 +162,80 @@ type zombieResourceData struct {
 	CanPickUpLoot      types.Bool   `tfsdk:"can_pick_up_loot"`
 	PersistenceRequired types.Bool  `tfsdk:"persistence_required"`
 	Health             types.Float64 `tfsdk:"health"`
+	MaxHealth          types.Float64 `tfsdk:"max_health"`
+	NoGravity          types.Bool   `tfsdk:"no_gravity"`
+	Invulnerable       types.Bool   `tfsdk:"invulnerable"`
+	Silent             types.Bool   `tfsdk:"silent"`
+	Name               types.String `tfsdk:"name"`
+	Team               types.String `tfsdk:"team"`
+	DeathLootTable     types.String `tfsdk:"death_loot_table"`
+	EquipmentLoot      map[string]string `tfsdk:"equipment_loot_table"`
+}
+
+// Upper bound of the max health attribute.
+const maxHealthLimit = 1024.0
+
+func validateMaxHealth(health, maxHealth float64) error {
+	if maxHealth <= 0 || maxHealth > maxHealthLimit {
+		return fmt.Errorf("max_health must be between 0 and %g (got %g)", maxHealthLimit, maxHealth)
+	}
+	if maxHealth < health {
+		return fmt.Errorf("max_health (%g) must be at least health (%g)", maxHealth, health)
+	}
+	return nil
+}
+
+// Default max health of the mobs with their own resource.
+const (
+	zombieMaxHealth = 20.0
+	sheepMaxHealth  = 8.0
+)
+
+// mobNameAttribute returns the schema of a mob's `name`.
+func mobNameAttribute(what string) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		MarkdownDescription: fmt.Sprintf("Display name always shown above the %s, e.g. for a boss with `max_health`. Tracking uses a tag, so this is free text (max %d characters). Defaults to no visible name.", what, maxEntityNameLength),
+		Optional:            true,
+		Type:                types.StringType,
+		PlanModifiers: tfsdk.AttributePlanModifiers{
+			tfsdk.RequiresReplace(),
+		},
+	}
+}
+
+// resolveMobHealth defaults health to full and checks it against max_health,
+// or against defaultMax when max_health is unset, as the summon would clamp it.
+func resolveMobHealth(health *types.Float64, maxHealth types.Float64, defaultMax float64) error {
+	hasMaxHealth := !maxHealth.Null && !maxHealth.Unknown
+	if health.Null || health.Unknown {
+		*health = types.Float64{Value: defaultMax}
+		if hasMaxHealth {
+			*health = types.Float64{Value: maxHealth.Value}
+		}
+	}
+	if health.Value <= 0 {
+		return fmt.Errorf("health must be above 0 (got %g)", health.Value)
+	}
+	if hasMaxHealth {
+		return validateMaxHealth(health.Value, maxHealth.Value)
+	}
+	if health.Value > defaultMax {
+		return fmt.Errorf("health above %g needs max_health (got %g)", defaultMax, health.Value)
+	}
+	return nil
+}
+
+// raiseMaxHealth sets the max health attribute of a freshly summoned mob. The
+// summon NBT clamps Health to the default max, so Health is re-applied after.
+func (p provider) raiseMaxHealth(ctx context.Context, client *minecraft.Client, entity, id string, health, maxHealth float64, diags *diag.Diagnostics) {
+	sel := minecraft.SelectorByTag(entity, id)
+	if err := client.SetAttributeBase(ctx, sel, p.maxHealthAttribute(), maxHealth); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set %s max health: %s", entity, err))
+		return
+	}
+	if err := client.SetEntityHealth(ctx, sel, health); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set %s health: %s", entity, err))
+	}
 }
 
 // ---------- Resource Impl ----------
@@ -147,12 +252,6 @@ func (r zombieResource) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 		return
 	}
 
-	client, err := r.provider.GetClient(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
-		return
-	}
-
 	// Default bools to false when null/unknown
 	if data.IsBaby.Null || data.IsBaby.Unknown {
 		data.IsBaby = types.Bool{Value: false}
@@ -166,30 +265,73 @@ func (r zombieResource) Create(ctx context.Context, req tfsdk.CreateResourceRequ
 	if data.PersistenceRequired.Null || data.PersistenceRequired.Unknown {
 		data.PersistenceRequired = types.Bool{Value: false}
 	}
+	if data.NoGravity.Null || data.NoGravity.Unknown {
+		data.NoGravity = types.Bool{Value: false}
+	}
+	if data.Invulnerable.Null || data.Invulnerable.Unknown {
+		data.Invulnerable = types.Bool{Value: false}
+	}
 
-	// Default health to full (20.0) when null/unknown
-	if data.Health.Null || data.Health.Unknown {
-		data.Health = types.Float64{Value: 20.0}
+	if err := validateEntityName(data.Name.Value); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	if err := validateEquipmentLootTables(data.EquipmentLoot); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
+	}
+	// Default health to full (max_health, or 20.0) when null/unknown
+	if err := resolveMobHealth(&data.Health, data.MaxHealth, zombieMaxHealth); err != nil {
+		resp.Diagnostics.AddError("Validation Error", err.Error())
+		return
 	}
 
-	id := uuid.NewString()
-	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+	client, err := r.provider.GetClient(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
+		return
+	}
+
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
+	id := r.provider.newEntityID("minecraft:zombie", pos)
 
 	// Use the specialized client method to include zombie-specific NBT
 	if err := client.CreateZombie(
 		ctx,
 		pos,
 		id,
+		data.Name.Value,
 		data.IsBaby.Value,
 		data.CanBreakDoors.Value,
 		data.CanPickUpLoot.Value,
 		data.PersistenceRequired.Value,
 		float32(data.Health.Value),
+		data.NoGravity.Value,
+		data.Invulnerable.Value,
+		data.Silent.Value,
+		data.DeathLootTable.Value,
 	); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to summon zombie: %s", err))
 		return
 	}
 
+	if !data.MaxHealth.Null && !data.MaxHealth.Unknown {
+		r.provider.raiseMaxHealth(ctx, client, "minecraft:zombie", id, data.Health.Value, data.MaxHealth.Value, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	equipFromLoot(ctx, client, "minecraft:zombie", id, data.EquipmentLoot, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	joinSummonedTeam(ctx, client, "minecraft:zombie", pos, id, data.Team, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	data.Id = types.String{Value: id}
 
 	diags = resp.State.Set(ctx, &data)
@@ -228,13 +370,17 @@ func (r zombieResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequ
 		return
 	}
 
+	if r.provider.keepWorldOnDelete(&resp.Diagnostics, fmt.Sprintf("minecraft:zombie %s", data.Id.Value)) {
+		return
+	}
+
 	client, err := r.provider.GetClient(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create client: %s", err))
 		return
 	}
 
-	pos := fmt.Sprintf("%d %d %d", data.Position.X, data.Position.Y, data.Position.Z)
+	pos := minecraft.FormatPosition(data.Position.X, data.Position.Y, data.Position.Z)
 	if err := client.DeleteEntity(ctx, "minecraft:zombie", pos, data.Id.Value); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete zombie: %s", err))
 		return
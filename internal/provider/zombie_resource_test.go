@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestZombieMaxHealthSetsAttributeAfterSummon(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := zombieResourceType{}.GetSchema(ctx)
+	state, diags := createResource(t, p, zombieResourceType{}, map[string]tftypes.Value{
+		"position":   xyzValue(ctx, schema, "position", 0.5, 64, 0.5),
+		"health":     tftypes.NewValue(tftypes.Number, 30),
+		"max_health": tftypes.NewValue(tftypes.Number, 40),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	id := stateString(t, state, "id")
+
+	sent := server.sent()
+	if len(sent) != 3 {
+		t.Fatalf("sent %q, want summon, attribute and health commands", sent)
+	}
+	if !strings.HasPrefix(sent[0], "summon zombie 0.5 64 0.5 ") || !strings.Contains(sent[0], fmt.Sprintf(`Tags:["%s"]`, id)) {
+		t.Errorf("sent[0] = %q, want a summon tagged with %s", sent[0], id)
+	}
+	sel := fmt.Sprintf("@e[type=minecraft:zombie,tag=%s,limit=1]", id)
+	if want := "attribute " + sel + " " + p.maxHealthAttribute() + " base set 40"; sent[1] != want {
+		t.Errorf("sent[1] = %q, want %q", sent[1], want)
+	}
+	if want := "data merge entity " + sel + " {Health:30f}"; sent[2] != want {
+		t.Errorf("sent[2] = %q, want %q", sent[2], want)
+	}
+}
+
+func TestNamedBossMob(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		rt     tfsdk.ResourceType
+		entity string
+		prefix string
+		extra  map[string]tftypes.Value
+	}{
+		{rt: zombieResourceType{}, entity: "minecraft:zombie", prefix: "summon zombie 0.5 64 0.5 "},
+		{rt: sheepResourceType{}, entity: "minecraft:sheep", prefix: "summon sheep 0.5 64 0.5 ", extra: map[string]tftypes.Value{
+			"color": tftypes.NewValue(tftypes.String, "black"),
+		}},
+	}
+	for _, tt := range tests {
+		server := newFakeServer(t, nil)
+		p := configureProvider(t, server.address, nil)
+
+		schema, _ := tt.rt.GetSchema(ctx)
+		values := map[string]tftypes.Value{
+			"position":   xyzValue(ctx, schema, "position", 0.5, 64, 0.5),
+			"name":       tftypes.NewValue(tftypes.String, "Grave Lord"),
+			"max_health": tftypes.NewValue(tftypes.Number, 200),
+		}
+		for k, v := range tt.extra {
+			values[k] = v
+		}
+		state, diags := createResource(t, p, tt.rt, values)
+		if diags.HasError() {
+			t.Fatalf("%s: Create: %v", tt.entity, diags)
+		}
+		id := stateString(t, state, "id")
+
+		sel := fmt.Sprintf("@e[type=%s,tag=%s,limit=1]", tt.entity, id)
+		sent := server.sent()
+		want := []string{
+			"attribute " + sel + " " + p.maxHealthAttribute() + " base set 200",
+			"data merge entity " + sel + " {Health:200f}",
+		}
+		if len(sent) != 3 || !reflect.DeepEqual(sent[1:], want) {
+			t.Fatalf("%s: sent %q, want a summon then %q", tt.entity, sent, want)
+		}
+		for _, part := range []string{
+			fmt.Sprintf(`{Tags:["%s"],CustomName:'{"text":"Grave Lord"}',CustomNameVisible:1b,`, id),
+			"Health:200.000000f",
+		} {
+			if !strings.HasPrefix(sent[0], tt.prefix) || !strings.Contains(sent[0], part) {
+				t.Errorf("%s: summon %q, want %q", tt.entity, sent[0], part)
+			}
+		}
+	}
+}
+
+func TestZombieValidation(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		values map[string]tftypes.Value
+	}{
+		{"long name", map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, strings.Repeat("x", maxEntityNameLength+1))}},
+		{"health without max_health", map[string]tftypes.Value{"health": tftypes.NewValue(tftypes.Number, 30)}},
+		{"zero health", map[string]tftypes.Value{"health": tftypes.NewValue(tftypes.Number, 0)}},
+		{"max_health too high", map[string]tftypes.Value{"max_health": tftypes.NewValue(tftypes.Number, 2048)}},
+		{"unknown equipment slot", map[string]tftypes.Value{"equipment_loot_table": lootTables(map[string]string{"container.0": "gear:chest"})}},
+		{"bad loot table", map[string]tftypes.Value{"equipment_loot_table": lootTables(map[string]string{"armor.head": "Gear Helmets"})}},
+	}
+	for _, tt := range tests {
+		server := newFakeServer(t, nil)
+		p := configureProvider(t, server.address, nil)
+		schema, _ := zombieResourceType{}.GetSchema(ctx)
+		tt.values["position"] = xyzValue(ctx, schema, "position", 0, 64, 0)
+		if _, diags := createResource(t, p, zombieResourceType{}, tt.values); !diags.HasError() {
+			t.Errorf("%s: Create succeeded", tt.name)
+		}
+		if sent := server.sent(); len(sent) != 0 {
+			t.Errorf("%s: sent %q before validating", tt.name, sent)
+		}
+	}
+}
+
+func lootTables(tables map[string]string) tftypes.Value {
+	elems := map[string]tftypes.Value{}
+	for slot, table := range tables {
+		elems[slot] = tftypes.NewValue(tftypes.String, table)
+	}
+	return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elems)
+}
+
+func TestZombieEquipmentFromLoot(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	p := configureProvider(t, server.address, nil)
+
+	schema, _ := zombieResourceType{}.GetSchema(ctx)
+	state, diags := createResource(t, p, zombieResourceType{}, map[string]tftypes.Value{
+		"position": xyzValue(ctx, schema, "position", 0.5, 64, 0.5),
+		"equipment_loot_table": lootTables(map[string]string{
+			"weapon.mainhand": "gear:swords",
+			"armor.feet":      "gear:boots",
+			"armor.head":      "gear:helmets",
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("Create: %v", diags)
+	}
+	sel := fmt.Sprintf("@e[type=minecraft:zombie,tag=%s,limit=1]", stateString(t, state, "id"))
+
+	sent := server.sent()
+	want := []string{
+		"loot replace entity " + sel + " armor.head loot gear:helmets",
+		"loot replace entity " + sel + " armor.feet loot gear:boots",
+		"loot replace entity " + sel + " weapon.mainhand loot gear:swords",
+	}
+	if len(sent) != 4 || !strings.HasPrefix(sent[0], "summon zombie ") || !reflect.DeepEqual(sent[1:], want) {
+		t.Errorf("sent %q, want a summon then %q", sent, want)
+	}
+}
+
+func TestValidateMaxHealth(t *testing.T) {
+	tests := []struct {
+		health, maxHealth float64
+		wantErr           bool
+	}{
+		{health: 20, maxHealth: 40},
+		{health: 40, maxHealth: 40},
+		{health: 50, maxHealth: 40, wantErr: true},
+		{health: 20, maxHealth: 0, wantErr: true},
+		{health: 20, maxHealth: 2048, wantErr: true},
+	}
+	for _, tt := range tests {
+		err := validateMaxHealth(tt.health, tt.maxHealth)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateMaxHealth(%g, %g) = %v, wantErr %v", tt.health, tt.maxHealth, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSilentMobs(t *testing.T) {
+	ctx := context.Background()
+	for _, rt := range []tfsdk.ResourceType{entityResourceType{}, zombieResourceType{}, sheepResourceType{}} {
+		for _, silent := range []bool{false, true} {
+			server := newFakeServer(t, nil)
+			p := configureProvider(t, server.address, nil)
+
+			schema, _ := rt.GetSchema(ctx)
+			values := map[string]tftypes.Value{
+				"position":     xyzValue(ctx, schema, "position", 0, 64, 0),
+				"invulnerable": tftypes.NewValue(tftypes.Bool, true),
+			}
+			if silent {
+				values["silent"] = tftypes.NewValue(tftypes.Bool, true)
+			}
+			if _, ok := rt.(entityResourceType); ok {
+				values["type"] = tftypes.NewValue(tftypes.String, "minecraft:pig")
+			}
+			if _, ok := rt.(sheepResourceType); ok {
+				values["color"] = tftypes.NewValue(tftypes.String, "white")
+			}
+			if _, diags := createResource(t, p, rt, values); diags.HasError() {
+				t.Fatalf("%T: Create: %v", rt, diags)
+			}
+
+			sent := server.sent()
+			if len(sent) == 0 || !strings.HasPrefix(sent[0], "summon ") {
+				t.Fatalf("%T: sent %q, want a summon", rt, sent)
+			}
+			if got := strings.Contains(sent[0], "Invulnerable:1b,Silent:1b"); got != silent {
+				t.Errorf("%T: silent %t, summon %q", rt, silent, sent[0])
+			}
+			if !silent && strings.Contains(sent[0], "Silent") {
+				t.Errorf("%T: summon %q has Silent without the flag", rt, sent[0])
+			}
+		}
+	}
+}